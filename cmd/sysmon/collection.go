@@ -0,0 +1,235 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// collectorsTracker defines the interface for tracking the health of the collectors.
+type collectorsTracker interface {
+	// Observe records the collection of the collector
+	Observe(name string, at time.Time, duration time.Duration, samples int, err error)
+	// List returns the health of the collectors
+	List() models.CollectorsStatus
+}
+
+// collection holds the state of the metrics collection kept between the ticks.
+// The ticks never overlap, so the state is accessed by one tick at a time.
+type collection struct {
+	cfg        *config
+	collectors []metrics.Collector
+	switches   collectorsSwitches
+	tracker    collectorsTracker
+	// inFlight marks the collectors with the collection abandoned on timeout still running
+	inFlight map[string]*atomic.Bool
+	// unavailable is the metrics disabled since their source does not exist on the system
+	unavailable map[string]struct{}
+	// last is the last successful collections to show if the next ones fail
+	last map[string]collectResult
+	// latest is the last collections to show until the collectors with the longer intervals are collected again
+	latest map[string]collectResult
+	// latestTick is the time of the ticks the collectors were last collected on
+	latestTick map[string]time.Time
+	// disabled is the collectors seen disabled on the ticks to reset once enabled again
+	disabled map[string]struct{}
+}
+
+// newCollection returns the collection of the metrics by the collectors.
+func newCollection(cfg *config, collectors []metrics.Collector, switches collectorsSwitches) *collection {
+	c := &collection{
+		cfg:         cfg,
+		collectors:  collectors,
+		switches:    switches,
+		tracker:     metrics.NewTracker(),
+		inFlight:    make(map[string]*atomic.Bool, len(collectors)),
+		unavailable: make(map[string]struct{}),
+		last:        make(map[string]collectResult),
+		latest:      make(map[string]collectResult),
+		latestTick:  make(map[string]time.Time),
+		disabled:    make(map[string]struct{}),
+	}
+	for _, collector := range collectors {
+		c.inFlight[collector.Name()] = &atomic.Bool{}
+	}
+
+	return c
+}
+
+// prime collects all the metrics enabled once discarding the results.
+// The rate-based collectors keep the snapshot of the counters to calculate the rates by the next one.
+func (c *collection) prime(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, collector := range c.collectors {
+		name := collector.Name()
+		if !c.switches.Enabled(name) {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			collect(ctx, collector, c.cfg.collectorTimeout(name), c.inFlight[name])
+		}()
+	}
+	wg.Wait()
+}
+
+// tick collects the metrics due on the tick of the stats in parallel, stores them to the stats
+// and appends them to the output in the order of the collectors.
+// The metrics not due are the ones of their last collection
+// and the metrics failed to be collected are the ones of the last successful collection.
+func (c *collection) tick(ctx context.Context, stats *models.Metrics, res *metricsStringBuilder) {
+	stats.CollectedAt = make(map[string]time.Time)
+
+	results := make([]collectResult, len(c.collectors))
+	fresh := make([]bool, len(c.collectors))
+	var wg sync.WaitGroup
+	for i, collector := range c.collectors {
+		name := collector.Name()
+		if _, ok := c.unavailable[name]; ok {
+			continue
+		}
+		if !c.switches.Enabled(name) {
+			// The disabled collector is collected right away once enabled again
+			delete(c.last, name)
+			delete(c.latest, name)
+			delete(c.latestTick, name)
+			c.disabled[name] = struct{}{}
+			continue
+		}
+		if _, ok := c.disabled[name]; ok {
+			// The rates of the collector enabled again are calculated since its first collection,
+			// not over the time it was disabled
+			if r, ok := collector.(metrics.Resetter); ok {
+				r.Reset()
+			}
+			delete(c.disabled, name)
+		}
+		if t, ok := c.latestTick[name]; ok && stats.Tick.Time.Sub(t) < c.cfg.collectorInterval(name) {
+			continue
+		}
+		c.latestTick[name] = stats.Tick.Time
+		fresh[i] = true
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = collect(ctx, collector, c.cfg.collectorTimeout(name), c.inFlight[name])
+		}()
+	}
+	wg.Wait()
+
+	for i, collector := range c.collectors {
+		name := collector.Name()
+		r := results[i]
+		if fresh[i] {
+			var samples int
+			if r.err == nil {
+				samples = len(r.stats.Samples())
+			}
+			c.tracker.Observe(name, r.collectedAt, r.duration, samples, r.err)
+
+			if errors.Is(r.err, metrics.ErrNotAvailable) {
+				c.unavailable[name] = struct{}{}
+				continue
+			}
+			if r.err != nil {
+				// The metric of the last successful collection is kept stale
+				prev, ok := c.last[name]
+				if ok && !errors.Is(r.err, metrics.ErrWarmingUp) {
+					r.stats, r.collectedAt = prev.stats, prev.collectedAt
+				} else {
+					r.stats = nil
+				}
+			} else {
+				c.last[name] = r
+			}
+			c.latest[name] = r
+		} else {
+			// The metric of the collector not due is the one of its last collection
+			var ok bool
+			if r, ok = c.latest[name]; !ok {
+				continue
+			}
+		}
+
+		title := collector.Description()
+		if interval := c.cfg.collectorInterval(name); interval > 0 {
+			title += fmt.Sprintf(" (every %s, collected at %s)", interval, r.collectedAt.Format(time.TimeOnly))
+		}
+		if r.stats == nil {
+			res.append(title, "", r.err)
+			continue
+		}
+
+		if stored, ok := r.stats.(models.StoredStats); ok {
+			stored.Store(stats)
+		}
+		stats.CollectedAt[name] = r.collectedAt
+		for _, sample := range r.stats.Samples() {
+			// The samples of the plugins may be stamped by them
+			if sample.Timestamp.IsZero() {
+				sample.Timestamp = r.collectedAt
+			}
+			stats.Samples = append(stats.Samples, sample)
+		}
+		res.append(title, r.stats.String(), r.err)
+	}
+
+	stats.Collectors = c.tracker.List()
+}
+
+// collectResult is the result of the metric collection.
+type collectResult struct {
+	stats       models.Stats
+	err         error
+	collectedAt time.Time
+	duration    time.Duration
+}
+
+// collect collects the metric failing with metrics.ErrTimeout if it is not collected in time.
+// The collector not returning after the context is done is abandoned, so a stuck one does not block the tick.
+// The collector is marked in flight until it returns and is not collected again meanwhile,
+// so the stateful collectors are never collected concurrently. Such collection fails with metrics.ErrTimeout too.
+func collect(ctx context.Context, c metrics.Collector, timeout time.Duration, inFlight *atomic.Bool) collectResult {
+	if !inFlight.CompareAndSwap(false, true) {
+		return collectResult{
+			err:         fmt.Errorf("%w: the previous collection is still running", metrics.ErrTimeout),
+			collectedAt: time.Now(),
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	ch := make(chan collectResult, 1)
+	go func() {
+		defer inFlight.Store(false)
+
+		s, err := c.Collect(ctx)
+		ch <- collectResult{stats: s, err: err, collectedAt: time.Now()}
+	}()
+
+	var r collectResult
+	select {
+	case r = <-ch:
+	case <-ctx.Done():
+		r.err = ctx.Err()
+		r.collectedAt = time.Now()
+	}
+	r.duration = r.collectedAt.Sub(start)
+
+	if errors.Is(r.err, context.DeadlineExceeded) {
+		r.err = fmt.Errorf("%w: not collected in %s", metrics.ErrTimeout, timeout)
+	}
+
+	return r
+}
@@ -0,0 +1,269 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// fakeCollector is the collector collecting by the function.
+type fakeCollector struct {
+	name    string
+	collect func(ctx context.Context, call int64) (models.Stats, error)
+	calls   atomic.Int64
+	resets  atomic.Int64
+}
+
+func (c *fakeCollector) Name() string        { return c.name }
+func (c *fakeCollector) Description() string { return strings.ToUpper(c.name) }
+func (c *fakeCollector) Platforms() []string { return nil }
+func (c *fakeCollector) OptIn() bool         { return false }
+func (c *fakeCollector) Reset()              { c.resets.Add(1) }
+
+func (c *fakeCollector) Collect(ctx context.Context) (models.Stats, error) {
+	return c.collect(ctx, c.calls.Add(1))
+}
+
+func Test_collect(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+
+		c := &fakeCollector{
+			name: "load",
+			collect: func(_ context.Context, _ int64) (models.Stats, error) {
+				return models.LoadAverageStats{OneMin: 1}, nil
+			},
+		}
+		inFlight := &atomic.Bool{}
+
+		r := collect(context.Background(), c, time.Second, inFlight)
+		require.NoError(t, r.err)
+		require.Equal(t, models.LoadAverageStats{OneMin: 1}, r.stats)
+		require.False(t, r.collectedAt.IsZero())
+		require.False(t, inFlight.Load())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		c := &fakeCollector{
+			name: "load",
+			collect: func(_ context.Context, _ int64) (models.Stats, error) {
+				return nil, errors.New("exit status 1")
+			},
+		}
+		inFlight := &atomic.Bool{}
+
+		r := collect(context.Background(), c, time.Second, inFlight)
+		require.EqualError(t, r.err, "exit status 1")
+		require.False(t, inFlight.Load())
+	})
+
+	t.Run("timeout abandons the stuck collector and skips it while in flight", func(t *testing.T) {
+		t.Parallel()
+
+		release := make(chan struct{})
+		c := &fakeCollector{
+			name: "stuck",
+			collect: func(_ context.Context, call int64) (models.Stats, error) {
+				if call == 1 {
+					// Ignores the context like the collector stuck in a syscall
+					<-release
+				}
+				return models.LoadAverageStats{OneMin: float64(call)}, nil
+			},
+		}
+		inFlight := &atomic.Bool{}
+
+		r := collect(context.Background(), c, 20*time.Millisecond, inFlight)
+		require.ErrorIs(t, r.err, metrics.ErrTimeout)
+		require.Nil(t, r.stats)
+		require.GreaterOrEqual(t, r.duration, 20*time.Millisecond)
+		require.True(t, inFlight.Load())
+
+		// The abandoned collection is still running, so the collector is not collected again
+		r = collect(context.Background(), c, 20*time.Millisecond, inFlight)
+		require.ErrorIs(t, r.err, metrics.ErrTimeout)
+		require.Contains(t, r.err.Error(), "still running")
+		require.EqualValues(t, 1, c.calls.Load())
+
+		// The collector is collected again once the abandoned collection returns
+		close(release)
+		require.Eventually(t, func() bool { return !inFlight.Load() }, time.Second, time.Millisecond)
+
+		r = collect(context.Background(), c, 20*time.Millisecond, inFlight)
+		require.NoError(t, r.err)
+		require.Equal(t, models.LoadAverageStats{OneMin: 2}, r.stats)
+		require.EqualValues(t, 2, c.calls.Load())
+	})
+}
+
+func Test_collection_tick(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	tickAt := func(seq uint64) models.Metrics {
+		return models.Metrics{Tick: models.Tick{Seq: seq, Time: start.Add(time.Duration(seq) * time.Second)}}
+	}
+
+	t.Run("stale on failure", func(t *testing.T) {
+		t.Parallel()
+
+		c := &fakeCollector{
+			name: "load",
+			collect: func(_ context.Context, call int64) (models.Stats, error) {
+				if call == 2 {
+					return nil, errors.New("exit status 1")
+				}
+				return models.LoadAverageStats{OneMin: float64(call)}, nil
+			},
+		}
+		col := newCollection(&config{}, []metrics.Collector{c}, metrics.NewSwitches())
+
+		stats := tickAt(0)
+		col.tick(context.Background(), &stats, &metricsStringBuilder{})
+		require.Equal(t, float64(1), stats.LoadAverageStats.OneMin)
+		collectedAt := stats.CollectedAt["load"]
+
+		// The metric of the last successful collection is kept
+		stats = tickAt(1)
+		res := &metricsStringBuilder{}
+		col.tick(context.Background(), &stats, res)
+		require.Equal(t, float64(1), stats.LoadAverageStats.OneMin)
+		require.Equal(t, collectedAt, stats.CollectedAt["load"])
+		require.Contains(t, res.String(), "exit status 1")
+		require.Contains(t, res.String(), "Stale")
+		require.Len(t, stats.Collectors, 1)
+		require.True(t, stats.Collectors[0].Stale)
+
+		// The collector recovers
+		stats = tickAt(2)
+		col.tick(context.Background(), &stats, &metricsStringBuilder{})
+		require.Equal(t, float64(3), stats.LoadAverageStats.OneMin)
+		require.False(t, stats.Collectors[0].Stale)
+	})
+
+	t.Run("warming up is not stale", func(t *testing.T) {
+		t.Parallel()
+
+		c := &fakeCollector{
+			name: "load",
+			collect: func(_ context.Context, _ int64) (models.Stats, error) {
+				return nil, metrics.ErrWarmingUp
+			},
+		}
+		col := newCollection(&config{}, []metrics.Collector{c}, metrics.NewSwitches())
+
+		stats := tickAt(0)
+		res := &metricsStringBuilder{}
+		col.tick(context.Background(), &stats, res)
+		require.Empty(t, stats.CollectedAt)
+		require.Contains(t, res.String(), "Warming up")
+	})
+
+	t.Run("not available", func(t *testing.T) {
+		t.Parallel()
+
+		c := &fakeCollector{
+			name: "psi",
+			collect: func(_ context.Context, _ int64) (models.Stats, error) {
+				return nil, metrics.ErrNotAvailable
+			},
+		}
+		col := newCollection(&config{}, []metrics.Collector{c}, metrics.NewSwitches())
+
+		for seq := uint64(0); seq < 3; seq++ {
+			stats := tickAt(seq)
+			res := &metricsStringBuilder{}
+			col.tick(context.Background(), &stats, res)
+			require.NotContains(t, res.String(), "PSI")
+		}
+		require.EqualValues(t, 1, c.calls.Load())
+	})
+
+	t.Run("interval", func(t *testing.T) {
+		t.Parallel()
+
+		slow := &fakeCollector{
+			name: "slow",
+			collect: func(_ context.Context, call int64) (models.Stats, error) {
+				return models.LoadAverageStats{OneMin: float64(call)}, nil
+			},
+		}
+		fast := &fakeCollector{
+			name: "fast",
+			collect: func(_ context.Context, call int64) (models.Stats, error) {
+				return models.MemoryStats{UsedMb: uint64(call)}, nil
+			},
+		}
+		cfg := &config{Collectors: map[string]collectorConfig{"slow": {Interval: 3 * time.Second}}}
+		col := newCollection(cfg, []metrics.Collector{slow, fast}, metrics.NewSwitches())
+
+		want := []float64{1, 1, 1, 2, 2}
+		for seq := uint64(0); seq < 5; seq++ {
+			stats := tickAt(seq)
+			res := &metricsStringBuilder{}
+			col.tick(context.Background(), &stats, res)
+
+			// The metric not due is the one of the last collection
+			require.Equal(t, want[seq], stats.LoadAverageStats.OneMin, "tick #%d", seq)
+			require.Equal(t, seq+1, stats.MemoryStats.UsedMb, "tick #%d", seq)
+			require.Contains(t, res.String(), "SLOW (every 3s")
+		}
+		require.EqualValues(t, 2, slow.calls.Load())
+		require.EqualValues(t, 5, fast.calls.Load())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		c := &fakeCollector{
+			name: "load",
+			collect: func(_ context.Context, call int64) (models.Stats, error) {
+				return models.LoadAverageStats{OneMin: float64(call)}, nil
+			},
+		}
+		switches := metrics.NewSwitches("load")
+		col := newCollection(&config{}, []metrics.Collector{c}, switches)
+
+		stats := tickAt(0)
+		res := &metricsStringBuilder{}
+		col.tick(context.Background(), &stats, res)
+		require.NotContains(t, res.String(), "LOAD")
+		require.Zero(t, c.calls.Load())
+
+		// The collector enabled again is reset once
+		switches.SetEnabled("load", true)
+		stats = tickAt(1)
+		col.tick(context.Background(), &stats, &metricsStringBuilder{})
+		require.Equal(t, float64(1), stats.LoadAverageStats.OneMin)
+		require.EqualValues(t, 1, c.resets.Load())
+
+		stats = tickAt(2)
+		col.tick(context.Background(), &stats, &metricsStringBuilder{})
+		require.Equal(t, float64(2), stats.LoadAverageStats.OneMin)
+		require.EqualValues(t, 1, c.resets.Load())
+
+		// The collector disabled and enabled again is reset again
+		switches.SetEnabled("load", false)
+		stats = tickAt(3)
+		col.tick(context.Background(), &stats, &metricsStringBuilder{})
+		require.Zero(t, stats.LoadAverageStats.OneMin)
+
+		switches.SetEnabled("load", true)
+		stats = tickAt(4)
+		col.tick(context.Background(), &stats, &metricsStringBuilder{})
+		require.Equal(t, float64(3), stats.LoadAverageStats.OneMin)
+		require.EqualValues(t, 2, c.resets.Load())
+	})
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"runtime"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/cpu"
+	"github.com/sitnikovik/sysmon/internal/metrics/custom"
+	"github.com/sitnikovik/sysmon/internal/metrics/disk"
+	"github.com/sitnikovik/sysmon/internal/metrics/interrupts"
+	"github.com/sitnikovik/sysmon/internal/metrics/limits"
+	"github.com/sitnikovik/sysmon/internal/metrics/loadavg"
+	"github.com/sitnikovik/sysmon/internal/metrics/mdstat"
+	"github.com/sitnikovik/sysmon/internal/metrics/memory"
+	"github.com/sitnikovik/sysmon/internal/metrics/netdev"
+	"github.com/sitnikovik/sysmon/internal/metrics/netproto"
+	"github.com/sitnikovik/sysmon/internal/metrics/plugin"
+	"github.com/sitnikovik/sysmon/internal/metrics/proclimits"
+	"github.com/sitnikovik/sysmon/internal/metrics/procstate"
+	"github.com/sitnikovik/sysmon/internal/metrics/sessions"
+	"github.com/sitnikovik/sysmon/internal/metrics/sockstat"
+	"github.com/sitnikovik/sysmon/internal/metrics/thermal"
+	"github.com/sitnikovik/sysmon/internal/metrics/timesync"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/metrics/vmstat"
+	"github.com/sitnikovik/sysmon/internal/metrics/zfs"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// collectorsRegistry defines the interface for the registry of the metrics collectors.
+type collectorsRegistry interface {
+	// Register adds the collector to the registry
+	Register(c metrics.Collector) error
+	// Get returns the collector by the metric name
+	Get(name string) (metrics.Collector, bool)
+	// List returns the collectors in the order they were registered
+	List() []metrics.Collector
+}
+
+// newCollectors returns the registry with the built-in metrics collectors
+// and the custom collectors and the plugins of the configuration.
+// The collectors are created once, so the stateful ones track the changes between the snapshots.
+func newCollectors(cfg *config) (collectorsRegistry, error) {
+	execer := cmd.NewExecer()
+	paths := cfg.paths()
+
+	registry := metrics.NewRegistry()
+	for _, c := range []metrics.Collector{
+		metrics.NewCollector[models.CPUStats](
+			metrics.CollectorSpec{Name: "cpu", Description: "CPU Usage"},
+			cpu.NewParser(execer, paths),
+		),
+		metrics.NewCollector[models.LoadAverageStats](
+			metrics.CollectorSpec{Name: "loadavg", Description: "Load Average"},
+			loadavg.NewParser(execer, paths),
+		),
+		metrics.NewCollector[models.MemoryStats](
+			metrics.CollectorSpec{Name: "memory", Description: "Memory"},
+			memory.NewParser(execer, paths),
+		),
+		metrics.NewCollector[models.DiskStats](
+			metrics.CollectorSpec{Name: "disk", Description: "Disk Usage"},
+			disk.NewParser(execer, paths),
+		),
+		metrics.NewCollector[models.InterruptsStats](
+			metrics.CollectorSpec{Name: "interrupts", Description: "Interrupts", Platforms: []string{os.Linux}, OptIn: true},
+			interrupts.NewParser(execer, paths),
+		),
+		metrics.NewCollector[models.NetProtoStats](
+			metrics.CollectorSpec{Name: "netproto", Description: "Network Protocols", Platforms: []string{os.Linux}},
+			netproto.NewParser(execer, paths),
+		),
+		metrics.NewCollector[models.LimitsStats](
+			metrics.CollectorSpec{Name: "limits", Description: "Kernel Limits", Platforms: []string{os.Linux}},
+			limits.NewParser(execer, paths),
+		),
+		metrics.NewCollector[models.ProcessLimitsStats](
+			metrics.CollectorSpec{Name: "proclimits", Description: "Process Limits", Platforms: []string{os.Linux}},
+			proclimits.NewParser(execer, paths, cfg.ProcLimits.Watch, cfg.ProcLimits.Top),
+		),
+		metrics.NewCollector[models.ThermalStats](
+			metrics.CollectorSpec{
+				Name:        "thermal",
+				Description: "CPU Frequency and Temperatures",
+				Platforms:   []string{os.Linux},
+			},
+			thermal.NewParser(execer, paths),
+		),
+		metrics.NewCollector[models.MDStatStats](
+			metrics.CollectorSpec{Name: "mdstat", Description: "Software RAID", Platforms: []string{os.Linux}},
+			mdstat.NewParser(execer, paths),
+		),
+		metrics.NewCollector[models.ZFSArcStats](
+			metrics.CollectorSpec{Name: "zfsarc", Description: "ZFS ARC", Platforms: []string{os.Linux}},
+			zfs.NewParser(execer, paths),
+		),
+		metrics.NewCollector[models.TimeSyncStats](
+			metrics.CollectorSpec{Name: "timesync", Description: "Time Synchronisation", Platforms: []string{os.Linux}},
+			timesync.NewParser(execer),
+		),
+		metrics.NewCollector[models.SessionsStats](
+			metrics.CollectorSpec{Name: "sessions", Description: "Logged-in Users", Platforms: []string{os.Linux}},
+			sessions.NewParser(execer, paths),
+		),
+		metrics.NewCollector[models.ProcStateStats](
+			metrics.CollectorSpec{Name: "procstate", Description: "Processes States", Platforms: []string{os.Linux}},
+			procstate.NewParser(execer, paths),
+		),
+		metrics.NewCollector[models.SockStatStats](
+			metrics.CollectorSpec{Name: "sockstat", Description: "Sockets", Platforms: []string{os.Linux}},
+			sockstat.NewParser(execer, paths),
+		),
+		metrics.NewCollector[models.NetDevStats](
+			metrics.CollectorSpec{Name: "netdev", Description: "Network Interfaces", Platforms: []string{os.Linux}},
+			netdev.NewParser(execer, paths),
+		),
+		metrics.NewCollector[models.VMStatStats](
+			metrics.CollectorSpec{Name: "vmstat", Description: "Paging and Swapping", Platforms: []string{os.Linux}},
+			vmstat.NewParser(execer, paths),
+		),
+	} {
+		if err := registry.Register(c); err != nil {
+			return nil, err
+		}
+	}
+
+	// The custom collectors declared in the configuration run on any platform the commands exist on
+	for _, cc := range cfg.Custom {
+		p, err := custom.NewParser(execer, cc.spec())
+		if err != nil {
+			return nil, err
+		}
+
+		description := cc.Description
+		if description == "" {
+			description = cc.Name
+		}
+		c := metrics.NewCollector[models.CustomStats](metrics.CollectorSpec{Name: cc.Name, Description: description}, p)
+		if err := registry.Register(c); err != nil {
+			return nil, err
+		}
+	}
+
+	// The plugins run as separate processes, so their crashes do not affect the daemon
+	for _, pc := range cfg.Plugins {
+		c, err := plugin.NewCollector(pc.spec())
+		if err != nil {
+			return nil, err
+		}
+		if err := registry.Register(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return registry, nil
+}
+
+// getSupportedCollectors returns the collectors supported on the current platform.
+// The ones not to run by the configuration may be enabled at runtime.
+func getSupportedCollectors(collectors collectorsRegistry) []metrics.Collector {
+	all := collectors.List()
+	res := make([]metrics.Collector, 0, len(all))
+	for _, c := range all {
+		if metrics.Supports(c, runtime.GOOS) {
+			res = append(res, c)
+		}
+	}
+
+	return res
+}
+
+// getDisabledCollectors returns the names of the supported collectors not to run by the configuration.
+func getDisabledCollectors(cfg *config, collectors collectorsRegistry) []string {
+	toRun := make(map[string]struct{})
+	for _, c := range getCollectorsToRun(cfg, collectors) {
+		toRun[c.Name()] = struct{}{}
+	}
+
+	var res []string
+	for _, c := range getSupportedCollectors(collectors) {
+		if _, ok := toRun[c.Name()]; !ok {
+			res = append(res, c.Name())
+		}
+	}
+
+	return res
+}
+
+// getCollectorsToRun returns the collectors to run.
+// Opt-in metrics are collected only if they are included in the configuration
+// and the metrics not supported on the current platform are skipped.
+func getCollectorsToRun(cfg *config, collectors collectorsRegistry) []metrics.Collector {
+	excludedMetrics := make(map[string]struct{})
+	for _, metric := range cfg.Exclude.Metrics {
+		excludedMetrics[metric] = struct{}{}
+	}
+	includedMetrics := make(map[string]struct{})
+	for _, metric := range cfg.Include.Metrics {
+		includedMetrics[metric] = struct{}{}
+	}
+
+	all := collectors.List()
+	res := make([]metrics.Collector, 0, len(all))
+	for _, c := range all {
+		if _, excluded := excludedMetrics[c.Name()]; excluded {
+			continue
+		}
+		if _, included := includedMetrics[c.Name()]; c.OptIn() && !included {
+			continue
+		}
+		if !metrics.Supports(c, runtime.GOOS) {
+			continue
+		}
+		res = append(res, c)
+	}
+
+	return res
+}
@@ -3,20 +3,164 @@ package main
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
-	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/custom"
+	"github.com/sitnikovik/sysmon/internal/metrics/plugin"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/models"
+	"github.com/sitnikovik/sysmon/internal/scheduler"
 )
 
+// defaultCollectorTimeout is the time the metric must be collected in if not set in the configuration.
+const defaultCollectorTimeout = 10 * time.Second
+
 // config - struct to hold the configuration of the sysmon.
 type config struct {
 	Interval int `yaml:"interval"`
 	Margin   int `yaml:"margin"`
 	GRPCPort int `yaml:"grpcPort"`
-	Exclude  struct {
+	Admin    struct {
+		// Socket is the path of the unix socket to serve the SystemAdmin service on.
+		// The service changes the app at runtime, so it is not served if not set.
+		Socket string `yaml:"socket"`
+	} `yaml:"admin"`
+	Exclude struct {
 		Metrics []string `yaml:"metrics"`
 	} `yaml:"exclude"`
+	Include struct {
+		Metrics []string `yaml:"metrics"`
+	} `yaml:"include"`
+	ProcLimits struct {
+		// Watch is the names of the processes to report regardless of their usage.
+		Watch []string `yaml:"watch"`
+		// Top is the number of the processes closest to their limits to report.
+		Top int `yaml:"top"`
+	} `yaml:"procLimits"`
+	Events struct {
+		// Processes enables the events of the processes starting and exiting.
+		Processes bool `yaml:"processes"`
+	} `yaml:"events"`
+	Scheduler struct {
+		// Policy is the policy of the ticks fired while the previous one is still running:
+		// skip, queue or coalesce. Skip by default.
+		Policy string `yaml:"policy"`
+	} `yaml:"scheduler"`
+	// Collectors is the settings of the metrics collectors by their names.
+	Collectors map[string]collectorConfig `yaml:"collectors"`
+	// Paths is the paths the host filesystems are mounted at, e.g. to monitor the host from a container.
+	// The paths of the host the app runs on are used if not set.
+	Paths struct {
+		// Proc is the path the procfs is mounted at, /proc by default.
+		Proc string `yaml:"proc"`
+		// Sys is the path the sysfs is mounted at, /sys by default.
+		Sys string `yaml:"sys"`
+		// Rootfs is the path the root filesystem is mounted at, / by default.
+		Rootfs string `yaml:"rootfs"`
+		// Run is the path of the runtime state directory, /run by default.
+		Run string `yaml:"run"`
+	} `yaml:"paths"`
+	// Custom is the collectors of the metrics parsed from the output of the commands.
+	Custom []customCollectorConfig `yaml:"custom"`
+	// Plugins is the external collectors serving the samples over gRPC on the unix sockets.
+	Plugins []pluginConfig `yaml:"plugins"`
+}
+
+// collectorConfig - struct to hold the settings of the metric collector.
+type collectorConfig struct {
+	// Timeout is the time the metric must be collected in, defaultCollectorTimeout if not set.
+	Timeout time.Duration `yaml:"timeout"`
+	// Interval is the interval to collect the metric at rounded up to the ticks, on every tick if not set.
+	// The metric of the last collection is shown in between.
+	Interval time.Duration `yaml:"interval"`
+}
+
+// customCollectorConfig - struct to hold the settings of the custom collector running the command.
+type customCollectorConfig struct {
+	// Name is the name of the collector to refer it like the built-in ones.
+	Name string `yaml:"name"`
+	// Description is the title of the metrics in the terminal view, the name if not set.
+	Description string `yaml:"description"`
+	// Command is the command to run.
+	Command string `yaml:"command"`
+	// Args is the arguments of the command.
+	Args []string `yaml:"args"`
+	// Timeout is the time the command must complete in, defaultCollectorTimeout if not set.
+	Timeout time.Duration `yaml:"timeout"`
+	// Interval is the interval to run the command at rounded up to the ticks, on every tick if not set.
+	// The metrics of the last run are shown in between.
+	Interval time.Duration `yaml:"interval"`
+	// Parse is the way the output is parsed: number, keyvalue, json or regex.
+	Parse string `yaml:"parse"`
+	// Pattern is the regular expression with the named groups to parse the output by in the regex mode.
+	Pattern string `yaml:"pattern"`
+	// Metrics is the metrics to take from the output.
+	Metrics []struct {
+		// Name is the name of the metric.
+		Name string `yaml:"name"`
+		// Key is the key, the JSONPath or the group name of the value depending on the parse mode.
+		Key string `yaml:"key"`
+		// Unit is the unit of the value.
+		Unit string `yaml:"unit"`
+		// Type is the type of the value: gauge, counter or rate. Gauge by default.
+		Type string `yaml:"type"`
+	} `yaml:"metrics"`
+}
+
+// spec returns the spec of the custom collector.
+func (c customCollectorConfig) spec() custom.Spec {
+	res := custom.Spec{
+		Name:    c.Name,
+		Command: c.Command,
+		Args:    c.Args,
+		Mode:    custom.Mode(c.Parse),
+		Pattern: c.Pattern,
+		Metrics: make([]custom.MetricSpec, 0, len(c.Metrics)),
+	}
+	for _, m := range c.Metrics {
+		res.Metrics = append(res.Metrics, custom.MetricSpec{
+			Name: m.Name,
+			Key:  m.Key,
+			Unit: m.Unit,
+			Type: models.SampleType(m.Type),
+		})
+	}
+
+	return res
+}
+
+// pluginConfig - struct to hold the settings of the external collector plugin.
+type pluginConfig struct {
+	// Name is the name of the collector to refer it like the built-in ones.
+	Name string `yaml:"name"`
+	// Description is the title of the metrics in the terminal view, the one the plugin describes itself with if not set.
+	Description string `yaml:"description"`
+	// Command is the plugin binary to launch and restart if it exits, the socket is connected to if not set.
+	Command string `yaml:"command"`
+	// Args is the arguments of the plugin binary.
+	Args []string `yaml:"args"`
+	// Socket is the path of the unix socket the plugin listens on.
+	Socket string `yaml:"socket"`
+	// Timeout is the time the samples must be collected in, defaultCollectorTimeout if not set.
+	Timeout time.Duration `yaml:"timeout"`
+	// Interval is the interval to collect the samples at rounded up to the ticks, on every tick if not set.
+	// The samples of the last collection are shown in between.
+	Interval time.Duration `yaml:"interval"`
+}
+
+// spec returns the spec of the plugin.
+func (c pluginConfig) spec() plugin.Spec {
+	return plugin.Spec{
+		Name:        c.Name,
+		Description: c.Description,
+		Command:     c.Command,
+		Args:        c.Args,
+		Socket:      c.Socket,
+	}
 }
 
 func loadConfig(path string) (*config, error) {
@@ -51,15 +195,145 @@ func (c *config) validate() error {
 		return fmt.Errorf("invalid gRPC port: %d", c.GRPCPort)
 	}
 
-	for _, metric := range c.Exclude.Metrics {
-		if metrics.NameToType(metric) == metrics.Undefined {
+	if c.ProcLimits.Top < 0 {
+		return fmt.Errorf("invalid number of top processes: %d", c.ProcLimits.Top)
+	}
+
+	if c.Scheduler.Policy != "" && !scheduler.Policy(c.Scheduler.Policy).Valid() {
+		return fmt.Errorf("invalid scheduler policy: %s", c.Scheduler.Policy)
+	}
+
+	for name, collector := range c.Collectors {
+		if collector.Timeout < 0 {
+			return fmt.Errorf("invalid timeout of %s: %s", name, collector.Timeout)
+		}
+		if collector.Interval < 0 {
+			return fmt.Errorf("invalid interval of %s: %s", name, collector.Interval)
+		}
+	}
+
+	for _, collector := range c.Custom {
+		if collector.Name == "" {
+			return fmt.Errorf("invalid custom collector: name is required")
+		}
+		if collector.Timeout < 0 {
+			return fmt.Errorf("invalid timeout of %s: %s", collector.Name, collector.Timeout)
+		}
+		if collector.Interval < 0 {
+			return fmt.Errorf("invalid interval of %s: %s", collector.Name, collector.Interval)
+		}
+	}
+
+	for _, plugin := range c.Plugins {
+		if plugin.Name == "" {
+			return fmt.Errorf("invalid plugin: name is required")
+		}
+		if plugin.Command == "" && plugin.Socket == "" {
+			return fmt.Errorf("invalid plugin %s: command or socket is required", plugin.Name)
+		}
+		if plugin.Socket != "" && !filepath.IsAbs(plugin.Socket) {
+			return fmt.Errorf("invalid socket of %s: %s is not absolute", plugin.Name, plugin.Socket)
+		}
+		if plugin.Timeout < 0 {
+			return fmt.Errorf("invalid timeout of %s: %s", plugin.Name, plugin.Timeout)
+		}
+		if plugin.Interval < 0 {
+			return fmt.Errorf("invalid interval of %s: %s", plugin.Name, plugin.Interval)
+		}
+	}
+
+	for _, path := range []string{c.Paths.Proc, c.Paths.Sys, c.Paths.Rootfs, c.Paths.Run} {
+		if path != "" && !filepath.IsAbs(path) {
+			return fmt.Errorf("invalid path: %s is not absolute", path)
+		}
+	}
+
+	return nil
+}
+
+// validateMetrics validates the metrics names are the ones of the registered collectors.
+func (c *config) validateMetrics(collectors collectorsRegistry) error {
+	for _, metric := range slices.Concat(c.Exclude.Metrics, c.Include.Metrics) {
+		if _, ok := collectors.Get(metric); !ok {
 			return fmt.Errorf("invalid metric name: %s", metric)
 		}
 	}
 
+	for metric := range c.Collectors {
+		if _, ok := collectors.Get(metric); !ok {
+			return fmt.Errorf("invalid collector name: %s", metric)
+		}
+	}
+
 	return nil
 }
 
+// schedulerPolicy returns the policy of the ticks fired while the previous one is still running.
+func (c *config) schedulerPolicy() scheduler.Policy {
+	if c.Scheduler.Policy == "" {
+		return scheduler.PolicySkip
+	}
+
+	return scheduler.Policy(c.Scheduler.Policy)
+}
+
+// paths returns the paths the host filesystems are mounted at.
+func (c *config) paths() fs.Paths {
+	res := fs.DefaultPaths()
+	if c.Paths.Proc != "" {
+		res.Proc = c.Paths.Proc
+	}
+	if c.Paths.Sys != "" {
+		res.Sys = c.Paths.Sys
+	}
+	if c.Paths.Rootfs != "" {
+		res.Root = c.Paths.Rootfs
+	}
+	if c.Paths.Run != "" {
+		res.Run = c.Paths.Run
+	}
+
+	return res
+}
+
+// collectorTimeout returns the time the metric must be collected in.
+func (c *config) collectorTimeout(name string) time.Duration {
+	if timeout := c.Collectors[name].Timeout; timeout > 0 {
+		return timeout
+	}
+	for _, collector := range c.Custom {
+		if collector.Name == name && collector.Timeout > 0 {
+			return collector.Timeout
+		}
+	}
+	for _, plugin := range c.Plugins {
+		if plugin.Name == name && plugin.Timeout > 0 {
+			return plugin.Timeout
+		}
+	}
+
+	return defaultCollectorTimeout
+}
+
+// collectorInterval returns the interval to collect the metric at, zero to collect it on every tick.
+func (c *config) collectorInterval(name string) time.Duration {
+	if interval := c.Collectors[name].Interval; interval > 0 {
+		return interval
+	}
+	for _, collector := range c.Custom {
+		if collector.Name == name {
+			return collector.Interval
+		}
+	}
+	for _, plugin := range c.Plugins {
+		if plugin.Name == name {
+			return plugin.Interval
+		}
+	}
+
+	return 0
+}
+
 // GetMetricsToParse returns the metrics to parse.
 func (c *config) GetMetricsToParse(allMetrics []string) []string {
 	excludedMetrics := make(map[string]struct{})
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_config_collectorInterval(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config{
+		Collectors: map[string]collectorConfig{
+			"disk": {Interval: time.Minute},
+			"jobs": {Interval: 10 * time.Second},
+		},
+		Custom: []customCollectorConfig{
+			{Name: "jobs", Interval: 30 * time.Second},
+			{Name: "replication", Interval: 20 * time.Second},
+		},
+		Plugins: []pluginConfig{
+			{Name: "gpu", Interval: 15 * time.Second},
+		},
+	}
+
+	tests := []struct {
+		name string
+		want time.Duration
+	}{
+		{name: "disk", want: time.Minute},
+		{name: "jobs", want: 10 * time.Second},
+		{name: "replication", want: 20 * time.Second},
+		{name: "gpu", want: 15 * time.Second},
+		{name: "cpu", want: 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, cfg.collectorInterval(tt.name))
+		})
+	}
+}
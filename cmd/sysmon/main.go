@@ -4,10 +4,25 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/events"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/models"
+	eventsStorage "github.com/sitnikovik/sysmon/internal/storage/events"
 )
 
+// eventsHistory is the number of the recent system events of every kind kept in memory.
+const eventsHistory = 1000
+
 var (
 	// interval is the interval of time to output the metrics.
 	interval int
@@ -15,6 +30,8 @@ var (
 	margin int
 	// grpcPort is the gRPC port to connect to.
 	grpcPort int
+	// adminSocket is the path of the unix socket to serve the SystemAdmin service on.
+	adminSocket string
 	// configPath is the path to the configuration file.
 	configPath string
 )
@@ -24,6 +41,7 @@ func main() {
 	flag.IntVar(&interval, "n", 5, "Interval of time to output the metrics")
 	flag.IntVar(&margin, "m", 15, "Margin of time between statistics output")
 	flag.IntVar(&grpcPort, "grpc-port", 50051, "gRPC port")
+	flag.StringVar(&adminSocket, "admin-socket", "", "Path of the unix socket to serve the admin API on, off if empty")
 	flag.StringVar(&configPath, "config", "", "Path to the configuration file")
 	flag.Parse()
 
@@ -39,16 +57,67 @@ func main() {
 			Margin:   margin,
 			GRPCPort: grpcPort,
 		}
+		cfg.Admin.Socket = adminSocket
+	}
+
+	collectors, err := newCollectors(cfg)
+	if err != nil {
+		log.Fatalf("failed to register the metrics collectors: %v", err)
+	}
+	if err = cfg.validateMetrics(collectors); err != nil {
+		log.Fatalf("failed to validate the configuration: %v", err)
+	}
+
+	// Stop on the interrupt, so the launched plugins are killed
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	paths := cfg.paths()
+
+	// Watch the system events like OOM kills in the background
+	eventsStore := eventsStorage.NewStorage(eventsHistory)
+	go watch(ctx, eventsStore, "system events", events.NewWatcher(cmd.NewExecer(), paths, eventsStore).Run)
+	if cfg.Events.Processes {
+		go watch(ctx, eventsStore, "processes", events.NewProcessWatcher(cmd.NewExecer(), paths, eventsStore).Run)
 	}
 
-	ctx := context.Background()
+	// The collectors not to run by the configuration are disabled, so they can be enabled at runtime.
+	// The plugins are launched and restarted if they crash while they are enabled only
+	supported := getSupportedCollectors(collectors)
+	supervisor := newCollectorsSupervisor(ctx, supported, metrics.NewSwitches(getDisabledCollectors(cfg, collectors)...))
+	supervisor.Start()
 
 	go func() {
-		if err := runGRPCServer(grpcPort); err != nil {
+		if err := runGRPCServer(grpcPort, paths, collectors, supervisor, eventsStore); err != nil {
 			log.Fatalf("failed to run gRPC server: %v", err)
 		}
 	}()
+	if cfg.Admin.Socket != "" {
+		go func() {
+			if err := runAdminServer(cfg.Admin.Socket, collectors, supervisor); err != nil {
+				log.Fatalf("failed to run the admin gRPC server: %v", err)
+			}
+		}()
+	}
 
 	// Collect and print the system metrics
-	run(ctx, cfg)
+	run(ctx, cfg, supported, supervisor, eventsStore)
+
+	// Wait for the launched plugins to be killed
+	supervisor.Wait()
+}
+
+// watch runs the events watcher and adds its failure to the sink as the event,
+// so it is shown in the events panel instead of being written over the terminal view.
+func watch(ctx context.Context, sink events.Sink, what string, run func(ctx context.Context) error) {
+	err := run(ctx)
+	if err == nil || errors.Is(err, metrics.ErrUnsupportedOS) || ctx.Err() != nil {
+		return
+	}
+
+	sink.Add(ctx, models.Event{
+		Time:     time.Now(),
+		Kind:     models.EventWatcherError,
+		Severity: models.SeverityError,
+		Message:  fmt.Sprintf("failed to watch the %s: %v", what, err),
+	})
 }
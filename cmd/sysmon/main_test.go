@@ -0,0 +1,69 @@
+//go:build darwin || linux || windows
+
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// eventsSink keeps the events added.
+type eventsSink struct {
+	events []models.Event
+}
+
+// Add keeps the event.
+func (s *eventsSink) Add(_ context.Context, e models.Event) {
+	s.events = append(s.events, e)
+}
+
+func Test_watch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want []string
+	}{
+		{
+			name: "watcher failed",
+			err:  errors.New("permission denied"),
+			want: []string{"failed to watch the system events: permission denied"},
+		},
+		{
+			name: "watcher stopped",
+			err:  nil,
+			want: nil,
+		},
+		{
+			name: "unsupported os",
+			err:  metrics.ErrUnsupportedOS,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sink := &eventsSink{}
+			watch(context.Background(), sink, "system events", func(_ context.Context) error {
+				return tt.err
+			})
+
+			var got []string
+			for _, e := range sink.events {
+				require.Equal(t, models.EventWatcherError, e.Kind)
+				require.Equal(t, models.SeverityError, e.Severity)
+				got = append(got, e.Message)
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -7,20 +7,34 @@ import (
 	"log"
 	"runtime"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/sitnikovik/sysmon/internal/metrics"
-	"github.com/sitnikovik/sysmon/internal/metrics/cpu"
-	"github.com/sitnikovik/sysmon/internal/metrics/disk"
-	"github.com/sitnikovik/sysmon/internal/metrics/loadavg"
-	"github.com/sitnikovik/sysmon/internal/metrics/memory"
 	"github.com/sitnikovik/sysmon/internal/metrics/utils"
-	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
 	"github.com/sitnikovik/sysmon/internal/models"
+	"github.com/sitnikovik/sysmon/internal/scheduler"
 	storage "github.com/sitnikovik/sysmon/internal/storage/metrics"
 )
 
+const (
+	// eventsPanelSize is the number of the recent system events shown.
+	eventsPanelSize = 10
+	// processesPanelSize is the number of the recent processes starting and exiting shown.
+	processesPanelSize = 5
+)
+
+// eventsLister defines the interface for listing the recent system events.
+type eventsLister interface {
+	// List returns the recent events from the oldest to the newest
+	List(ctx context.Context) []models.Event
+}
+
+// collectorsSwitches defines the interface for the collectors enabled and disabled at runtime.
+type collectorsSwitches interface {
+	// Enabled reports whether the collector of the name is enabled
+	Enabled(name string) bool
+}
+
 // metricsStringBuilder is a helper struct for building the metrics output.
 type metricsStringBuilder struct {
 	sb strings.Builder
@@ -46,23 +60,75 @@ func NewMetricsStringBuilder() *metricsStringBuilder {
 
 // append appends the metric name and the string representation of the metric
 // or print the error if the metric parsing failed.
+// The metric of the last successful collection is printed below the error marked stale if there is one.
 func (m *metricsStringBuilder) append(metricName, s string, err error) {
 	m.sb.WriteString(utils.BgGreenText(utils.BoldText(metricName)) + "\n")
 
 	if err != nil {
 		switch {
-		case errors.Is(err, metrics.ErrInvalidOutput):
-			log.Fatalf("%s: failed to parse %s: %s\n", utils.BgRedText("ERROR"), metricName, err)
+		case errors.Is(err, metrics.ErrTimeout):
+			m.sb.WriteString(fmt.Sprintf("%s: %s\n", utils.BgRedText("TIMEOUT"), err))
+		case errors.Is(err, metrics.ErrWarmingUp):
+			m.sb.WriteString(utils.GrayText("Warming up") + "\n\n")
 			return
 		default:
 			m.sb.WriteString(fmt.Sprintf("%s: %s\n", utils.BgRedText("ERROR"), err))
+		}
+		if s == "" {
 			return
 		}
+		m.sb.WriteString(utils.GrayText("Stale, the last collected:") + "\n")
 	}
 
 	m.sb.WriteString(fmt.Sprintf("%s\n\n", s))
 }
 
+// appendTick appends the sequence number of the tick and the scheduler statistics.
+func (m *metricsStringBuilder) appendTick(tick models.Tick, stats models.SchedulerStats) {
+	m.sb.WriteString(fmt.Sprintf("Tick #%d at %s\n", tick.Seq, tick.Time.Format(time.TimeOnly)))
+	m.sb.WriteString(stats.String() + "\n\n")
+}
+
+// appendEvents appends the most recent system events.
+// The processes starting and exiting are shown apart not to push the kernel events out of the panel,
+// and only if they are watched.
+func (m *metricsStringBuilder) appendEvents(events []models.Event) {
+	var system, processes []models.Event
+	for _, e := range events {
+		if e.Lifecycle() {
+			processes = append(processes, e)
+			continue
+		}
+		system = append(system, e)
+	}
+
+	m.appendEventsPanel("Events", system, eventsPanelSize)
+	if len(processes) > 0 {
+		m.appendEventsPanel("Processes", processes, processesPanelSize)
+	}
+}
+
+// appendEventsPanel appends the panel of the most recent events up to the size.
+func (m *metricsStringBuilder) appendEventsPanel(title string, events []models.Event, size int) {
+	m.sb.WriteString(utils.BgGreenText(utils.BoldText(title)) + "\n")
+
+	if len(events) == 0 {
+		m.sb.WriteString(utils.GrayText("No events") + "\n\n")
+		return
+	}
+
+	// The newest events first
+	for i := len(events) - 1; i >= max(0, len(events)-size); i-- {
+		m.sb.WriteString(events[i].String() + "\n")
+	}
+	m.sb.WriteString("\n")
+}
+
+// appendStatus appends the health of the metrics collectors as the footer.
+func (m *metricsStringBuilder) appendStatus(status models.CollectorsStatus) {
+	m.sb.WriteString(status.String() + "\n")
+}
+
 // String returns the string representation of the metrics.
 func (m *metricsStringBuilder) String() string {
 	return m.sb.String()
@@ -77,27 +143,37 @@ func (m *metricsStringBuilder) Print() {
 }
 
 // run parses the metrics collection in real-time mode.
-func run(ctx context.Context, cfg *config) {
-	// Get the metrics to parse
-	metricsToParse := getMetricsToParse(cfg, []metrics.Type{
-		metrics.CPU,
-		metrics.LoadAverage,
-		metrics.Memory,
-		metrics.Disk,
-	})
-	if len(metricsToParse) == 0 {
+// The collectors disabled are skipped until they are enabled.
+func run(
+	ctx context.Context, cfg *config, collectors []metrics.Collector, switches collectorsSwitches, events eventsLister,
+) {
+	if len(collectors) == 0 {
 		log.Fatalf("%s: no metrics to parse\n", utils.BgRedText("ERROR"))
 	}
 
 	n := time.Duration(cfg.Interval) * time.Second
 	m := time.Duration(cfg.Margin) * time.Second
 
+	ticks, err := scheduler.NewScheduler(n, cfg.schedulerPolicy())
+	if err != nil {
+		log.Fatalf("%s: failed to create the scheduler: %s\n", utils.BgRedText("ERROR"), err)
+	}
+
 	// Start the spinner and wait for the duration
 	spinnerCh := make(chan bool)
 	go spinner(m, spinnerCh)
+
+	// Take the first snapshots of the rate-based metrics while waiting,
+	// so the first tick shows the rates since them
+	metricsCollection := newCollection(cfg, collectors, switches)
+	primed := make(chan struct{})
+	go func() {
+		defer close(primed)
+		metricsCollection.prime(ctx)
+	}()
 	time.Sleep(m)
+	<-primed
 	spinnerCh <- true // Stop the spinner
-	var wg sync.WaitGroup
 
 	// Create a new storage instance to store the metrics
 	storage := storage.NewStorage()
@@ -105,45 +181,27 @@ func run(ctx context.Context, cfg *config) {
 	// Clear the cli screen before printing the metrics
 	clearScreen()
 
-	var err error
-	ticker := time.NewTicker(n)
-	for range ticker.C {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			// Builder for storing the metrics output to be printed
-			res := NewMetricsStringBuilder()
-
-			// Collectig the metrics
-			execer := cmd.NewExecer()
-			stats := models.Metrics{}
-			for _, metricType := range metricsToParse {
-				switch metricType {
-				case metrics.Undefined:
-					log.Fatalf("%s: undefined metric type\n", utils.BgRedText("ERROR"))
-				case metrics.CPU:
-					stats.CPUStats, err = cpu.NewParser(execer).Parse(ctx)
-					res.append("CPU Usage", stats.CPUStats.String(), err)
-				case metrics.LoadAverage:
-					stats.LoadAverageStats, err = loadavg.NewParser(execer).Parse(ctx)
-					res.append("Load Average", stats.LoadAverageStats.String(), err)
-				case metrics.Memory:
-					stats.MemoryStats, err = memory.NewParser(execer).Parse(ctx)
-					res.append("Memory", stats.MemoryStats.String(), err)
-				case metrics.Disk:
-					stats.DiskStats, err = disk.NewParser(execer).Parse(ctx)
-					res.append("Disk Usage", stats.DiskStats.String(), err)
-				}
-			}
+	// The ticks never overlap, so the collection state is accessed by one tick at a time
+	ticks.Run(ctx, func(ctx context.Context, tick models.Tick) {
+		// Builder for storing the metrics output to be printed
+		res := NewMetricsStringBuilder()
+		res.appendTick(tick, ticks.Stats())
 
-			// Store the metrics
-			if err = storage.Set(ctx, stats); err != nil {
-				log.Fatalf("%s: failed to store the metrics: %s\n", utils.BgRedText("ERROR"), err)
-			}
+		// Collectig the metrics due
+		stats := models.Metrics{Tick: tick}
+		metricsCollection.tick(ctx, &stats, res)
 
-			res.Print()
-		}()
-	}
+		res.appendEvents(events.List(ctx))
+		res.appendStatus(stats.Collectors)
+
+		// Store the metrics
+		stats.Scheduler = ticks.Stats()
+		if err := storage.Set(ctx, stats); err != nil {
+			log.Fatalf("%s: failed to store the metrics: %s\n", utils.BgRedText("ERROR"), err)
+		}
+
+		res.Print()
+	})
 }
 
 // spinner shows a spinner while waiting for the duration.
@@ -174,20 +232,3 @@ func clearLines(n int) {
 		fmt.Print("\033[A\033[K")
 	}
 }
-
-// getMetricsToParse returns the metrics to parse.
-func getMetricsToParse(cfg *config, allMetrics []metrics.Type) []metrics.Type {
-	excludedMetrics := make(map[string]struct{})
-	for _, metric := range cfg.Exclude.Metrics {
-		excludedMetrics[metric] = struct{}{}
-	}
-
-	metricsToParse := make([]metrics.Type, 0, len(allMetrics))
-	for _, metric := range allMetrics {
-		if _, excluded := excludedMetrics[metric.String()]; !excluded {
-			metricsToParse = append(metricsToParse, metric)
-		}
-	}
-
-	return metricsToParse
-}
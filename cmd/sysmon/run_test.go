@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+func Test_metricsStringBuilder_appendEvents(t *testing.T) {
+	t.Parallel()
+
+	events := []models.Event{{Kind: models.EventOOMKill, Message: "Out of memory: Killed process 4242 (java)"}}
+	for i := range 20 {
+		events = append(events, models.Event{Kind: models.EventProcessStart, Message: fmt.Sprintf("Started job-%02d", i)})
+	}
+
+	m := &metricsStringBuilder{}
+	m.appendEvents(events)
+	got := m.String()
+
+	// The processes churn does not push the kernel events out of the panel
+	require.Contains(t, got, "Out of memory: Killed process 4242 (java)")
+	require.Contains(t, got, "Processes")
+	require.Equal(t, processesPanelSize, strings.Count(got, "Started job-"))
+	require.Contains(t, got, "Started job-19")
+	require.NotContains(t, got, "Started job-14")
+
+	// The processes panel is not shown if they are not watched
+	m = &metricsStringBuilder{}
+	m.appendEvents(events[:1])
+	require.NotContains(t, m.String(), "Processes")
+}
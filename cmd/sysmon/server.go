@@ -1,25 +1,84 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"net"
+	"os"
+	"time"
 
 	"google.golang.org/grpc"
 
 	api "github.com/sitnikovik/sysmon/internal/api"
+	"github.com/sitnikovik/sysmon/internal/metrics/blockdev"
+	"github.com/sitnikovik/sysmon/internal/metrics/sysinfo"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
 	"github.com/sitnikovik/sysmon/internal/storage/metrics"
 	pb "github.com/sitnikovik/sysmon/pkg/v1/api"
 )
 
-// runGRPCServer runs the gRPC server.
-func runGRPCServer(grpcPort int) error {
+// systemInfoTTL is the time the system inventory is cached for since it is changed rarely.
+const systemInfoTTL = time.Hour
+
+// adminSocketMode is the mode of the admin socket, so only the user the app runs as may connect to it.
+const adminSocketMode = 0o600
+
+// runGRPCServer runs the gRPC server of the read-only SystemStats service.
+func runGRPCServer(
+	grpcPort int,
+	paths fs.Paths,
+	collectors api.CollectorsLister,
+	switches api.CollectorsSwitches,
+	events api.EventsStorage,
+) error {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
 	if err != nil {
 		return err
 	}
 
 	s := grpc.NewServer()
-	pb.RegisterSystemStatsServer(s, api.NewImplementation(metrics.NewStorage()))
+	pb.RegisterSystemStatsServer(s, api.NewImplementation(
+		metrics.NewStorage(),
+		sysinfo.NewCachedParser(cmd.NewExecer(), paths, systemInfoTTL),
+		blockdev.NewParser(cmd.NewExecer(), paths),
+		events,
+		collectors,
+		switches,
+	))
 
 	return s.Serve(lis)
 }
+
+// runAdminServer runs the gRPC server of the SystemAdmin service on the unix socket
+// apart from the public one, so only the local users with the access to the socket may change the app.
+func runAdminServer(socket string, collectors api.CollectorsLister, switches api.CollectorsSwitches) error {
+	lis, err := listenAdmin(socket)
+	if err != nil {
+		return err
+	}
+
+	s := grpc.NewServer()
+	pb.RegisterSystemAdminServer(s, api.NewAdminImplementation(collectors, switches))
+
+	return s.Serve(lis)
+}
+
+// listenAdmin listens on the unix socket accessible by the user the app runs as only.
+// The socket left by the previous run is removed.
+func listenAdmin(socket string) (net.Listener, error) {
+	if err := os.Remove(socket); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to remove the stale socket: %w", err)
+	}
+
+	lis, err := net.Listen("unix", socket)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.Chmod(socket, adminSocketMode); err != nil {
+		lis.Close()
+		return nil, fmt.Errorf("failed to restrict the socket access: %w", err)
+	}
+
+	return lis, nil
+}
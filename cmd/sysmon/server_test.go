@@ -0,0 +1,32 @@
+//go:build unix
+
+package main
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_listenAdmin(t *testing.T) {
+	t.Parallel()
+
+	// The socket left by the previous run does not prevent listening
+	socket := filepath.Join(t.TempDir(), "admin.sock")
+	require.NoError(t, os.WriteFile(socket, nil, 0o666))
+
+	lis, err := listenAdmin(socket)
+	require.NoError(t, err)
+	defer lis.Close()
+
+	info, err := os.Stat(socket)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(adminSocketMode), info.Mode().Perm())
+
+	conn, err := net.Dial("unix", socket)
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+}
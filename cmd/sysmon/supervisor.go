@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	api "github.com/sitnikovik/sysmon/internal/api"
+	"github.com/sitnikovik/sysmon/internal/metrics"
+)
+
+// supervisedCollector defines the interface for the collector running in the background like the plugin.
+type supervisedCollector interface {
+	metrics.Collector
+	// Run supervises the collector until the context is done
+	Run(ctx context.Context)
+}
+
+// collectorsSupervisor runs the supervised collectors like the plugins while they are enabled.
+// It switches the collectors on and off, so the ones enabled at runtime are started
+// and the ones disabled are stopped.
+type collectorsSupervisor struct {
+	ctx      context.Context
+	switches api.CollectorsSwitches
+
+	mu         sync.Mutex
+	wg         sync.WaitGroup
+	collectors map[string]supervisedCollector
+	// cancels stops the collectors running by their names.
+	cancels map[string]context.CancelFunc
+	// done is closed once the last run of the collector is stopped by their names,
+	// so the collector enabled again is not run along with its previous run still stopping.
+	done map[string]chan struct{}
+}
+
+// newCollectorsSupervisor returns the supervisor of the collectors switched by the switches
+// running them until the context is done.
+func newCollectorsSupervisor(
+	ctx context.Context, collectors []metrics.Collector, switches api.CollectorsSwitches,
+) *collectorsSupervisor {
+	s := &collectorsSupervisor{
+		ctx:        ctx,
+		switches:   switches,
+		collectors: make(map[string]supervisedCollector),
+		cancels:    make(map[string]context.CancelFunc),
+		done:       make(map[string]chan struct{}),
+	}
+	for _, c := range collectors {
+		if sc, ok := c.(supervisedCollector); ok {
+			s.collectors[c.Name()] = sc
+		}
+	}
+
+	return s
+}
+
+// Start runs the supervised collectors enabled.
+func (s *collectorsSupervisor) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name := range s.collectors {
+		if s.switches.Enabled(name) {
+			s.start(name)
+		}
+	}
+}
+
+// Wait waits for all the collectors run to be stopped once the context is done.
+func (s *collectorsSupervisor) Wait() {
+	s.wg.Wait()
+}
+
+// Enabled reports whether the collector of the name is enabled.
+func (s *collectorsSupervisor) Enabled(name string) bool {
+	return s.switches.Enabled(name)
+}
+
+// SetEnabled enables or disables the collector of the name starting or stopping it if it is supervised.
+func (s *collectorsSupervisor) SetEnabled(name string, enabled bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.switches.SetEnabled(name, enabled)
+	if _, ok := s.collectors[name]; !ok {
+		return
+	}
+
+	if enabled {
+		s.start(name)
+		return
+	}
+	if cancel, ok := s.cancels[name]; ok {
+		cancel()
+		delete(s.cancels, name)
+	}
+}
+
+// start runs the collector of the name if it is not running.
+func (s *collectorsSupervisor) start(name string) {
+	if _, ok := s.cancels[name]; ok {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	prev, done := s.done[name], make(chan struct{})
+	s.cancels[name], s.done[name] = cancel, done
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer close(done)
+
+		if prev != nil {
+			<-prev
+		}
+		s.collectors[name].Run(ctx)
+	}()
+}
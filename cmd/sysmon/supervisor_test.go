@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+)
+
+// fakeSupervisedCollector is the supervised collector counting its runs.
+type fakeSupervisedCollector struct {
+	fakeCollector
+	runs    atomic.Int64
+	running atomic.Int64
+}
+
+func (c *fakeSupervisedCollector) Run(ctx context.Context) {
+	c.runs.Add(1)
+	if c.running.Add(1) > 1 {
+		panic("run concurrently")
+	}
+	defer c.running.Add(-1)
+
+	<-ctx.Done()
+	// Stopping takes a while like killing the plugin
+	time.Sleep(10 * time.Millisecond)
+}
+
+func Test_collectorsSupervisor(t *testing.T) {
+	t.Parallel()
+
+	enabled := &fakeSupervisedCollector{fakeCollector: fakeCollector{name: "gpu"}}
+	disabled := &fakeSupervisedCollector{fakeCollector: fakeCollector{name: "app"}}
+	builtin := &fakeCollector{name: "cpu"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := newCollectorsSupervisor(
+		ctx, []metrics.Collector{enabled, disabled, builtin}, metrics.NewSwitches("app"),
+	)
+	s.Start()
+
+	// The disabled collectors are not run
+	require.Eventually(t, func() bool { return enabled.running.Load() == 1 }, time.Second, time.Millisecond)
+	require.Zero(t, disabled.runs.Load())
+
+	// The collector enabled is run and the one disabled is stopped
+	s.SetEnabled("app", true)
+	s.SetEnabled("gpu", false)
+	require.True(t, s.Enabled("app"))
+	require.False(t, s.Enabled("gpu"))
+	require.Eventually(t, func() bool { return disabled.running.Load() == 1 }, time.Second, time.Millisecond)
+	require.Eventually(t, func() bool { return enabled.running.Load() == 0 }, time.Second, time.Millisecond)
+
+	// The collector enabled again is run once its previous run is stopped
+	s.SetEnabled("gpu", true)
+	s.SetEnabled("gpu", false)
+	s.SetEnabled("gpu", true)
+	s.SetEnabled("gpu", true)
+	require.Eventually(t, func() bool { return enabled.runs.Load() == 3 }, time.Second, time.Millisecond)
+
+	// The built-in collectors are switched only
+	s.SetEnabled("cpu", false)
+	require.False(t, s.Enabled("cpu"))
+
+	cancel()
+	s.Wait()
+	require.Zero(t, enabled.running.Load())
+	require.Zero(t, disabled.running.Load())
+	require.EqualValues(t, 1, disabled.runs.Load())
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"runtime"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	v1 "github.com/sitnikovik/sysmon/pkg/v1/api"
+)
+
+// CollectorsSwitches defines the interface for enabling and disabling the collectors at runtime.
+type CollectorsSwitches interface {
+	// Enabled reports whether the collector of the name is enabled
+	Enabled(name string) bool
+	// SetEnabled enables or disables the collector of the name
+	SetEnabled(name string, enabled bool)
+}
+
+type AdminImplementation struct {
+	v1.UnimplementedSystemAdminServer
+
+	collectors CollectorsLister
+	switches   CollectorsSwitches
+}
+
+// NewAdminImplementation returns a new instance of the administration API Implementation.
+func NewAdminImplementation(collectors CollectorsLister, switches CollectorsSwitches) *AdminImplementation {
+	return &AdminImplementation{
+		collectors: collectors,
+		switches:   switches,
+	}
+}
+
+// SetCollectorEnabled enables or disables the collector with no restart.
+// The collector enabled is collected on the next tick.
+func (i *AdminImplementation) SetCollectorEnabled(
+	_ context.Context, req *v1.SetCollectorEnabledRequest,
+) (*v1.SetCollectorEnabledResponse, error) {
+	var collector metrics.Collector
+	for _, c := range i.collectors.List() {
+		if c.Name() == req.GetName() {
+			collector = c
+			break
+		}
+	}
+	if collector == nil {
+		return nil, status.Errorf(codes.NotFound, "collector %s is not registered", req.GetName())
+	}
+	if req.GetEnabled() && !metrics.Supports(collector, runtime.GOOS) {
+		return nil, status.Errorf(
+			codes.FailedPrecondition, "collector %s is not supported on %s", req.GetName(), runtime.GOOS,
+		)
+	}
+
+	i.switches.SetEnabled(req.GetName(), req.GetEnabled())
+
+	return &v1.SetCollectorEnabledResponse{
+		Name:    req.GetName(),
+		Enabled: i.switches.Enabled(req.GetName()),
+	}, nil
+}
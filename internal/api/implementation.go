@@ -2,7 +2,12 @@ package server
 
 import (
 	"context"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/sitnikovik/sysmon/internal/metrics"
 	"github.com/sitnikovik/sysmon/internal/models"
 	v1 "github.com/sitnikovik/sysmon/pkg/v1/api"
 )
@@ -15,17 +20,61 @@ type Storage interface {
 	Set(ctx context.Context, m models.Metrics) error
 }
 
+// SystemInfoParser defines the interface for parsing the hardware and OS inventory of the system.
+type SystemInfoParser interface {
+	// Parse returns the inventory of the system
+	Parse(ctx context.Context) (models.SystemInfo, error)
+}
+
+// EventsStorage defines the interface for the storage of the system events.
+type EventsStorage interface {
+	// List returns the recent events from the oldest to the newest
+	List(ctx context.Context) []models.Event
+	// Subscribe returns the channel receiving the new events until the context is done
+	Subscribe(ctx context.Context) <-chan models.Event
+}
+
+// CollectorsLister defines the interface for listing the registered metrics collectors.
+type CollectorsLister interface {
+	// List returns the collectors in the order they were registered
+	List() []metrics.Collector
+}
+
+// BlockDevicesParser defines the interface for parsing the block devices inventory.
+type BlockDevicesParser interface {
+	// Parse returns the block devices with their partitions and mount points
+	Parse(ctx context.Context) ([]models.BlockDevice, error)
+}
+
 type Implementation struct {
 	v1.UnimplementedSystemStatsServer
 
 	// storage for the metrics
 	storage Storage
+	// systemInfo is the parser of the system inventory
+	systemInfo   SystemInfoParser
+	blockDevices BlockDevicesParser
+	events       EventsStorage
+	collectors   CollectorsLister
+	switches     CollectorsSwitches
 }
 
 // NewImplementation returns a new instance of the API Implementation.
-func NewImplementation(storage Storage) *Implementation {
+func NewImplementation(
+	storage Storage,
+	systemInfo SystemInfoParser,
+	blockDevices BlockDevicesParser,
+	events EventsStorage,
+	collectors CollectorsLister,
+	switches CollectorsSwitches,
+) *Implementation {
 	return &Implementation{
-		storage: storage,
+		storage:      storage,
+		systemInfo:   systemInfo,
+		blockDevices: blockDevices,
+		events:       events,
+		collectors:   collectors,
+		switches:     switches,
 	}
 }
 
@@ -40,6 +89,200 @@ func (i *Implementation) GetStats(ctx context.Context, _ *v1.StatsRequest) (*v1.
 	return metricsToStatsResponse(m), nil
 }
 
+// GetProcessLimits returns the processes closest to their resource limits.
+func (i *Implementation) GetProcessLimits(
+	ctx context.Context,
+	_ *v1.ProcessLimitsRequest,
+) (*v1.ProcessLimitsResponse, error) {
+	m, err := i.storage.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &v1.ProcessLimitsResponse{
+		Processes: make([]*v1.ProcessLimitsResponse_Process, 0, len(m.ProcessLimitsStats.Processes)),
+	}
+	for _, p := range m.ProcessLimitsStats.Processes {
+		res.Processes = append(res.Processes, &v1.ProcessLimitsResponse_Process{
+			Pid:            int32(p.PID),
+			Name:           p.Name,
+			Watched:        p.Watched,
+			OpenFiles:      limitUsageToResponse(p.OpenFiles),
+			Processes:      limitUsageToResponse(p.Processes),
+			LockedMemoryKb: limitUsageToResponse(p.LockedMemoryKb),
+			MaxUsedPercent: p.MaxUsedPercent,
+		})
+	}
+
+	return res, nil
+}
+
+// GetSystemInfo returns the hardware and OS inventory of the system.
+func (i *Implementation) GetSystemInfo(ctx context.Context, _ *v1.SystemInfoRequest) (*v1.SystemInfoResponse, error) {
+	info, err := i.systemInfo.Parse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.SystemInfoResponse{
+		Hostname:      info.Hostname,
+		Os:            info.OS,
+		Arch:          info.Arch,
+		KernelRelease: info.KernelRelease,
+		Distro:        info.Distro,
+		CpuModel:      info.CPUModel,
+		CpuSockets:    int32(info.CPUSockets),
+		CpuCores:      int32(info.CPUCores),
+		CpuThreads:    int32(info.CPUThreads),
+		TotalMemoryMb: info.TotalMemoryMb,
+		BootTime:      info.BootTime.Unix(),
+		UptimeSec:     int64(info.Uptime.Seconds()),
+		Vendor:        info.Vendor,
+		Product:       info.Product,
+		MachineId:     info.MachineID,
+	}, nil
+}
+
+// GetBlockDevices returns the block devices with their partitions and mount points.
+func (i *Implementation) GetBlockDevices(ctx context.Context, _ *v1.BlockDevicesRequest) (*v1.BlockDevicesResponse, error) {
+	devices, err := i.blockDevices.Parse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &v1.BlockDevicesResponse{
+		Devices: make([]*v1.BlockDevicesResponse_BlockDevice, 0, len(devices)),
+	}
+	for _, dev := range devices {
+		partitions := make([]*v1.BlockDevicesResponse_Partition, 0, len(dev.Partitions))
+		for _, part := range dev.Partitions {
+			partitions = append(partitions, &v1.BlockDevicesResponse_Partition{
+				Name:        part.Name,
+				SizeBytes:   part.SizeBytes,
+				StartBytes:  part.StartBytes,
+				MountPoints: mountPointsToResponse(part.MountPoints),
+			})
+		}
+
+		res.Devices = append(res.Devices, &v1.BlockDevicesResponse_BlockDevice{
+			Name:        dev.Name,
+			Model:       dev.Model,
+			Serial:      dev.Serial,
+			SizeBytes:   dev.SizeBytes,
+			Rotational:  dev.Rotational,
+			Removable:   dev.Removable,
+			Scheduler:   dev.Scheduler,
+			DmName:      dev.DMName,
+			Partitions:  partitions,
+			MountPoints: mountPointsToResponse(dev.MountPoints),
+		})
+	}
+
+	return res, nil
+}
+
+// ListCollectors returns the registered metrics collectors.
+func (i *Implementation) ListCollectors(_ context.Context, _ *v1.ListCollectorsRequest) (*v1.ListCollectorsResponse, error) {
+	collectors := i.collectors.List()
+
+	res := &v1.ListCollectorsResponse{
+		Collectors: make([]*v1.ListCollectorsResponse_Collector, 0, len(collectors)),
+	}
+	for _, c := range collectors {
+		res.Collectors = append(res.Collectors, &v1.ListCollectorsResponse_Collector{
+			Name:        c.Name(),
+			Description: c.Description(),
+			Platforms:   c.Platforms(),
+			OptIn:       c.OptIn(),
+			Supported:   metrics.Supports(c, runtime.GOOS),
+			Enabled:     metrics.Supports(c, runtime.GOOS) && i.switches.Enabled(c.Name()),
+		})
+	}
+
+	return res, nil
+}
+
+// GetCollectorStatus returns the health of the metrics collectors filtered by the names.
+func (i *Implementation) GetCollectorStatus(
+	ctx context.Context, req *v1.CollectorStatusRequest,
+) (*v1.CollectorStatusResponse, error) {
+	m, err := i.storage.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &v1.CollectorStatusResponse{
+		Collectors: make([]*v1.CollectorStatusResponse_Collector, 0, len(m.Collectors)),
+	}
+	for _, c := range m.Collectors {
+		if len(req.GetNames()) > 0 && !slices.Contains(req.GetNames(), c.Name) {
+			continue
+		}
+
+		res.Collectors = append(res.Collectors, &v1.CollectorStatusResponse_Collector{
+			Name:                c.Name,
+			Collections:         c.Collections,
+			Failures:            c.Failures,
+			ConsecutiveFailures: c.ConsecutiveFailures,
+			LastSuccessMs:       timeToMs(c.LastSuccess),
+			LastError:           c.LastError,
+			LastErrorMs:         timeToMs(c.LastErrorTime),
+			LastDurationMs:      durationToMs(c.LastDuration),
+			Duration:            histogramToResponse(c.Duration),
+			LastSamples:         uint64(c.LastSamples),
+			Samples:             c.Samples,
+			Stale:               c.Stale,
+		})
+	}
+
+	return res, nil
+}
+
+// GetSamples returns the samples of the collected metrics filtered by the name prefixes.
+func (i *Implementation) GetSamples(ctx context.Context, req *v1.SamplesRequest) (*v1.SampleBatch, error) {
+	m, err := i.storage.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &v1.SampleBatch{
+		TimeMs:  m.Tick.Time.UnixMilli(),
+		Samples: make([]*v1.Sample, 0, len(m.Samples)),
+		Seq:     m.Tick.Seq,
+	}
+	for _, s := range m.Samples {
+		if !hasAnyPrefix(s.Name, req.GetPrefixes()) {
+			continue
+		}
+
+		res.Samples = append(res.Samples, &v1.Sample{
+			Name:   s.Name,
+			Labels: s.Labels,
+			Unit:   s.Unit,
+			Type:   string(s.Type),
+			Value:  s.Value,
+			TimeMs: s.Timestamp.UnixMilli(),
+		})
+	}
+
+	return res, nil
+}
+
+// hasAnyPrefix reports whether the name has any of the prefixes or there are no prefixes.
+func hasAnyPrefix(name string, prefixes []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // metricsToStatsResponse converts the metrics to the StatsResponse.
 func metricsToStatsResponse(m models.Metrics) *v1.StatsResponse {
 	return &v1.StatsResponse{
@@ -58,18 +301,520 @@ func metricsToStatsResponse(m models.Metrics) *v1.StatsResponse {
 			UsedInodes:        m.DiskStats.UsedInodes,
 			UsedInodesPercent: m.DiskStats.UsedInodesPercent,
 		},
-		Memory: &v1.StatsResponse_Memory{
-			TotalMb:     m.MemoryStats.TotalMb,
-			AvailableMb: m.MemoryStats.AvailableMb,
-			FreeMb:      m.MemoryStats.FreeMb,
-			ActiveMb:    m.MemoryStats.ActiveMb,
-			InactiveMb:  m.MemoryStats.InactiveMb,
-			WiredMb:     m.MemoryStats.WiredMb,
-		},
+		Memory: memoryToResponse(m.MemoryStats),
 		LoadAverage: &v1.StatsResponse_LoadAverage{
 			OneMin:     m.LoadAverageStats.OneMin,
 			FiveMin:    m.LoadAverageStats.FiveMin,
 			FifteenMin: m.LoadAverageStats.FifteenMin,
 		},
+		Interrupts: &v1.StatsResponse_Interrupts{
+			Cpus:       int32(m.InterruptsStats.CPUs),
+			TopIrqs:    irqsToResponse(m.InterruptsStats.TopIRQs),
+			LocalIrqs:  irqsToResponse(m.InterruptsStats.LocalIRQs),
+			SoftIrqs:   irqsToResponse(m.InterruptsStats.SoftIRQs),
+			PerCpu:     m.InterruptsStats.PerCPU,
+			BusiestCpu: int32(m.InterruptsStats.BusiestCPU),
+			Imbalance:  m.InterruptsStats.Imbalance,
+		},
+		NetProto: netProtoToResponse(m.NetProtoStats),
+		Limits: &v1.StatsResponse_Limits{
+			FileHandles:      limitUsageToResponse(m.LimitsStats.FileHandles),
+			Pids:             limitUsageToResponse(m.LimitsStats.PIDs),
+			Conntrack:        limitUsageToResponse(m.LimitsStats.Conntrack),
+			InotifyWatches:   limitUsageToResponse(m.LimitsStats.InotifyWatches),
+			InotifyInstances: limitUsageToResponse(m.LimitsStats.InotifyInstances),
+		},
+		Thermal: thermalToResponse(m.ThermalStats),
+		MdStat:  mdStatToResponse(m.MDStatStats),
+		ZfsArc: &v1.StatsResponse_ZFSArc{
+			SizeBytes:   m.ZFSArcStats.SizeBytes,
+			TargetBytes: m.ZFSArcStats.TargetBytes,
+			MinBytes:    m.ZFSArcStats.MinBytes,
+			MaxBytes:    m.ZFSArcStats.MaxBytes,
+			Hits:        m.ZFSArcStats.Hits,
+			Misses:      m.ZFSArcStats.Misses,
+			HitPercent:  m.ZFSArcStats.HitPercent,
+		},
+		TimeSync: &v1.StatsResponse_TimeSync{
+			Synchronised: m.TimeSyncStats.Synchronised,
+			State:        m.TimeSyncStats.State,
+			OffsetMs:     m.TimeSyncStats.OffsetMs,
+			MaxErrorMs:   m.TimeSyncStats.MaxErrorMs,
+			EstErrorMs:   m.TimeSyncStats.EstErrorMs,
+			FreqPpm:      m.TimeSyncStats.FreqPPM,
+			TaiOffsetSec: int32(m.TimeSyncStats.TAIOffsetSec),
+		},
+		Sessions:  sessionsToResponse(m.SessionsStats),
+		ProcState: procStateToResponse(m.ProcStateStats),
+		SockStat:  sockStatToResponse(m.SockStatStats),
+		NetDev:    netDevToResponse(m.NetDevStats),
+		VmStat: &v1.StatsResponse_VMStat{
+			PageFaults:  m.VMStatStats.PageFaults,
+			MajorFaults: m.VMStatStats.MajorFaults,
+			SwapIns:     m.VMStatStats.SwapIns,
+			SwapOuts:    m.VMStatStats.SwapOuts,
+			PagedInKb:   m.VMStatStats.PagedInKb,
+			PagedOutKb:  m.VMStatStats.PagedOutKb,
+		},
+		Custom:        customToResponse(m.CustomStats),
+		Stale:         staleCollectors(m.Collectors),
+		CollectedAtMs: collectedAtToResponse(m.CollectedAt),
+		Tick: &v1.StatsResponse_Tick{
+			Seq:       m.Tick.Seq,
+			TimeMs:    m.Tick.Time.UnixMilli(),
+			JitterMs:  durationToMs(m.Tick.Jitter()),
+			Coalesced: m.Tick.Coalesced,
+		},
+		Scheduler: &v1.StatsResponse_Scheduler{
+			Policy:       m.Scheduler.Policy,
+			Scheduled:    m.Scheduler.Scheduled,
+			Run:          m.Scheduler.Run,
+			Skipped:      m.Scheduler.Skipped,
+			Coalesced:    m.Scheduler.Coalesced,
+			Overruns:     m.Scheduler.Overruns,
+			LastJitterMs: durationToMs(m.Scheduler.LastJitter),
+			MeanJitterMs: durationToMs(m.Scheduler.MeanJitter),
+			MaxJitterMs:  durationToMs(m.Scheduler.MaxJitter),
+		},
+	}
+}
+
+// durationToMs converts the duration to milliseconds.
+func durationToMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// timeToMs converts the time to Unix time in milliseconds, the zero time to 0.
+func timeToMs(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
 	}
+
+	return t.UnixMilli()
+}
+
+// histogramToResponse converts the durations histogram to the response one.
+func histogramToResponse(h models.DurationHistogram) *v1.CollectorStatusResponse_Histogram {
+	res := &v1.CollectorStatusResponse_Histogram{
+		BoundsMs: make([]float64, 0, len(models.DurationBuckets)),
+		Counts:   h.Counts,
+		SumMs:    durationToMs(h.Sum),
+		Count:    h.Count,
+	}
+	for _, b := range models.DurationBuckets {
+		res.BoundsMs = append(res.BoundsMs, durationToMs(b))
+	}
+
+	return res
+}
+
+// memoryToResponse converts the memory statistics to the response ones.
+func memoryToResponse(m models.MemoryStats) *v1.StatsResponse_Memory {
+	res := &v1.StatsResponse_Memory{
+		TotalMb:           m.TotalMb,
+		AvailableMb:       m.AvailableMb,
+		FreeMb:            m.FreeMb,
+		ActiveMb:          m.ActiveMb,
+		InactiveMb:        m.InactiveMb,
+		WiredMb:           m.WiredMb,
+		SlabMb:            m.SlabMb,
+		SlabReclaimableMb: m.SlabReclaimableMb,
+		HugetlbMb:         m.HugetlbMb,
+		NumaNodes:         make([]*v1.StatsResponse_Memory_NUMANode, 0, len(m.NUMANodes)),
+		HugePages:         make([]*v1.StatsResponse_Memory_HugePagePool, 0, len(m.HugePages)),
+		TopSlabCaches:     make([]*v1.StatsResponse_Memory_SlabCache, 0, len(m.TopSlabCaches)),
+	}
+	for _, n := range m.NUMANodes {
+		res.NumaNodes = append(res.NumaNodes, &v1.StatsResponse_Memory_NUMANode{
+			Node:          int32(n.Node),
+			TotalMb:       n.TotalMb,
+			FreeMb:        n.FreeMb,
+			UsedMb:        n.UsedMb,
+			FilePagesMb:   n.FilePagesMb,
+			AnonPagesMb:   n.AnonPagesMb,
+			SlabMb:        n.SlabMb,
+			HugePages:     n.HugePages,
+			HugePagesFree: n.HugePagesFree,
+		})
+	}
+	for _, p := range m.HugePages {
+		res.HugePages = append(res.HugePages, &v1.StatsResponse_Memory_HugePagePool{
+			SizeKb:   p.SizeKb,
+			Total:    p.Total,
+			Free:     p.Free,
+			Reserved: p.Reserved,
+			Surplus:  p.Surplus,
+		})
+	}
+	for _, c := range m.TopSlabCaches {
+		res.TopSlabCaches = append(res.TopSlabCaches, &v1.StatsResponse_Memory_SlabCache{
+			Name:          c.Name,
+			ActiveObjects: c.ActiveObjects,
+			Objects:       c.Objects,
+			SizeKb:        c.SizeKb,
+		})
+	}
+
+	return res
+}
+
+// sockStatToResponse converts the sockets usage statistics to the response ones.
+func sockStatToResponse(s models.SockStatStats) *v1.StatsResponse_SockStat {
+	res := &v1.StatsResponse_SockStat{
+		SocketsUsed: s.SocketsUsed,
+		Tcp: &v1.StatsResponse_SockStat_TCP{
+			InUse:    s.TCP.InUse,
+			InUse6:   s.TCP.InUse6,
+			Orphan:   s.TCP.Orphan,
+			TimeWait: s.TCP.TimeWait,
+			Alloc:    s.TCP.Alloc,
+			Memory:   sockMemoryToResponse(s.TCP.Memory),
+		},
+		Udp: &v1.StatsResponse_SockStat_UDP{
+			InUse:  s.UDP.InUse,
+			InUse6: s.UDP.InUse6,
+			Memory: sockMemoryToResponse(s.UDP.Memory),
+		},
+		RawInUse: s.RawInUse,
+		UdpDrops: make([]*v1.StatsResponse_SockStat_UDPDrops, 0, len(s.UDPDrops)),
+	}
+	for _, d := range s.UDPDrops {
+		res.UdpDrops = append(res.UdpDrops, &v1.StatsResponse_SockStat_UDPDrops{
+			LocalAddress: d.LocalAddress,
+			Inode:        d.Inode,
+			Drops:        d.Drops,
+			RxQueueBytes: d.RxQueueBytes,
+		})
+	}
+
+	return res
+}
+
+// netDevToResponse converts the network interfaces statistics to the response.
+func netDevToResponse(n models.NetDevStats) *v1.StatsResponse_NetDev {
+	res := &v1.StatsResponse_NetDev{
+		Interfaces: make([]*v1.StatsResponse_NetDev_Interface, 0, len(n.Interfaces)),
+	}
+	for _, iface := range n.Interfaces {
+		res.Interfaces = append(res.Interfaces, &v1.StatsResponse_NetDev_Interface{
+			Name:      iface.Name,
+			RxBytes:   iface.RxBytes,
+			TxBytes:   iface.TxBytes,
+			RxPackets: iface.RxPackets,
+			TxPackets: iface.TxPackets,
+			RxErrors:  iface.RxErrors,
+			TxErrors:  iface.TxErrors,
+			RxDropped: iface.RxDropped,
+			TxDropped: iface.TxDropped,
+		})
+	}
+
+	return res
+}
+
+// collectedAtToResponse converts the time the metrics were collected at to Unix time in milliseconds.
+func collectedAtToResponse(collectedAt map[string]time.Time) map[string]int64 {
+	res := make(map[string]int64, len(collectedAt))
+	for name, t := range collectedAt {
+		res[name] = t.UnixMilli()
+	}
+
+	return res
+}
+
+// staleCollectors returns the names of the collectors with the metrics of the last successful collection.
+func staleCollectors(status models.CollectorsStatus) []string {
+	var res []string
+	for _, c := range status {
+		if c.Stale {
+			res = append(res, c.Name)
+		}
+	}
+
+	return res
+}
+
+// customToResponse converts the metrics of the custom collectors to the response.
+func customToResponse(cc []models.CustomStats) []*v1.StatsResponse_Custom {
+	res := make([]*v1.StatsResponse_Custom, 0, len(cc))
+	for _, c := range cc {
+		custom := &v1.StatsResponse_Custom{
+			Collector: c.Collector,
+			Metrics:   make([]*v1.StatsResponse_Custom_Metric, 0, len(c.Metrics)),
+		}
+		for _, m := range c.Metrics {
+			custom.Metrics = append(custom.Metrics, &v1.StatsResponse_Custom_Metric{
+				Name:  m.Name,
+				Unit:  m.Unit,
+				Type:  string(m.Type),
+				Value: m.Value,
+			})
+		}
+		res = append(res, custom)
+	}
+
+	return res
+}
+
+// sockMemoryToResponse converts the sockets memory usage to the response one.
+func sockMemoryToResponse(m models.SockMemory) *v1.StatsResponse_SockStat_Memory {
+	return &v1.StatsResponse_SockStat_Memory{
+		UsedPages:     m.UsedPages,
+		UsedBytes:     m.UsedBytes,
+		PressurePages: m.PressurePages,
+		MaxPages:      m.MaxPages,
+		UsedPercent:   m.UsedPercent,
+		UnderPressure: m.UnderPressure,
+	}
+}
+
+// procStateToResponse converts the processes states summary to the response one.
+func procStateToResponse(s models.ProcStateStats) *v1.StatsResponse_ProcState {
+	res := &v1.StatsResponse_ProcState{
+		Processes:      stateCountsToResponse(s.Processes),
+		Threads:        stateCountsToResponse(s.Threads),
+		TotalProcesses: int32(s.TotalProcesses),
+		TotalThreads:   int32(s.TotalThreads),
+		ZombieParents:  make([]*v1.StatsResponse_ProcState_ZombieParent, 0, len(s.ZombieParents)),
+		Blocked:        make([]*v1.StatsResponse_ProcState_BlockedTask, 0, len(s.Blocked)),
+	}
+	for _, p := range s.ZombieParents {
+		res.ZombieParents = append(res.ZombieParents, &v1.StatsResponse_ProcState_ZombieParent{
+			Pid:     int32(p.PID),
+			Name:    p.Name,
+			Zombies: int32(p.Zombies),
+		})
+	}
+	for _, t := range s.Blocked {
+		res.Blocked = append(res.Blocked, &v1.StatsResponse_ProcState_BlockedTask{
+			Tid:        int32(t.TID),
+			Name:       t.Name,
+			BlockedSec: t.BlockedSec,
+		})
+	}
+
+	return res
+}
+
+// stateCountsToResponse converts the tasks counts by their states to the response ones.
+func stateCountsToResponse(c models.StateCounts) *v1.StatsResponse_ProcState_StateCounts {
+	return &v1.StatsResponse_ProcState_StateCounts{
+		Running:   int32(c.Running),
+		Sleeping:  int32(c.Sleeping),
+		DiskSleep: int32(c.DiskSleep),
+		Zombie:    int32(c.Zombie),
+		Stopped:   int32(c.Stopped),
+		Idle:      int32(c.Idle),
+	}
+}
+
+// sessionsToResponse converts the login sessions to the response ones.
+func sessionsToResponse(s models.SessionsStats) *v1.StatsResponse_Sessions {
+	res := &v1.StatsResponse_Sessions{
+		Sessions: make([]*v1.StatsResponse_Sessions_Session, 0, len(s.Sessions)),
+		PerUser:  make(map[string]int32, len(s.PerUser)),
+	}
+	for _, session := range s.Sessions {
+		res.Sessions = append(res.Sessions, &v1.StatsResponse_Sessions_Session{
+			User:      session.User,
+			Tty:       session.TTY,
+			Host:      session.Host,
+			Pid:       int32(session.PID),
+			LoginTime: session.LoginTime.Unix(),
+		})
+	}
+	for user, n := range s.PerUser {
+		res.PerUser[user] = int32(n)
+	}
+
+	return res
+}
+
+// mdStatToResponse converts the software RAID arrays status to the response one.
+func mdStatToResponse(s models.MDStatStats) *v1.StatsResponse_MDStat {
+	res := &v1.StatsResponse_MDStat{
+		Arrays: make([]*v1.StatsResponse_MDStat_Array, 0, len(s.Arrays)),
+	}
+	for _, a := range s.Arrays {
+		members := make([]*v1.StatsResponse_MDStat_Member, 0, len(a.Members))
+		for _, m := range a.Members {
+			members = append(members, &v1.StatsResponse_MDStat_Member{
+				Name:  m.Name,
+				Slot:  int32(m.Slot),
+				State: m.State,
+			})
+		}
+
+		res.Arrays = append(res.Arrays, &v1.StatsResponse_MDStat_Array{
+			Name:          a.Name,
+			State:         a.State,
+			Level:         a.Level,
+			Devices:       int32(a.Devices),
+			ActiveDevices: int32(a.ActiveDevices),
+			Degraded:      a.Degraded,
+			Members:       members,
+			Sync: &v1.StatsResponse_MDStat_Sync{
+				Action:    a.Sync.Action,
+				Pending:   a.Sync.Pending,
+				Percent:   a.Sync.Percent,
+				FinishMin: a.Sync.FinishMin,
+				SpeedKbs:  a.Sync.SpeedKBs,
+			},
+		})
+	}
+
+	return res
+}
+
+// thermalToResponse converts the CPU frequency and temperatures to the response ones.
+func thermalToResponse(t models.ThermalStats) *v1.StatsResponse_Thermal {
+	res := &v1.StatsResponse_Thermal{
+		Cpus:    make([]*v1.StatsResponse_Thermal_CPUFreq, 0, len(t.CPUs)),
+		Sensors: make([]*v1.StatsResponse_Thermal_Sensor, 0, len(t.Sensors)),
+	}
+	for _, cpu := range t.CPUs {
+		res.Cpus = append(res.Cpus, &v1.StatsResponse_Thermal_CPUFreq{
+			Cpu:              int32(cpu.CPU),
+			CurMhz:           cpu.CurMHz,
+			MinMhz:           cpu.MinMHz,
+			MaxMhz:           cpu.MaxMHz,
+			CoreThrottles:    cpu.CoreThrottles,
+			PackageThrottles: cpu.PackageThrottles,
+		})
+	}
+	for _, s := range t.Sensors {
+		res.Sensors = append(res.Sensors, &v1.StatsResponse_Thermal_Sensor{
+			Source: s.Source,
+			Chip:   s.Chip,
+			Label:  s.Label,
+			TempC:  s.TempC,
+			CritC:  s.CritC,
+		})
+	}
+
+	return res
+}
+
+// limitUsageToResponse converts the kernel limit usage to the response one.
+func limitUsageToResponse(u models.LimitUsage) *v1.StatsResponse_LimitUsage {
+	return &v1.StatsResponse_LimitUsage{
+		Used:        u.Used,
+		Max:         u.Max,
+		UsedPercent: u.UsedPercent,
+		Available:   u.Available,
+	}
+}
+
+// netProtoToResponse converts the network protocols statistics to the response one.
+func netProtoToResponse(n models.NetProtoStats) *v1.StatsResponse_NetProto {
+	return &v1.StatsResponse_NetProto{
+		Ip: &v1.StatsResponse_NetProto_IP{
+			InReceives:  n.IP.InReceives,
+			OutRequests: n.IP.OutRequests,
+			InDiscards:  n.IP.InDiscards,
+			OutDiscards: n.IP.OutDiscards,
+			InHdrErrors: n.IP.InHdrErrors,
+		},
+		Tcp: &v1.StatsResponse_NetProto_TCP{
+			CurrEstab:       n.TCP.CurrEstab,
+			ActiveOpens:     n.TCP.ActiveOpens,
+			PassiveOpens:    n.TCP.PassiveOpens,
+			AttemptFails:    n.TCP.AttemptFails,
+			OutSegs:         n.TCP.OutSegs,
+			RetransSegs:     n.TCP.RetransSegs,
+			RetransPercent:  n.TCP.RetransPercent,
+			ResetsSent:      n.TCP.ResetsSent,
+			EstabResets:     n.TCP.EstabResets,
+			InErrs:          n.TCP.InErrs,
+			ListenOverflows: n.TCP.ListenOverflows,
+			ListenDrops:     n.TCP.ListenDrops,
+		},
+		Udp: &v1.StatsResponse_NetProto_UDP{
+			InDatagrams:  n.UDP.InDatagrams,
+			OutDatagrams: n.UDP.OutDatagrams,
+			NoPorts:      n.UDP.NoPorts,
+			InErrors:     n.UDP.InErrors,
+			RcvbufErrors: n.UDP.RcvbufErrors,
+			SndbufErrors: n.UDP.SndbufErrors,
+		},
+		Icmp: &v1.StatsResponse_NetProto_ICMP{
+			InMsgs:         n.ICMP.InMsgs,
+			OutMsgs:        n.ICMP.OutMsgs,
+			InErrors:       n.ICMP.InErrors,
+			OutErrors:      n.ICMP.OutErrors,
+			InDestUnreachs: n.ICMP.InDestUnreachs,
+		},
+	}
+}
+
+// irqsToResponse converts the interrupts rates to the response ones.
+func irqsToResponse(irqs []models.IRQStats) []*v1.StatsResponse_IRQ {
+	res := make([]*v1.StatsResponse_IRQ, 0, len(irqs))
+	for _, irq := range irqs {
+		res = append(res, &v1.StatsResponse_IRQ{
+			Irq:        irq.IRQ,
+			Device:     irq.Device,
+			PerCpu:     irq.PerCPU,
+			Total:      irq.Total,
+			BusiestCpu: int32(irq.BusiestCPU),
+			Imbalance:  irq.Imbalance,
+		})
+	}
+
+	return res
+}
+
+// StreamEvents streams the system events like the OOM kills as they happen.
+func (i *Implementation) StreamEvents(req *v1.StreamEventsRequest, stream v1.SystemStats_StreamEventsServer) error {
+	ctx := stream.Context()
+
+	// Subscribing before sending the history not to miss the events happened in between
+	events := i.events.Subscribe(ctx)
+	if req.GetHistory() {
+		for _, e := range i.events.List(ctx) {
+			if err := stream.Send(eventToResponse(e)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for e := range events {
+		if err := stream.Send(eventToResponse(e)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// eventToResponse converts the system event to the response one.
+func eventToResponse(e models.Event) *v1.Event {
+	return &v1.Event{
+		TimeMs:   e.Time.UnixMilli(),
+		Kind:     e.Kind,
+		Severity: e.Severity,
+		Message:  e.Message,
+		Process: &v1.Event_Process{
+			Pid:         int32(e.Process.PID),
+			Name:        e.Process.Name,
+			Ppid:        int32(e.Process.PPID),
+			Command:     e.Process.Command,
+			User:        e.Process.User,
+			LifetimeSec: e.Process.LifetimeSec,
+		},
+		Device: e.Device,
+	}
+}
+
+// mountPointsToResponse converts the mount points to the response ones.
+func mountPointsToResponse(mounts []models.MountPoint) []*v1.BlockDevicesResponse_MountPoint {
+	res := make([]*v1.BlockDevicesResponse_MountPoint, 0, len(mounts))
+	for _, m := range mounts {
+		res = append(res, &v1.BlockDevicesResponse_MountPoint{
+			Path:   m.Path,
+			FsType: m.FSType,
+		})
+	}
+
+	return res
 }
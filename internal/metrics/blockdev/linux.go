@@ -0,0 +1,208 @@
+package blockdev
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	fsUtils "github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// parseForLinux parses the block devices and partitions for Linux.
+func (p *parser) parseForLinux(_ context.Context) ([]models.BlockDevice, error) {
+	mounts, err := p.parseMounts()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mounts: %w", err)
+	}
+
+	dir := filepath.Join(p.paths.Sys, dirBlock)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]models.BlockDevice, 0, len(entries))
+	for _, entry := range entries {
+		dev, err := p.parseDevice(filepath.Join(dir, entry.Name()), mounts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", entry.Name(), err)
+		}
+		// Unused loop and ram devices have no size
+		if dev.SizeBytes == 0 {
+			continue
+		}
+		res = append(res, dev)
+	}
+
+	return res, nil
+}
+
+// parseDevice parses the block device by its sysfs directory.
+func (p *parser) parseDevice(dir string, mounts map[string][]models.MountPoint) (models.BlockDevice, error) {
+	dev := models.BlockDevice{
+		Name: filepath.Base(dir),
+	}
+
+	sectors, err := readUint(filepath.Join(dir, "size"))
+	if err != nil {
+		return models.BlockDevice{}, err
+	}
+	dev.SizeBytes = sectors * sectorSize
+
+	rotational, err := readUint(filepath.Join(dir, "queue/rotational"))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return models.BlockDevice{}, err
+	}
+	dev.Rotational = rotational == 1
+
+	removable, err := readUint(filepath.Join(dir, "removable"))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return models.BlockDevice{}, err
+	}
+	dev.Removable = removable == 1
+
+	// Virtual devices have no model, serial or scheduler
+	optional := map[string]*string{
+		"device/model":    &dev.Model,
+		"device/serial":   &dev.Serial,
+		"queue/scheduler": &dev.Scheduler,
+		"dm/name":         &dev.DMName,
+	}
+	for file, value := range optional {
+		if *value, err = readString(filepath.Join(dir, file)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return models.BlockDevice{}, err
+		}
+	}
+	dev.Scheduler = parseScheduler(dev.Scheduler)
+
+	dev.MountPoints = mounts[dev.Name]
+	if dev.DMName != "" {
+		dev.MountPoints = append(dev.MountPoints, mounts["mapper/"+dev.DMName]...)
+	}
+
+	if dev.Partitions, err = p.parsePartitions(dir, mounts); err != nil {
+		return models.BlockDevice{}, err
+	}
+
+	return dev, nil
+}
+
+// parsePartitions parses the partitions of the block device by its sysfs directory.
+func (p *parser) parsePartitions(dir string, mounts map[string][]models.MountPoint) ([]models.Partition, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []models.Partition
+	for _, entry := range entries {
+		partDir := filepath.Join(dir, entry.Name())
+		// Only the partitions directories have the partition file with the partition number
+		if _, err = os.Stat(filepath.Join(partDir, "partition")); err != nil {
+			continue
+		}
+
+		part := models.Partition{
+			Name:        entry.Name(),
+			MountPoints: mounts[entry.Name()],
+		}
+
+		sectors, err := readUint(filepath.Join(partDir, "size"))
+		if err != nil {
+			return nil, err
+		}
+		part.SizeBytes = sectors * sectorSize
+
+		start, err := readUint(filepath.Join(partDir, "start"))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		part.StartBytes = start * sectorSize
+
+		res = append(res, part)
+	}
+
+	return res, nil
+}
+
+// parseMounts parses the mount table and returns the mount points by the device names relative to /dev.
+func (p *parser) parseMounts() (map[string][]models.MountPoint, error) {
+	lines, err := fsUtils.ReadLines(filepath.Join(p.paths.Proc, fileMounts))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string][]models.MountPoint)
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) < 3 || !strings.HasPrefix(fields[0], "/dev/") {
+			continue
+		}
+
+		name := strings.TrimPrefix(fields[0], "/dev/")
+		res[name] = append(res[name], models.MountPoint{
+			Path:   unescapeMountPath(fields[1]),
+			FSType: fields[2],
+		})
+	}
+
+	return res, nil
+}
+
+// parseScheduler returns the selected I/O scheduler from the list like "mq-deadline [none]".
+func parseScheduler(s string) string {
+	start := strings.Index(s, "[")
+	end := strings.Index(s, "]")
+	if start == -1 || end < start {
+		return s
+	}
+
+	return s[start+1 : end]
+}
+
+// unescapeMountPath decodes the octal escapes of the spaces, tabs and newlines in the mount path.
+func unescapeMountPath(path string) string {
+	if !strings.Contains(path, `\`) {
+		return path
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(path); i++ {
+		if path[i] == '\\' && i+3 < len(path) {
+			if n, err := strconv.ParseUint(path[i+1:i+4], 8, 8); err == nil {
+				sb.WriteByte(byte(n))
+				i += 3
+				continue
+			}
+		}
+		sb.WriteByte(path[i])
+	}
+
+	return sb.String()
+}
+
+// readString reads the single line value from the sysfs file.
+func readString(path string) (string, error) {
+	lines, err := fsUtils.ReadLines(path)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(lines[0]), nil
+}
+
+// readUint reads the single unsigned integer value from the sysfs file.
+func readUint(path string) (uint64, error) {
+	s, err := readString(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(s, 10, 64)
+}
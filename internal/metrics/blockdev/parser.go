@@ -0,0 +1,46 @@
+package blockdev
+
+import (
+	"context"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+var (
+	// dirBlock is the sysfs directory with the block devices.
+	dirBlock = "block"
+	// fileMounts is the procfs file with the mount table.
+	fileMounts = "self/mounts"
+
+	// sectorSize is the size of the sector the sysfs sizes are reported in.
+	sectorSize uint64 = 512
+)
+
+// parser - struct to hold the parser dependencies.
+type parser struct {
+	execer cmd.Execer
+	paths  fs.Paths
+}
+
+// NewParser returns a new parser to parse the block devices and partitions inventory.
+//
+//nolint:revive
+func NewParser(execer cmd.Execer, paths fs.Paths) *parser {
+	return &parser{
+		execer: execer,
+		paths:  paths,
+	}
+}
+
+// Parse parses the block devices and partitions of the system joined with the mount table.
+func (p *parser) Parse(ctx context.Context) ([]models.BlockDevice, error) {
+	if p.execer.OS() == os.Linux {
+		return p.parseForLinux(ctx)
+	}
+
+	return nil, metrics.ErrUnsupportedOS
+}
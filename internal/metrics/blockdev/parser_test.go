@@ -0,0 +1,178 @@
+package blockdev
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+func Test_parser_Parse(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		execerMockFunc func(t *testing.T) cmd.Execer
+		paths          fs.Paths
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []models.BlockDevice
+		wantErr bool
+	}{
+		{
+			name: "ok linux",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/proc", Sys: "testdata/sys"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: []models.BlockDevice{
+				{
+					Name:        "dm-0",
+					SizeBytes:   214748364800,
+					Scheduler:   "none",
+					DMName:      "vg0-root",
+					MountPoints: []models.MountPoint{{Path: "/", FSType: "ext4"}},
+				},
+				{
+					Name:      "nvme0n1",
+					Model:     "Samsung SSD 970 EVO Plus 500GB",
+					Serial:    "S4EVNX0R123456",
+					SizeBytes: 512110190592,
+					Scheduler: "none",
+					Partitions: []models.Partition{
+						{
+							Name:        "nvme0n1p1",
+							SizeBytes:   512108789760,
+							StartBytes:  1048576,
+							MountPoints: []models.MountPoint{{Path: "/boot/efi", FSType: "vfat"}},
+						},
+					},
+				},
+				{
+					Name:       "sda",
+					Model:      "ST1000DM010-2EP1",
+					SizeBytes:  1000204886016,
+					Rotational: true,
+					Scheduler:  "bfq",
+					Partitions: []models.Partition{
+						{
+							Name:       "sda1",
+							SizeBytes:  536870912,
+							StartBytes: 1048576,
+						},
+						{
+							Name:        "sda2",
+							SizeBytes:   999666949632,
+							StartBytes:  537919488,
+							MountPoints: []models.MountPoint{{Path: "/mnt/backup disk", FSType: "xfs"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "err linux no mount table",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/notexists", Sys: "testdata/sys"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "err darwin unsupported",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Darwin).
+						Once()
+
+					return execer
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &parser{
+				execer: tt.fields.execerMockFunc(t),
+				paths:  tt.fields.paths,
+			}
+			got, err := p.Parse(tt.args.ctx)
+
+			require.Equalf(t, tt.wantErr, err != nil, "error = %v", err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseScheduler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "selected in the middle", s: "mq-deadline [kyber] bfq none", want: "kyber"},
+		{name: "single without brackets", s: "none", want: "none"},
+		{name: "empty", s: "", want: ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, parseScheduler(tt.s))
+		})
+	}
+}
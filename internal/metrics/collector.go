@@ -0,0 +1,107 @@
+package metrics
+
+import (
+	"context"
+	"slices"
+
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// Collector defines the interface for collecting a metric of the system.
+type Collector interface {
+	// Name returns the name of the metric used in the configuration like cpu
+	Name() string
+	// Description returns the title of the metric like CPU Usage
+	Description() string
+	// Platforms returns the operating systems the metric is supported on or nil if it is supported on every one
+	Platforms() []string
+	// OptIn reports whether the metric is collected only if included in the configuration
+	OptIn() bool
+	// Collect collects the metric statistics
+	Collect(ctx context.Context) (models.Stats, error)
+}
+
+// Resetter defines the interface for the collectors and the parsers keeping the state between the collections
+// like the previous counters of the rates.
+type Resetter interface {
+	// Reset forgets the state, so the next collection starts over like the first one
+	Reset()
+}
+
+// Parser defines the interface for parsing the statistics of a metric.
+type Parser[T models.Stats] interface {
+	// Parse returns the statistics of the metric
+	Parse(ctx context.Context) (T, error)
+}
+
+// CollectorSpec describes the metric collected by the collector.
+type CollectorSpec struct {
+	// Name is the name of the metric used in the configuration like cpu.
+	Name string
+	// Description is the title of the metric like CPU Usage.
+	Description string
+	// Platforms is the operating systems the metric is supported on. Empty means every one.
+	Platforms []string
+	// OptIn is whether the metric is collected only if included in the configuration
+	// since it is verbose or expensive.
+	OptIn bool
+}
+
+// collector is an implementation of Collector collecting the metric with its parser.
+type collector[T models.Stats] struct {
+	spec   CollectorSpec
+	parser Parser[T]
+}
+
+// NewCollector returns a new instance of the collector of the metric described by the spec
+// parsed with the parser.
+//
+//nolint:revive
+func NewCollector[T models.Stats](spec CollectorSpec, parser Parser[T]) *collector[T] {
+	return &collector[T]{
+		spec:   spec,
+		parser: parser,
+	}
+}
+
+// Name returns the name of the metric.
+func (c *collector[T]) Name() string {
+	return c.spec.Name
+}
+
+// Description returns the title of the metric.
+func (c *collector[T]) Description() string {
+	return c.spec.Description
+}
+
+// Platforms returns the operating systems the metric is supported on.
+func (c *collector[T]) Platforms() []string {
+	return c.spec.Platforms
+}
+
+// OptIn reports whether the metric is collected only if included in the configuration.
+func (c *collector[T]) OptIn() bool {
+	return c.spec.OptIn
+}
+
+// Collect collects the metric statistics.
+func (c *collector[T]) Collect(ctx context.Context) (models.Stats, error) {
+	return c.parser.Parse(ctx)
+}
+
+// Reset forgets the state kept by the parser between the collections if there is one.
+func (c *collector[T]) Reset() {
+	if r, ok := c.parser.(Resetter); ok {
+		r.Reset()
+	}
+}
+
+// Supports reports whether the collector can collect the metric on the provided operating system.
+func Supports(c Collector, goos string) bool {
+	platforms := c.Platforms()
+	if len(platforms) == 0 {
+		return true
+	}
+
+	return slices.Contains(platforms, goos)
+}
@@ -9,8 +9,8 @@ import (
 )
 
 // parseForDarwin parses the CPU statistics of the system for Darwin.
-func (p *parser) parseForDarwin(_ context.Context) (models.CPUStats, error) {
-	cmdRes, err := p.execer.Exec(cmdDarwin, argsDarwin...)
+func (p *parser) parseForDarwin(ctx context.Context) (models.CPUStats, error) {
+	cmdRes, err := p.execer.Exec(ctx, cmdDarwin, argsDarwin...)
 	if err != nil {
 		return models.CPUStats{}, err
 	}
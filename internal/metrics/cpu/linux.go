@@ -3,42 +3,93 @@ package cpu
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"strconv"
 	"strings"
 
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/sampler"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
 	"github.com/sitnikovik/sysmon/internal/models"
 )
 
-// parseForLinux parses the CPU statistics of the system for Linux.
+// jiffies holds the time all CPUs spent in the modes since the boot in USER_HZ units.
+type jiffies struct {
+	user   uint64
+	system uint64
+	idle   uint64
+	total  uint64
+}
+
+// parseForLinux parses the CPU statistics of the system for Linux
+// by the jiffies of /proc/stat spent since the previous call.
 func (p *parser) parseForLinux(_ context.Context) (models.CPUStats, error) {
-	cmdRes, err := p.execer.Exec(cmdLinux, argsLinux...)
+	lines, err := fs.ReadLines(filepath.Join(p.paths.Proc, fileStat))
 	if err != nil {
 		return models.CPUStats{}, err
 	}
 
-	res := models.CPUStats{}
-	for _, line := range cmdRes.Lines() {
-		if strings.HasPrefix(line, "%Cpu(s):") {
-			parts := strings.Fields(line)
+	cur, err := parseJiffies(lines)
+	if err != nil {
+		return models.CPUStats{}, err
+	}
 
-			res.User, err = strconv.ParseFloat(strings.Replace(parts[1], ",", ".", 1), 64)
-			if err != nil {
-				return models.CPUStats{}, fmt.Errorf("parsing user cpu: %w", err)
-			}
+	prev, _, err := p.sampler.Next(cur)
+	if err != nil {
+		return models.CPUStats{}, err
+	}
 
-			res.System, err = strconv.ParseFloat(strings.Replace(parts[3], ",", ".", 1), 64)
-			if err != nil {
-				return models.CPUStats{}, fmt.Errorf("parsing system cpu: %w", err)
-			}
+	return calcStats(prev, cur), nil
+}
 
-			res.Idle, err = strconv.ParseFloat(strings.Replace(parts[7], ",", ".", 1), 64)
+// parseJiffies parses the aggregated jiffies of all CPUs from the "cpu" line of /proc/stat.
+// The guest time is already counted in the user time, so it is not added to the total.
+func parseJiffies(lines []string) (jiffies, error) {
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) < 8 || fields[0] != "cpu" {
+			continue
+		}
+
+		// user nice system idle iowait irq softirq [steal]
+		values := make([]uint64, 0, 8)
+		for _, field := range fields[1:min(len(fields), 9)] {
+			n, err := strconv.ParseUint(field, 10, 64)
 			if err != nil {
-				return models.CPUStats{}, fmt.Errorf("parsing idle cpu: %w", err)
+				return jiffies{}, fmt.Errorf("failed to parse cpu jiffies: %w", err)
 			}
+			values = append(values, n)
+		}
 
-			break
+		res := jiffies{
+			user:   values[0] + values[1],
+			system: values[2] + values[5] + values[6],
+			idle:   values[3] + values[4],
 		}
+		for _, n := range values {
+			res.total += n
+		}
+
+		return res, nil
+	}
+
+	return jiffies{}, metrics.ErrInvalidOutput
+}
+
+// calcStats calculates the percentages of the CPU time spent in the modes between the snapshots.
+func calcStats(prev, cur jiffies) models.CPUStats {
+	total := sampler.Delta(prev.total, cur.total, 64)
+	if total == 0 {
+		return models.CPUStats{}
 	}
 
-	return res, nil
+	percent := func(prev, cur uint64) float64 {
+		return float64(sampler.Delta(prev, cur, 64)) / float64(total) * 100
+	}
+
+	return models.CPUStats{
+		User:   percent(prev.user, cur.user),
+		System: percent(prev.system, cur.system),
+		Idle:   percent(prev.idle, cur.idle),
+	}
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/sampler"
 	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
 	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
 	"github.com/sitnikovik/sysmon/internal/models"
 )
@@ -12,30 +14,34 @@ import (
 var (
 	// cmdDarwin is the command to get the CPU statistics on Darwin systems.
 	cmdDarwin = "top"
-	// cmdLinux is the command to get the CPU statistics on Linux systems.
-	cmdLinux = "top"
 
 	// argsDarwin are the arguments to get the CPU statistics on Darwin systems.
 	argsDarwin = []string{"-l", "1", "-s", "0"}
-	// argsLinux are the arguments to get the CPU statistics on Linux systems.
-	argsLinux = []string{"-b", "-n", "1"}
+
+	// fileStat is the procfs file with the CPU time counters on Linux systems.
+	fileStat = "stat"
 )
 
 // parser - struct to hold the parser dependencies.
 type parser struct {
-	execer cmd.Execer
+	execer  cmd.Execer
+	paths   fs.Paths
+	sampler *sampler.Sampler[jiffies]
 }
 
 // NewParser returns a new parser to parse CPU statistics.
 //
 //nolint:revive
-func NewParser(execer cmd.Execer) *parser {
+func NewParser(execer cmd.Execer, paths fs.Paths) *parser {
 	return &parser{
-		execer: execer,
+		execer:  execer,
+		paths:   paths,
+		sampler: sampler.NewSampler[jiffies](),
 	}
 }
 
 // Parse parses the CPU statistics of the system.
+// On Linux the usage is calculated since the previous call, so the first one returns metrics.ErrWarmingUp.
 func (p *parser) Parse(ctx context.Context) (models.CPUStats, error) {
 	switch p.execer.OS() {
 	case os.Darwin:
@@ -46,3 +52,8 @@ func (p *parser) Parse(ctx context.Context) (models.CPUStats, error) {
 		return models.CPUStats{}, metrics.ErrUnsupportedOS
 	}
 }
+
+// Reset forgets the previous counters, so the rates are calculated since the next call.
+func (p *parser) Reset() {
+	p.sampler.Reset()
+}
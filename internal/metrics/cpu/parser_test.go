@@ -6,8 +6,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/sampler"
 	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
 	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
 	"github.com/sitnikovik/sysmon/internal/metrics/utils/strings"
 	"github.com/sitnikovik/sysmon/internal/models"
@@ -18,12 +22,12 @@ func TestNewParser(t *testing.T) {
 
 	t.Run("not nil on nil args", func(t *testing.T) {
 		t.Parallel()
-		assert.NotNil(t, NewParser(nil))
+		assert.NotNil(t, NewParser(nil, fs.Paths{}))
 	})
 
 	t.Run("with execer", func(t *testing.T) {
 		t.Parallel()
-		assert.NotNil(t, NewParser(cmd.NewExecer()))
+		assert.NotNil(t, NewParser(cmd.NewExecer(), fs.DefaultPaths()))
 	})
 }
 
@@ -32,12 +36,14 @@ func Test_parser_Parse(t *testing.T) {
 
 	type fields struct {
 		execerMockFunc func(t *testing.T) cmd.Execer
+		paths          fs.Paths
 	}
 	type args struct {
 		ctx context.Context
 	}
 	tests := []struct {
 		name    string
+		warm    bool
 		fields  fields
 		args    args
 		want    models.CPUStats
@@ -52,7 +58,7 @@ func Test_parser_Parse(t *testing.T) {
 					execer := cmd.NewMockExecer(t)
 
 					execer.EXPECT().
-						Exec(cmdDarwin, strings.ToInterfaces(argsDarwin)...).
+						Exec(mock.Anything, cmdDarwin, strings.ToInterfaces(argsDarwin)...).
 						Return(&cmd.Result{
 							Bytes: []byte("CPU usage: 10.0% user, 20.0% sys, 70.0% idle"),
 						}, nil)
@@ -73,6 +79,46 @@ func Test_parser_Parse(t *testing.T) {
 				Idle:   70.0,
 			},
 		},
+		{
+			name: "ok linux",
+			warm: true,
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Twice()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+		},
+		{
+			name: "err linux no procfs",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux)
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/notexists"},
+			},
+			wantErr: true,
+		},
 		{
 			name: "err darwin invalid cmd",
 			fields: fields{
@@ -82,7 +128,7 @@ func Test_parser_Parse(t *testing.T) {
 					execer := cmd.NewMockExecer(t)
 
 					execer.EXPECT().
-						Exec(cmdDarwin, strings.ToInterfaces(argsDarwin)...).
+						Exec(mock.Anything, cmdDarwin, strings.ToInterfaces(argsDarwin)...).
 						Return(nil, errors.New("invalid cmd"))
 
 					execer.EXPECT().
@@ -118,7 +164,13 @@ func Test_parser_Parse(t *testing.T) {
 			t.Parallel()
 
 			p := &parser{
-				execer: tt.fields.execerMockFunc(t),
+				execer:  tt.fields.execerMockFunc(t),
+				paths:   tt.fields.paths,
+				sampler: sampler.NewSampler[jiffies](),
+			}
+			if tt.warm {
+				_, err := p.Parse(tt.args.ctx)
+				assert.ErrorIs(t, err, metrics.ErrWarmingUp)
 			}
 			got, err := p.Parse(tt.args.ctx)
 
@@ -131,3 +183,34 @@ func Test_parser_Parse(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseJiffies(t *testing.T) {
+	t.Parallel()
+
+	lines, err := fs.ReadLines("testdata/stat")
+	assert.NoError(t, err)
+
+	got, err := parseJiffies(lines)
+	assert.NoError(t, err)
+	assert.Equal(t, jiffies{
+		user:   4705 + 356,
+		system: 584 + 277,
+		idle:   3699176 + 23060,
+		total:  4705 + 356 + 584 + 3699176 + 23060 + 277,
+	}, got)
+
+	_, err = parseJiffies([]string{"cpu0 1 2 3 4 5 6 7"})
+	assert.ErrorIs(t, err, metrics.ErrInvalidOutput)
+	_, err = parseJiffies([]string{"cpu 1 2 3 x 5 6 7"})
+	assert.Error(t, err)
+}
+
+func Test_calcStats(t *testing.T) {
+	t.Parallel()
+
+	prev := jiffies{user: 100, system: 50, idle: 850, total: 1000}
+	cur := jiffies{user: 130, system: 60, idle: 1010, total: 1200}
+
+	assert.Equal(t, models.CPUStats{User: 15, System: 5, Idle: 80}, calcStats(prev, cur))
+	assert.Equal(t, models.CPUStats{}, calcStats(cur, cur))
+}
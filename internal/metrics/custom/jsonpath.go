@@ -0,0 +1,85 @@
+package custom
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// step is the step of the JSONPath selecting either the field of the object or the element of the array.
+type step struct {
+	field string
+	index int
+	isIdx bool
+}
+
+// parsePath parses the subset of JSONPath selecting the single value like $.queues[0].depth or $['queue.depth'].
+// The leading $ is optional, so queues[0].depth selects the same value.
+func parsePath(path string) ([]step, error) {
+	s := strings.TrimPrefix(path, "$")
+	if s != "" && s[0] != '.' && s[0] != '[' {
+		s = "." + s
+	}
+
+	var res []step
+	for s != "" {
+		switch s[0] {
+		case '.':
+			s = s[1:]
+			n := strings.IndexAny(s, ".[")
+			if n < 0 {
+				n = len(s)
+			}
+			if n == 0 {
+				return nil, fmt.Errorf("invalid path %q: empty field", path)
+			}
+			res = append(res, step{field: s[:n]})
+			s = s[n:]
+		case '[':
+			end := strings.IndexByte(s, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %q: unclosed bracket", path)
+			}
+			inner := s[1:end]
+			s = s[end+1:]
+
+			if len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0] {
+				res = append(res, step{field: inner[1 : len(inner)-1]})
+				continue
+			}
+			idx, err := strconv.Atoi(inner)
+			if err != nil || idx < 0 {
+				return nil, fmt.Errorf("invalid path %q: invalid index %q", path, inner)
+			}
+			res = append(res, step{index: idx, isIdx: true})
+		default:
+			return nil, fmt.Errorf("invalid path %q", path)
+		}
+	}
+
+	return res, nil
+}
+
+// selectPath returns the node of the decoded JSON document selected by the steps.
+func selectPath(node any, steps []step) (any, bool) {
+	for _, st := range steps {
+		if st.isIdx {
+			arr, ok := node.([]any)
+			if !ok || st.index >= len(arr) {
+				return nil, false
+			}
+			node = arr[st.index]
+			continue
+		}
+
+		obj, ok := node.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if node, ok = obj[st.field]; !ok {
+			return nil, false
+		}
+	}
+
+	return node, true
+}
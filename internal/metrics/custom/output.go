@@ -0,0 +1,136 @@
+package custom
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// extract returns the values of the metrics in the order of the spec parsed from the output of the command.
+func (p *parser) extract(out []byte) ([]float64, error) {
+	switch p.spec.Mode {
+	case ModeNumber:
+		v, err := parseValue(strings.TrimSpace(string(out)))
+		if err != nil {
+			return nil, err
+		}
+
+		return []float64{v}, nil
+	case ModeKeyValue:
+		return p.extractKeyValue(out)
+	case ModeJSON:
+		return p.extractJSON(out)
+	case ModeRegex:
+		return p.extractRegex(out)
+	}
+
+	return nil, fmt.Errorf("unknown parse mode %q", p.spec.Mode)
+}
+
+// extractKeyValue extracts the values from the key=value lines, the lines without = are skipped.
+func (p *parser) extractKeyValue(out []byte) ([]float64, error) {
+	kv := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), "=")
+		if !ok {
+			continue
+		}
+		kv[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	res := make([]float64, 0, len(p.spec.Metrics))
+	for _, m := range p.spec.Metrics {
+		s, ok := kv[m.Key]
+		if !ok {
+			return nil, fmt.Errorf("%w: key %s", errMissingValue, m.Key)
+		}
+		v, err := parseValue(s)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", m.Key, err)
+		}
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// extractJSON extracts the values from the JSON document by the JSONPath selectors.
+func (p *parser) extractJSON(out []byte) ([]float64, error) {
+	var doc any
+	if err := json.Unmarshal(out, &doc); err != nil {
+		return nil, err
+	}
+
+	res := make([]float64, 0, len(p.spec.Metrics))
+	for i, m := range p.spec.Metrics {
+		node, ok := selectPath(doc, p.selectors[i])
+		if !ok {
+			return nil, fmt.Errorf("%w: path %s", errMissingValue, m.Key)
+		}
+
+		var v float64
+		switch n := node.(type) {
+		case float64:
+			v = n
+		case bool:
+			v = boolValue(n)
+		case string:
+			var err error
+			if v, err = parseValue(n); err != nil {
+				return nil, fmt.Errorf("path %s: %w", m.Key, err)
+			}
+		default:
+			return nil, fmt.Errorf("path %s: not a number", m.Key)
+		}
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// extractRegex extracts the values from the named groups of the first match of the pattern.
+func (p *parser) extractRegex(out []byte) ([]float64, error) {
+	match := p.pattern.FindSubmatch(out)
+	if match == nil {
+		return nil, fmt.Errorf("%w: pattern does not match", errMissingValue)
+	}
+
+	res := make([]float64, 0, len(p.spec.Metrics))
+	for _, m := range p.spec.Metrics {
+		v, err := parseValue(string(match[p.pattern.SubexpIndex(m.Key)]))
+		if err != nil {
+			return nil, fmt.Errorf("group %s: %w", m.Key, err)
+		}
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// parseValue parses the number or the boolean value given as 1 or 0.
+func parseValue(s string) (float64, error) {
+	if v, err := strconv.ParseFloat(s, 64); err == nil {
+		return v, nil
+	}
+	if b, err := strconv.ParseBool(s); err == nil {
+		return boolValue(b), nil
+	}
+
+	return 0, fmt.Errorf("not a number: %q", s)
+}
+
+// boolValue returns 1 for true and 0 for false.
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
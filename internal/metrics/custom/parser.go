@@ -0,0 +1,175 @@
+package custom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// Mode is the way the output of the command is parsed.
+type Mode string
+
+const (
+	// ModeNumber parses the output as the single number.
+	ModeNumber Mode = "number"
+	// ModeKeyValue parses the output as the key=value lines.
+	ModeKeyValue Mode = "keyvalue"
+	// ModeJSON parses the output as JSON selecting the values by JSONPath.
+	ModeJSON Mode = "json"
+	// ModeRegex parses the output by the regular expression selecting the values by the named groups.
+	ModeRegex Mode = "regex"
+)
+
+var (
+	// errInvalidSpec is an error returned when the custom collector is declared wrong.
+	errInvalidSpec = errors.New("invalid custom collector")
+	// errMissingValue is an error returned when the output has no value for the metric.
+	errMissingValue = errors.New("missing value")
+)
+
+// Spec describes the custom collector running the command and parsing the metrics from its output.
+type Spec struct {
+	// Name is the name of the collector.
+	Name string
+	// Command is the command to run.
+	Command string
+	// Args is the arguments of the command.
+	Args []string
+	// Mode is the way the output of the command is parsed.
+	Mode Mode
+	// Pattern is the regular expression with the named groups for ModeRegex.
+	Pattern string
+	// Metrics is the metrics to take from the output.
+	Metrics []MetricSpec
+}
+
+// MetricSpec describes the metric taken from the output of the command.
+type MetricSpec struct {
+	// Name is the name of the metric.
+	Name string
+	// Key selects the value from the output: the key of ModeKeyValue, the JSONPath of ModeJSON
+	// or the group name of ModeRegex. Not used by ModeNumber.
+	Key string
+	// Unit is the unit of the value.
+	Unit string
+	// Type is the type of the value, gauge by default.
+	Type models.SampleType
+}
+
+// parser is an implementation of Parser.
+type parser struct {
+	execer    cmd.Execer
+	spec      Spec
+	pattern   *regexp.Regexp
+	selectors [][]step
+}
+
+// NewParser returns a new parser to parse the metrics from the output of the custom collector command.
+// Returns an error if the spec is invalid.
+//
+//nolint:revive
+func NewParser(execer cmd.Execer, spec Spec) (*parser, error) {
+	p := &parser{
+		execer: execer,
+		spec:   spec,
+	}
+	if err := p.compile(); err != nil {
+		return nil, fmt.Errorf("%w %s: %w", errInvalidSpec, spec.Name, err)
+	}
+
+	return p, nil
+}
+
+// Parse runs the command and parses the metrics from its output.
+func (p *parser) Parse(ctx context.Context) (models.CustomStats, error) {
+	res, err := p.execer.Exec(ctx, p.spec.Command, p.spec.Args...)
+	if err != nil {
+		return models.CustomStats{}, err
+	}
+
+	values, err := p.extract(res.Bytes)
+	if err != nil {
+		return models.CustomStats{}, fmt.Errorf("failed to parse the output of %s: %w", p.spec.Command, err)
+	}
+
+	stats := models.CustomStats{
+		Collector: p.spec.Name,
+		Metrics:   make([]models.CustomMetric, 0, len(p.spec.Metrics)),
+	}
+	for i, m := range p.spec.Metrics {
+		stats.Metrics = append(stats.Metrics, models.CustomMetric{
+			Name:  m.Name,
+			Unit:  m.Unit,
+			Type:  m.Type,
+			Value: values[i],
+		})
+	}
+
+	return stats, nil
+}
+
+// compile validates the spec, sets the defaults and prepares the selectors of the values.
+func (p *parser) compile() error {
+	if p.spec.Name == "" {
+		return errors.New("name is required")
+	}
+	if p.spec.Command == "" {
+		return errors.New("command is required")
+	}
+	if len(p.spec.Metrics) == 0 {
+		return errors.New("at least one metric is required")
+	}
+
+	p.spec.Metrics = append([]MetricSpec(nil), p.spec.Metrics...)
+	for i, m := range p.spec.Metrics {
+		if m.Name == "" {
+			return fmt.Errorf("metric #%d: name is required", i+1)
+		}
+		switch m.Type {
+		case "":
+			p.spec.Metrics[i].Type = models.SampleTypeGauge
+		case models.SampleTypeGauge, models.SampleTypeCounter, models.SampleTypeRate:
+		default:
+			return fmt.Errorf("metric %s: unknown type %q", m.Name, m.Type)
+		}
+		if m.Key == "" && p.spec.Mode != ModeNumber {
+			return fmt.Errorf("metric %s: key is required", m.Name)
+		}
+	}
+
+	switch p.spec.Mode {
+	case ModeNumber:
+		if len(p.spec.Metrics) != 1 {
+			return fmt.Errorf("%s mode takes exactly one metric", ModeNumber)
+		}
+	case ModeKeyValue:
+	case ModeJSON:
+		p.selectors = make([][]step, 0, len(p.spec.Metrics))
+		for _, m := range p.spec.Metrics {
+			steps, err := parsePath(m.Key)
+			if err != nil {
+				return fmt.Errorf("metric %s: %w", m.Name, err)
+			}
+			p.selectors = append(p.selectors, steps)
+		}
+	case ModeRegex:
+		re, err := regexp.Compile(p.spec.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern: %w", err)
+		}
+		for _, m := range p.spec.Metrics {
+			if re.SubexpIndex(m.Key) < 0 {
+				return fmt.Errorf("metric %s: pattern has no group %q", m.Name, m.Key)
+			}
+		}
+		p.pattern = re
+	default:
+		return fmt.Errorf("unknown parse mode %q", p.spec.Mode)
+	}
+
+	return nil
+}
@@ -0,0 +1,261 @@
+package custom
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+func TestNewParser(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		spec    Spec
+		wantErr bool
+	}{
+		{
+			name: "ok",
+			spec: Spec{
+				Name:    "queue",
+				Command: "queue-depth",
+				Mode:    ModeNumber,
+				Metrics: []MetricSpec{{Name: "queue_depth"}},
+			},
+		},
+		{
+			name: "no command",
+			spec: Spec{
+				Name:    "queue",
+				Mode:    ModeNumber,
+				Metrics: []MetricSpec{{Name: "queue_depth"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown mode",
+			spec: Spec{
+				Name:    "queue",
+				Command: "queue-depth",
+				Mode:    "xml",
+				Metrics: []MetricSpec{{Name: "queue_depth"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "number mode with two metrics",
+			spec: Spec{
+				Name:    "queue",
+				Command: "queue-depth",
+				Mode:    ModeNumber,
+				Metrics: []MetricSpec{{Name: "a"}, {Name: "b"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown type",
+			spec: Spec{
+				Name:    "queue",
+				Command: "queue-depth",
+				Mode:    ModeNumber,
+				Metrics: []MetricSpec{{Name: "queue_depth", Type: "histogram"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no key",
+			spec: Spec{
+				Name:    "queue",
+				Command: "queue-stats",
+				Mode:    ModeKeyValue,
+				Metrics: []MetricSpec{{Name: "queue_depth"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no group",
+			spec: Spec{
+				Name:    "queue",
+				Command: "queue-stats",
+				Mode:    ModeRegex,
+				Pattern: `depth: (?P<depth>\d+)`,
+				Metrics: []MetricSpec{{Name: "queue_depth", Key: "size"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid path",
+			spec: Spec{
+				Name:    "queue",
+				Command: "queue-stats",
+				Mode:    ModeJSON,
+				Metrics: []MetricSpec{{Name: "queue_depth", Key: "$.queues[first]"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewParser(cmd.NewMockExecer(t), tt.spec)
+			require.Equal(t, tt.wantErr, err != nil, err)
+		})
+	}
+}
+
+func Test_parser_Parse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		spec    Spec
+		output  string
+		execErr error
+		want    []models.CustomMetric
+		wantErr bool
+	}{
+		{
+			name: "number",
+			spec: Spec{
+				Mode:    ModeNumber,
+				Metrics: []MetricSpec{{Name: "queue_depth", Unit: models.UnitCount}},
+			},
+			output: "42\n",
+			want: []models.CustomMetric{
+				{Name: "queue_depth", Unit: models.UnitCount, Type: models.SampleTypeGauge, Value: 42},
+			},
+		},
+		{
+			name: "key value",
+			spec: Spec{
+				Mode: ModeKeyValue,
+				Metrics: []MetricSpec{
+					{Name: "queue_depth", Key: "depth", Unit: models.UnitCount},
+					{Name: "queue_processed", Key: "processed", Unit: models.UnitCount, Type: models.SampleTypeCounter},
+					{Name: "queue_paused", Key: "paused", Unit: models.UnitBool},
+				},
+			},
+			output: "# queue stats\ndepth = 12\nprocessed=1500\npaused=false\n",
+			want: []models.CustomMetric{
+				{Name: "queue_depth", Unit: models.UnitCount, Type: models.SampleTypeGauge, Value: 12},
+				{Name: "queue_processed", Unit: models.UnitCount, Type: models.SampleTypeCounter, Value: 1500},
+				{Name: "queue_paused", Unit: models.UnitBool, Type: models.SampleTypeGauge, Value: 0},
+			},
+		},
+		{
+			name: "json",
+			spec: Spec{
+				Mode: ModeJSON,
+				Metrics: []MetricSpec{
+					{Name: "replication_lag", Key: "$.replication.lag", Unit: models.UnitSeconds},
+					{Name: "first_queue_depth", Key: "$.queues[0]['depth']", Unit: models.UnitCount},
+					{Name: "connected", Key: "connected", Unit: models.UnitBool},
+					{Name: "version", Key: "$.version"},
+				},
+			},
+			output: `{"replication":{"lag":0.25},"queues":[{"depth":7}],"connected":true,"version":"3"}`,
+			want: []models.CustomMetric{
+				{Name: "replication_lag", Unit: models.UnitSeconds, Type: models.SampleTypeGauge, Value: 0.25},
+				{Name: "first_queue_depth", Unit: models.UnitCount, Type: models.SampleTypeGauge, Value: 7},
+				{Name: "connected", Unit: models.UnitBool, Type: models.SampleTypeGauge, Value: 1},
+				{Name: "version", Type: models.SampleTypeGauge, Value: 3},
+			},
+		},
+		{
+			name: "regex",
+			spec: Spec{
+				Mode:    ModeRegex,
+				Pattern: `(?P<ok>\d+) passed, (?P<failed>\d+) failed`,
+				Metrics: []MetricSpec{
+					{Name: "checks_passed", Key: "ok", Unit: models.UnitCount},
+					{Name: "checks_failed", Key: "failed", Unit: models.UnitCount},
+				},
+			},
+			output: "health: 9 passed, 1 failed\n",
+			want: []models.CustomMetric{
+				{Name: "checks_passed", Unit: models.UnitCount, Type: models.SampleTypeGauge, Value: 9},
+				{Name: "checks_failed", Unit: models.UnitCount, Type: models.SampleTypeGauge, Value: 1},
+			},
+		},
+		{
+			name: "not a number",
+			spec: Spec{
+				Mode:    ModeNumber,
+				Metrics: []MetricSpec{{Name: "queue_depth"}},
+			},
+			output:  "unknown\n",
+			wantErr: true,
+		},
+		{
+			name: "missing key",
+			spec: Spec{
+				Mode:    ModeKeyValue,
+				Metrics: []MetricSpec{{Name: "queue_depth", Key: "depth"}},
+			},
+			output:  "processed=1500\n",
+			wantErr: true,
+		},
+		{
+			name: "missing path",
+			spec: Spec{
+				Mode:    ModeJSON,
+				Metrics: []MetricSpec{{Name: "replication_lag", Key: "$.replication.lag"}},
+			},
+			output:  `{"replication":null}`,
+			wantErr: true,
+		},
+		{
+			name: "no match",
+			spec: Spec{
+				Mode:    ModeRegex,
+				Pattern: `depth: (?P<depth>\d+)`,
+				Metrics: []MetricSpec{{Name: "queue_depth", Key: "depth"}},
+			},
+			output:  "empty\n",
+			wantErr: true,
+		},
+		{
+			name: "command failed",
+			spec: Spec{
+				Mode:    ModeNumber,
+				Metrics: []MetricSpec{{Name: "queue_depth"}},
+			},
+			execErr: errors.New("exit status 1"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			execer := cmd.NewMockExecer(t)
+			execer.EXPECT().
+				Exec(mock.Anything, "check", "--all").
+				Return(&cmd.Result{Bytes: []byte(tt.output)}, tt.execErr).Once()
+
+			spec := tt.spec
+			spec.Name = "check"
+			spec.Command = "check"
+			spec.Args = []string{"--all"}
+			p, err := NewParser(execer, spec)
+			require.NoError(t, err)
+
+			got, err := p.Parse(context.Background())
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, models.CustomStats{Collector: "check", Metrics: tt.want}, got)
+		})
+	}
+}
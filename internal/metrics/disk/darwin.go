@@ -36,8 +36,8 @@ func (p *parser) parseForDarwin(ctx context.Context) (models.DiskStats, error) {
 }
 
 // parseDiskLoadForDarwin parses the disk load for Darwin OS and fills the provided result struct.
-func (p *parser) parseDiskLoadForDarwin(_ context.Context, res *models.DiskStats) error {
-	cmdRes, err := p.execer.Exec(unixCmdDiskLoad, unixArgsDiskLoad...)
+func (p *parser) parseDiskLoadForDarwin(ctx context.Context, res *models.DiskStats) error {
+	cmdRes, err := p.execer.Exec(ctx, unixCmdDiskLoad, unixArgsDiskLoad...)
 	if err != nil {
 		return err
 	}
@@ -3,96 +3,162 @@ package disk
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
 	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/sampler"
+	fsUtils "github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
 	"github.com/sitnikovik/sysmon/internal/models"
 )
 
+// ioCounters holds the IO counters of the disk since the boot.
+type ioCounters struct {
+	reads          uint64
+	writes         uint64
+	sectorsRead    uint64
+	sectorsWritten uint64
+}
+
+// diskCounters holds the IO counters by the disk name.
+type diskCounters map[string]ioCounters
+
 // parseForLinux parses the disk statistics for Linux.
-func (p *parser) parseForLinux(ctx context.Context) (models.DiskStats, error) {
+func (p *parser) parseForLinux(_ context.Context) (models.DiskStats, error) {
 	var res models.DiskStats
 	var err error
 
 	// Getting the disk load
-	err = p.parseDiskLoadForLinux(ctx, &res)
+	err = p.parseDiskLoadForLinux(&res)
 	if err != nil {
 		return models.DiskStats{}, err
 	}
 
-	// Getting the disk space
-	err = p.parseDiskSpaceForUnix(ctx, &res)
+	// Getting the disk space and inodes of the root filesystem
+	err = p.parseDiskSpaceForLinux(&res)
 	if err != nil {
 		return models.DiskStats{}, err
 	}
 
-	// Getting the disk space as inodes
-	err = p.parseDiskSpaceAsInodesForUnix(ctx, &res)
+	return res, nil
+}
+
+// parseDiskSpaceForLinux parses the disk space and inodes usage of the root filesystem
+// and fills the provided result struct. The used percentage is calculated the way df does,
+// so the blocks reserved for root are not counted as available.
+func (p *parser) parseDiskSpaceForLinux(res *models.DiskStats) error {
+	usage, err := p.statfs(p.paths.Root)
 	if err != nil {
-		return models.DiskStats{}, err
+		return fmt.Errorf("failed to stat the root filesystem: %w", err)
 	}
 
-	return res, nil
+	// The root path may be the one of the host filesystem mounted into the container, which is / on the host
+	res.Mount = rootMount
+	used := usage.blocks - min(usage.free, usage.blocks)
+	res.TotalMb = usage.blocks * usage.blockSize / 1024 / 1024
+	res.UsedMb = used * usage.blockSize / 1024 / 1024
+	if used+usage.available > 0 {
+		res.UsedPercent = float64(used) / float64(used+usage.available) * 100
+	}
+
+	res.UsedInodes = usage.files - min(usage.filesFree, usage.files)
+	if usage.files > 0 {
+		res.UsedInodesPercent = float64(res.UsedInodes) / float64(usage.files) * 100
+	}
+
+	return nil
+}
+
+// parseDiskLoadForLinux parses the disk load for Linux by the counters of /proc/diskstats
+// changed since the previous call and fills the provided result struct.
+// Only whole disks are counted, since the partitions IO is counted in their disks too.
+func (p *parser) parseDiskLoadForLinux(res *models.DiskStats) error {
+	disks, err := p.readDisks()
+	if err != nil {
+		return err
+	}
+
+	lines, err := fsUtils.ReadLines(filepath.Join(p.paths.Proc, fileDiskStats))
+	if err != nil {
+		return err
+	}
+	cur, err := parseDiskStats(lines, disks)
+	if err != nil {
+		return err
+	}
+
+	prev, seconds, err := p.sampler.Next(cur)
+	if err != nil {
+		return err
+	}
+
+	calcDiskLoad(prev, cur, seconds, res)
+
+	return nil
 }
 
-// parseDiskLoadForLinux parses the disk load for Linux and fills the provided result struct.
-func (p *parser) parseDiskLoadForLinux(_ context.Context, res *models.DiskStats) error {
-	cmdRes, err := p.execer.Exec(unixCmdDiskLoad, unixArgsDiskLoad...)
+// readDisks returns the names of the whole disks listed in /sys/block.
+func (p *parser) readDisks() (map[string]struct{}, error) {
+	entries, err := os.ReadDir(filepath.Join(p.paths.Sys, dirBlock))
 	if err != nil {
-		return fmt.Errorf("failed to execute command: %w", err)
+		return nil, err
 	}
 
-	lines := cmdRes.Lines()
-	if len(lines) < 4 {
-		return metrics.ErrInvalidOutput
+	res := make(map[string]struct{}, len(entries))
+	for _, entry := range entries {
+		// The slashes in the device names like cciss/c0d0 are replaced with "!" in sysfs
+		res[strings.ReplaceAll(entry.Name(), "!", "/")] = struct{}{}
 	}
 
-	// Find all lines with disk data
-	dataLines := make([]string, 0, len(lines))
-	var foundHeadersLine bool
+	return res, nil
+}
+
+// parseDiskStats parses the IO counters of the disks from the content of /proc/diskstats.
+func parseDiskStats(lines []string, disks map[string]struct{}) (diskCounters, error) {
+	res := make(diskCounters, len(disks))
 	for _, line := range lines {
-		if line == "" {
-			continue
+		fields := strings.Fields(line)
+		if len(fields) < 10 {
+			return nil, metrics.ErrInvalidOutput
 		}
-		if !foundHeadersLine {
-			if strings.Contains(line, "Device") {
-				foundHeadersLine = true
-			}
+		if _, ok := disks[fields[2]]; !ok {
 			continue
 		}
-		dataLines = append(dataLines, line)
-	}
-	if len(dataLines) == 0 {
-		return fmt.Errorf("no disk data found")
-	}
 
-	// Sums the values of all disks
-	for _, dataLine := range dataLines {
-		fields := strings.Fields(dataLine)
-		if len(fields) < 6 {
-			return fmt.Errorf("unexpected output format for disk data: %s", dataLine)
+		// reads completed, sectors read, writes completed and sectors written
+		var values [4]uint64
+		for i, field := range []string{fields[3], fields[5], fields[7], fields[9]} {
+			n, err := strconv.ParseUint(field, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s counters: %w", fields[2], err)
+			}
+			values[i] = n
 		}
-
-		tps, err := p.parseFloat(fields[1])
-		if err != nil {
-			return fmt.Errorf("failed to parse tps '%s': %w", fmt.Sprint(fields[1]), err)
+		res[fields[2]] = ioCounters{
+			reads:          values[0],
+			sectorsRead:    values[1],
+			writes:         values[2],
+			sectorsWritten: values[3],
 		}
+	}
 
-		kbReadPerS, err := strconv.ParseFloat(fields[2], 64)
-		if err != nil {
-			return fmt.Errorf("failed to parse kb_read/s: %w", err)
-		}
+	return res, nil
+}
 
-		kbWrtnPerS, err := strconv.ParseFloat(fields[3], 64)
-		if err != nil {
-			return fmt.Errorf("failed to parse kb_wrtn/s: %w", err)
+// calcDiskLoad calculates the disk load of the disks present in both snapshots and fills the provided result struct.
+func calcDiskLoad(prev, cur diskCounters, seconds float64, res *models.DiskStats) {
+	for name, c := range cur {
+		p, ok := prev[name]
+		if !ok {
+			continue
 		}
 
-		res.Reads += tps
-		res.Writes += kbWrtnPerS
-		res.ReadWriteKb += kbReadPerS + kbWrtnPerS
+		res.Reads += sampler.Rate(p.reads, c.reads, sampler.NativeBits, seconds)
+		res.Writes += sampler.Rate(p.writes, c.writes, sampler.NativeBits, seconds)
+		sectors := sampler.Rate(p.sectorsRead, c.sectorsRead, sampler.NativeBits, seconds) +
+			sampler.Rate(p.sectorsWritten, c.sectorsWritten, sampler.NativeBits, seconds)
+		res.ReadWriteKb += sectors * sectorSize / 1024
 	}
-
-	return nil
 }
@@ -7,7 +7,9 @@ import (
 	"strings"
 
 	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/sampler"
 	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
 	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
 	"github.com/sitnikovik/sysmon/internal/models"
 )
@@ -25,23 +27,52 @@ var (
 	unixCmdDiskSpaceInodes = "df"
 	// unixArgsDiskSpaceInodes are the arguments to get the disk space inodes statistics on unix systems.
 	unixArgsDiskSpaceInodes = []string{"-i"}
+
+	// fileDiskStats is the procfs file with the IO counters of the block devices on Linux systems.
+	fileDiskStats = "diskstats"
+	// dirBlock is the sysfs directory with the whole disks on Linux systems.
+	dirBlock = "block"
+
+	// rootMount is the mount point of the root filesystem the space is reported of.
+	rootMount = "/"
 )
 
+// sectorSize is the size of the sector /proc/diskstats counts in regardless of the disk sector size.
+const sectorSize = 512
+
+// fsUsage holds the space and inodes usage of the filesystem.
+type fsUsage struct {
+	blockSize uint64
+	blocks    uint64
+	free      uint64
+	// available is the number of the free blocks available to the unprivileged users.
+	available uint64
+	files     uint64
+	filesFree uint64
+}
+
 // parser - struct to hold the parser dependencies.
 type parser struct {
-	execer cmd.Execer
+	execer  cmd.Execer
+	paths   fs.Paths
+	sampler *sampler.Sampler[diskCounters]
+	statfs  func(path string) (fsUsage, error)
 }
 
 // NewParser returns a new parser to parse disk statistics.
 //
 //nolint:revive
-func NewParser(execer cmd.Execer) *parser {
+func NewParser(execer cmd.Execer, paths fs.Paths) *parser {
 	return &parser{
-		execer: execer,
+		execer:  execer,
+		paths:   paths,
+		sampler: sampler.NewSampler[diskCounters](),
+		statfs:  statfs,
 	}
 }
 
 // Parse parses the disk statistics of the system.
+// On Linux the load is calculated since the previous call, so the first one returns metrics.ErrWarmingUp.
 func (p *parser) Parse(ctx context.Context) (models.DiskStats, error) {
 	switch p.execer.OS() {
 	case os.Darwin:
@@ -53,12 +84,17 @@ func (p *parser) Parse(ctx context.Context) (models.DiskStats, error) {
 	return models.DiskStats{}, metrics.ErrUnsupportedOS
 }
 
+// Reset forgets the previous counters, so the rates are calculated since the next call.
+func (p *parser) Reset() {
+	p.sampler.Reset()
+}
+
 // parseFSnameFromDfOutput parses the disk system by the provided df command output.
 func (p *parser) parseFSnameFromDfOutput(lines []string) (string, error) {
 	// Return root filesystem if the previous one is not found
 	for i, line := range lines {
 		fields := strings.Fields(line)
-		if fields[len(fields)-1] == "/" {
+		if fields[len(fields)-1] == rootMount {
 			return lines[i], nil
 		}
 	}
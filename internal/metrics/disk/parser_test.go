@@ -2,11 +2,16 @@ package disk
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/sampler"
 	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
 	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
 	stringsUtils "github.com/sitnikovik/sysmon/internal/metrics/utils/strings"
 	"github.com/sitnikovik/sysmon/internal/models"
@@ -18,12 +23,15 @@ func Test_parser_Parse(t *testing.T) {
 
 	type fields struct {
 		execerMockFunc func(t *testing.T) cmd.Execer
+		paths          fs.Paths
+		statfs         func(path string) (fsUsage, error)
 	}
 	type args struct {
 		ctx context.Context
 	}
 	tests := []struct {
 		name    string
+		warm    bool
 		fields  fields
 		args    args
 		want    models.DiskStats
@@ -38,7 +46,7 @@ func Test_parser_Parse(t *testing.T) {
 					execer := cmd.NewMockExecer(t)
 
 					execer.EXPECT().
-						Exec(unixCmdDiskLoad, stringsUtils.ToInterfaces(unixArgsDiskLoad)...).
+						Exec(mock.Anything, unixCmdDiskLoad, stringsUtils.ToInterfaces(unixArgsDiskLoad)...).
 						Return(&cmd.Result{
 							Bytes: []byte(
 								"          disk0           disk1\n" +
@@ -49,7 +57,7 @@ func Test_parser_Parse(t *testing.T) {
 						Once()
 
 					execer.EXPECT().
-						Exec(unixCmdDiskSpaceInodes, stringsUtils.ToInterfaces(unixArgsDiskSpaceInodes)...).
+						Exec(mock.Anything, unixCmdDiskSpaceInodes, stringsUtils.ToInterfaces(unixArgsDiskSpaceInodes)...).
 						Return(&cmd.Result{
 							Bytes: []byte(
 								"Filesystem      Inodes   IUsed   IFree IUse% Mounted on\n" +
@@ -60,7 +68,7 @@ func Test_parser_Parse(t *testing.T) {
 						Once()
 
 					execer.EXPECT().
-						Exec(unixCmdDiskSpace, stringsUtils.ToInterfaces(unixArgsDiskSpace)...).
+						Exec(mock.Anything, unixCmdDiskSpace, stringsUtils.ToInterfaces(unixArgsDiskSpace)...).
 						Return(&cmd.Result{
 							Bytes: []byte(
 								"Filesystem      Size  Used Avail Use% Mounted on\n" +
@@ -88,71 +96,74 @@ func Test_parser_Parse(t *testing.T) {
 				UsedPercent:       40,
 				UsedInodes:        1048576,
 				UsedInodesPercent: 32,
+				Mount:             "/",
 			},
 		},
 		{
 			name: "linux",
+			warm: true,
 			fields: fields{
 				execerMockFunc: func(t *testing.T) cmd.Execer {
 					t.Helper()
 
 					execer := cmd.NewMockExecer(t)
 
-					execer.EXPECT().
-						Exec(unixCmdDiskLoad, stringsUtils.ToInterfaces(unixArgsDiskLoad)...).
-						Return(&cmd.Result{
-							Bytes: []byte(
-								"Linux 4.15.0-112-generic (hostname) 	09/01/2021 	_x86_64_	(4 CPU)\n" +
-									"\n" +
-									"Device             tps    kB_read/s    kB_wrtn/s    kB_read    kB_wrtn\n" +
-									"sda               1.00         20.00         350.00          50          10\n" +
-									"sda1               2.00         21.00         312.00          20          20\n",
-							),
-						}, nil).
-						Once()
-
-					execer.EXPECT().
-						Exec(unixCmdDiskSpaceInodes, stringsUtils.ToInterfaces(unixArgsDiskSpaceInodes)...).
-						Return(&cmd.Result{
-							Bytes: []byte(
-								"Filesystem      Inodes   IUsed   IFree IUse% Mounted on\n" +
-									"/dev/sda1      3276800  1048576 2228224   32% /\n" +
-									"tmpfs           128000     4000  124000    3% /run\n",
-							),
-						}, nil).
-						Once()
-
-					execer.EXPECT().
-						Exec(unixCmdDiskSpace, stringsUtils.ToInterfaces(unixArgsDiskSpace)...).
-						Return(&cmd.Result{
-							Bytes: []byte(
-								"Filesystem      Size  Used Avail Use% Mounted on\n" +
-									"/dev/sda1        50G   20G   30G  40% /\n" +
-									"tmpfs           500M  100M  400M  20% /run\n",
-							),
-						}, nil).Once()
-
 					execer.EXPECT().
 						OS().
-						Return(os.Linux)
+						Return(os.Linux).
+						Twice()
 
 					return execer
 				},
+				paths: fs.Paths{Proc: "testdata/proc", Sys: "testdata/sys", Root: "/"},
+				statfs: func(path string) (fsUsage, error) {
+					if path != "/" {
+						return fsUsage{}, errors.New("unexpected path")
+					}
+
+					return fsUsage{
+						blockSize: 4096,
+						blocks:    12800 * 1024 / 4 * 1024,
+						free:      8192 * 1024 / 4 * 1024,
+						available: 6912 * 1024 / 4 * 1024,
+						files:     3276800,
+						filesFree: 2228224,
+					}, nil
+				},
 			},
 			args: args{
 				ctx: context.Background(),
 			},
 			want: models.DiskStats{
-				Reads:             3,
-				Writes:            662,
-				ReadWriteKb:       20 + 350 + 21 + 312,
-				TotalMb:           50 * 1024,
-				UsedMb:            20 * 1024,
+				TotalMb:           12800 * 1024,
+				UsedMb:            4608 * 1024,
 				UsedPercent:       40,
 				UsedInodes:        1048576,
 				UsedInodesPercent: 32,
+				Mount:             "/",
 			},
 		},
+		{
+			name: "err linux no procfs",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux)
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/notexists", Sys: "testdata/sys"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
 		{
 			name: "err windows unsupported",
 			fields: fields{
@@ -180,7 +191,14 @@ func Test_parser_Parse(t *testing.T) {
 			t.Parallel()
 
 			p := &parser{
-				execer: tt.fields.execerMockFunc(t),
+				execer:  tt.fields.execerMockFunc(t),
+				paths:   tt.fields.paths,
+				sampler: sampler.NewSampler[diskCounters](),
+				statfs:  tt.fields.statfs,
+			}
+			if tt.warm {
+				_, err := p.Parse(tt.args.ctx)
+				require.ErrorIs(t, err, metrics.ErrWarmingUp)
 			}
 			got, err := p.Parse(tt.args.ctx)
 
@@ -189,3 +207,42 @@ func Test_parser_Parse(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseDiskStats(t *testing.T) {
+	t.Parallel()
+
+	lines, err := fs.ReadLines("testdata/proc/diskstats")
+	require.NoError(t, err)
+
+	got, err := parseDiskStats(lines, map[string]struct{}{"sda": {}, "nvme0n1": {}})
+	require.NoError(t, err)
+	require.Equal(t, diskCounters{
+		"nvme0n1": {reads: 48213, writes: 91234, sectorsRead: 3120456, sectorsWritten: 5421880},
+		"sda":     {reads: 1204, writes: 520, sectorsRead: 40312, sectorsWritten: 12840},
+	}, got)
+
+	_, err = parseDiskStats([]string{"8 0 sda 1 0 2"}, nil)
+	require.ErrorIs(t, err, metrics.ErrInvalidOutput)
+	_, err = parseDiskStats([]string{"8 0 sda x 0 2 0 3 0 4"}, map[string]struct{}{"sda": {}})
+	require.Error(t, err)
+}
+
+func Test_calcDiskLoad(t *testing.T) {
+	t.Parallel()
+
+	prev := diskCounters{
+		"sda": {reads: 100, writes: 200, sectorsRead: 1000, sectorsWritten: 2000},
+		"sdb": {reads: 500, writes: 500, sectorsRead: 5000, sectorsWritten: 5000},
+	}
+	cur := diskCounters{
+		"sda": {reads: 120, writes: 260, sectorsRead: 1400, sectorsWritten: 2800},
+		// Counters are reset
+		"sdb": {reads: 10, writes: 10, sectorsRead: 100, sectorsWritten: 100},
+		// Not present in the previous snapshot
+		"sdc": {reads: 10, writes: 10, sectorsRead: 100, sectorsWritten: 100},
+	}
+
+	var got models.DiskStats
+	calcDiskLoad(prev, cur, 2, &got)
+	require.Equal(t, models.DiskStats{Reads: 10, Writes: 30, ReadWriteKb: 300}, got)
+}
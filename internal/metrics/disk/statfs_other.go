@@ -0,0 +1,10 @@
+//go:build !darwin && !linux
+
+package disk
+
+import "github.com/sitnikovik/sysmon/internal/metrics"
+
+// statfs is not supported on the platform.
+func statfs(_ string) (fsUsage, error) {
+	return fsUsage{}, metrics.ErrUnsupportedOS
+}
@@ -0,0 +1,22 @@
+//go:build darwin || linux
+
+package disk
+
+import "syscall"
+
+// statfs returns the space and inodes usage of the filesystem the path is on.
+func statfs(path string) (fsUsage, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return fsUsage{}, err
+	}
+
+	return fsUsage{
+		blockSize: uint64(st.Bsize),
+		blocks:    st.Blocks,
+		free:      st.Bfree,
+		available: st.Bavail,
+		files:     st.Files,
+		filesFree: st.Ffree,
+	}, nil
+}
@@ -10,9 +10,9 @@ import (
 )
 
 // parseDiskSpaceForUnix parses the disk space for Unix OS and fills the provided result struct.
-func (p *parser) parseDiskSpaceForUnix(_ context.Context, res *models.DiskStats) error {
+func (p *parser) parseDiskSpaceForUnix(ctx context.Context, res *models.DiskStats) error {
 	var err error
-	cmdRes, err := p.execer.Exec(unixCmdDiskSpace, unixArgsDiskSpace...)
+	cmdRes, err := p.execer.Exec(ctx, unixCmdDiskSpace, unixArgsDiskSpace...)
 	if err != nil {
 		return err
 	}
@@ -27,6 +27,8 @@ func (p *parser) parseDiskSpaceForUnix(_ context.Context, res *models.DiskStats)
 		return metrics.ErrInvalidOutput
 	}
 
+	res.Mount = rootMount
+
 	// Getting the total disk space
 	total, _ := strconv.ParseUint(strings.TrimSuffix(data[1], "G"), 10, 64)
 	res.TotalMb = total * 1024
@@ -43,8 +45,8 @@ func (p *parser) parseDiskSpaceForUnix(_ context.Context, res *models.DiskStats)
 }
 
 // parseDiskSpaceAsInodesForUnix parses the disk space as inodes for unix OS and fills the provided result struct.
-func (p *parser) parseDiskSpaceAsInodesForUnix(_ context.Context, res *models.DiskStats) error {
-	cmdRes, err := p.execer.Exec(unixCmdDiskSpaceInodes, unixArgsDiskSpaceInodes...)
+func (p *parser) parseDiskSpaceAsInodesForUnix(ctx context.Context, res *models.DiskStats) error {
+	cmdRes, err := p.execer.Exec(ctx, unixCmdDiskSpaceInodes, unixArgsDiskSpaceInodes...)
 	if err != nil {
 		return err
 	}
@@ -9,4 +9,12 @@ var (
 	ErrUnsupportedOS = errors.New("unsupported platform")
 	// ErrInvalidOutput is an error returned when the output is invalid.
 	ErrInvalidOutput = errors.New("invalid output")
+	// ErrNotAvailable is an error returned when the metric source does not exist on the system,
+	// e.g. the kernel module providing it is not loaded.
+	ErrNotAvailable = errors.New("not available")
+	// ErrTimeout is an error returned when the metric is not collected in time.
+	ErrTimeout = errors.New("timeout")
+	// ErrWarmingUp is an error returned when the rates are not calculated yet
+	// since there is only one snapshot of the counters.
+	ErrWarmingUp = errors.New("warming up")
 )
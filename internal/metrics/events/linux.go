@@ -0,0 +1,238 @@
+package events
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	fsUtils "github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// kmsgBufferSize is the size of the buffer to read the kernel log records,
+// reading /dev/kmsg fails if the record does not fit into the buffer.
+const kmsgBufferSize = 8192
+
+var (
+	// reOOMKill matches the OOM killer victim like "Out of memory: Killed process 1234 (java)".
+	reOOMKill = regexp.MustCompile(`(?:Out of memory|Memory cgroup out of memory): Killed process (\d+) \((.*?)\)`)
+	// reHungTask matches the hung task warning like "INFO: task jbd2/sda1-8:312 blocked for more than 120 seconds".
+	reHungTask = regexp.MustCompile(`INFO: task (.+):(\d+) blocked for more than \d+ seconds`)
+	// reLinkFlap matches the NIC link state change like "eth0: NIC Link is Down".
+	reLinkFlap = regexp.MustCompile(`([^\s:]+):? (?:NIC )?[Ll]ink (?:is )?(Up|Down|up|down)\b`)
+	// reFSErrors match the filesystem and block device errors capturing the device name.
+	reFSErrors = []*regexp.Regexp{
+		regexp.MustCompile(`^EXT[234]-fs error \(device ([^)]+)\)`),
+		regexp.MustCompile(`^XFS \(([^)]+)\): .*(?:[Cc]orruption|I/O error|[Ss]hutting down)`),
+		regexp.MustCompile(`^BTRFS (?:error|critical) \(device ([^)]+)\)`),
+		regexp.MustCompile(`^Buffer I/O error on dev(?:ice)? ([^,\s]+)`),
+		regexp.MustCompile(`I/O error, dev ([^,\s]+), sector`),
+	}
+)
+
+// runForLinux watches the system for the events on Linux.
+// The kernel log records are watched since the start.
+// The kernel log provides the victims of the OOM kills, so the OOM kills counter is watched
+// only if the kernel log is not readable, e.g. restricted by kernel.dmesg_restrict.
+func (w *watcher) runForLinux(ctx context.Context) error {
+	f, err := os.Open(w.kmsg)
+	if err != nil {
+		if !errors.Is(err, fs.ErrPermission) && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		return w.watchOOMKills(ctx)
+	}
+
+	// The records logged since the boot are not the events happened while watching,
+	// so they are skipped not to be reported again on every start
+	if _, err = f.Seek(0, io.SeekEnd); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to skip the kernel log records: %w", err)
+	}
+
+	bootTime, err := readBootTime(w.paths.Proc)
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("failed to read boot time: %w", err)
+	}
+
+	return w.watchKmsg(ctx, f, bootTime)
+}
+
+// watchKmsg reads the kernel log records and adds the events detected to the sink.
+// Reading blocks waiting for the new records until the context is done.
+func (w *watcher) watchKmsg(ctx context.Context, f *os.File, bootTime time.Time) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		// Closing the file unblocks the pending read
+		<-ctx.Done()
+		f.Close()
+	}()
+
+	r := bufio.NewReaderSize(f, kmsgBufferSize)
+	for {
+		line, err := r.ReadString('\n')
+		switch {
+		case err == nil:
+		case errors.Is(err, syscall.EPIPE):
+			// The records were overwritten in the ring buffer before being read
+			continue
+		case errors.Is(err, io.EOF), ctx.Err() != nil:
+			return nil
+		default:
+			return err
+		}
+
+		if e, ok := parseRecord(strings.TrimSuffix(line, "\n"), bootTime); ok {
+			w.sink.Add(ctx, e)
+		}
+	}
+}
+
+// watchOOMKills polls the OOM kills counter and adds the event with no victim to the sink when it grows.
+func (w *watcher) watchOOMKills(ctx context.Context) error {
+	prev, err := w.readOOMKills()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		cur, err := w.readOOMKills()
+		if err != nil {
+			return err
+		}
+		if cur > prev {
+			w.sink.Add(ctx, models.Event{
+				Time:     w.now(),
+				Kind:     models.EventOOMKill,
+				Severity: models.SeverityCritical,
+				Message:  fmt.Sprintf("%d processes killed by the OOM killer", cur-prev),
+			})
+		}
+		prev = cur
+	}
+}
+
+// readOOMKills reads the number of the OOM kills since the boot from /proc/vmstat.
+func (w *watcher) readOOMKills() (uint64, error) {
+	lines, err := fsUtils.ReadLines(filepath.Join(w.paths.Proc, fileVMStat))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "oom_kill" {
+			return strconv.ParseUint(fields[1], 10, 64)
+		}
+	}
+
+	// The counter exists since Linux 4.13
+	return 0, metrics.ErrNotAvailable
+}
+
+// readBootTime reads the time the system was booted at from /proc/stat.
+func readBootTime(proc string) (time.Time, error) {
+	lines, err := fsUtils.ReadLines(filepath.Join(proc, fileStat))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "btime" {
+			continue
+		}
+
+		btime, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		return time.Unix(btime, 0), nil
+	}
+
+	return time.Time{}, metrics.ErrInvalidOutput
+}
+
+// parseRecord parses the kernel log record like "6,1234,5678901234,-;message"
+// where the prefix holds the priority, the sequence number and the time since the boot in microseconds.
+// Returns false if the record is not the event.
+func parseRecord(line string, bootTime time.Time) (models.Event, bool) {
+	// The continuation lines with the key-value pairs start with a space
+	prefix, msg, ok := strings.Cut(line, ";")
+	if !ok || strings.HasPrefix(line, " ") {
+		return models.Event{}, false
+	}
+
+	fields := strings.Split(prefix, ",")
+	if len(fields) < 3 {
+		return models.Event{}, false
+	}
+	usec, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return models.Event{}, false
+	}
+
+	e := models.Event{
+		Time:    bootTime.Add(time.Duration(usec) * time.Microsecond),
+		Message: msg,
+	}
+
+	if m := reOOMKill.FindStringSubmatch(msg); m != nil {
+		e.Kind = models.EventOOMKill
+		e.Severity = models.SeverityCritical
+		e.Process.PID, _ = strconv.Atoi(m[1])
+		e.Process.Name = m[2]
+		return e, true
+	}
+
+	if m := reHungTask.FindStringSubmatch(msg); m != nil {
+		e.Kind = models.EventHungTask
+		e.Severity = models.SeverityError
+		e.Process.PID, _ = strconv.Atoi(m[2])
+		e.Process.Name = m[1]
+		return e, true
+	}
+
+	for _, re := range reFSErrors {
+		if m := re.FindStringSubmatch(msg); m != nil {
+			e.Kind = models.EventFSError
+			e.Severity = models.SeverityError
+			e.Device = m[1]
+			return e, true
+		}
+	}
+
+	if m := reLinkFlap.FindStringSubmatch(msg); m != nil {
+		e.Kind = models.EventLinkFlap
+		e.Severity = models.SeverityInfo
+		if strings.EqualFold(m[2], "down") {
+			e.Severity = models.SeverityWarning
+		}
+		e.Device = m[1]
+		return e, true
+	}
+
+	return models.Event{}, false
+}
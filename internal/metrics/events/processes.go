@@ -0,0 +1,53 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+)
+
+var (
+	// processPollInterval is the interval to scan the PID table at,
+	// the processes living shorter than the interval may be missed.
+	processPollInterval = time.Second
+	// filePasswd is the file of the root filesystem with the users.
+	filePasswd = "etc/passwd"
+)
+
+// processWatcher - struct to hold the processes lifecycle watcher dependencies.
+type processWatcher struct {
+	execer     cmd.Execer
+	paths      fs.Paths
+	interval   time.Duration
+	now        func() time.Time
+	lookupUser func(uid string) string
+	sink       Sink
+}
+
+// NewProcessWatcher returns a new watcher to detect the processes starting and exiting
+// by diffing the PID table between the scans.
+//
+//nolint:revive
+func NewProcessWatcher(execer cmd.Execer, paths fs.Paths, sink Sink) *processWatcher {
+	return &processWatcher{
+		execer:     execer,
+		paths:      paths,
+		interval:   processPollInterval,
+		now:        time.Now,
+		lookupUser: newUserLookup(paths.Root),
+		sink:       sink,
+	}
+}
+
+// Run watches the processes starting and exiting and adds the events to the sink until the context is done.
+func (w *processWatcher) Run(ctx context.Context) error {
+	if w.execer.OS() == os.Linux {
+		return w.runForLinux(ctx)
+	}
+
+	return metrics.ErrUnsupportedOS
+}
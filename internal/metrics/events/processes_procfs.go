@@ -0,0 +1,263 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	fsUtils "github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// clockTicks is the number of the clock ticks per second the process start time is measured in.
+const clockTicks = 100
+
+// processKey identifies the process across the scans, the start time protects from the reused PIDs.
+type processKey struct {
+	pid       int
+	startTime uint64
+}
+
+// process holds the process details the lifecycle events carry.
+type process struct {
+	ppid    int
+	name    string
+	command string
+	uid     string
+	started time.Time
+}
+
+// runForLinux watches the processes lifecycle on Linux.
+func (w *processWatcher) runForLinux(ctx context.Context) error {
+	bootTime, err := readBootTime(w.paths.Proc)
+	if err != nil {
+		return fmt.Errorf("failed to read boot time: %w", err)
+	}
+
+	// The processes running at the start are not reported
+	prev, err := w.scan(nil, bootTime)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		cur, err := w.scan(prev, bootTime)
+		if err != nil {
+			return err
+		}
+		for _, e := range w.diff(prev, cur, w.now()) {
+			w.sink.Add(ctx, e)
+		}
+		prev = cur
+	}
+}
+
+// scan reads the PID table. Only the stat of the processes seen by the previous scan is read,
+// their details are carried forward since they are read once the process is seen first.
+func (w *processWatcher) scan(prev map[processKey]process, bootTime time.Time) (map[processKey]process, error) {
+	entries, err := os.ReadDir(w.paths.Proc)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[processKey]process, len(entries))
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		// The process may exit during the scan
+		key, proc, err := w.readStat(pid, bootTime)
+		if err != nil {
+			continue
+		}
+		if seen, ok := prev[key]; ok {
+			res[key] = seen
+			continue
+		}
+		if err = w.readDetails(pid, &proc); err != nil {
+			continue
+		}
+		res[key] = proc
+	}
+
+	return res, nil
+}
+
+// readStat reads the key of the process along with its name, parent and start time from its stat.
+func (w *processWatcher) readStat(pid int, bootTime time.Time) (processKey, process, error) {
+	bb, err := os.ReadFile(filepath.Join(w.paths.Proc, strconv.Itoa(pid), "stat"))
+	if err != nil {
+		return processKey{}, process{}, err
+	}
+	// The command name is in parentheses and may contain spaces and parentheses itself
+	s := string(bb)
+	start := strings.IndexByte(s, '(')
+	end := strings.LastIndexByte(s, ')')
+	if start == -1 || end < start {
+		return processKey{}, process{}, metrics.ErrInvalidOutput
+	}
+	fields := strings.Fields(s[end+1:])
+	if len(fields) < 20 {
+		return processKey{}, process{}, metrics.ErrInvalidOutput
+	}
+
+	key := processKey{pid: pid}
+	proc := process{name: s[start+1 : end]}
+	if proc.ppid, err = strconv.Atoi(fields[1]); err != nil {
+		return processKey{}, process{}, err
+	}
+	if key.startTime, err = strconv.ParseUint(fields[19], 10, 64); err != nil {
+		return processKey{}, process{}, err
+	}
+	proc.started = bootTime.Add(time.Duration(key.startTime) * time.Second / clockTicks)
+
+	return key, proc, nil
+}
+
+// readDetails reads the owner and the command line of the process.
+func (w *processWatcher) readDetails(pid int, proc *process) error {
+	dir := filepath.Join(w.paths.Proc, strconv.Itoa(pid))
+
+	status, err := fsUtils.ReadLines(filepath.Join(dir, "status"))
+	if err != nil {
+		return err
+	}
+	for _, line := range status {
+		if fields := strings.Fields(line); len(fields) > 1 && fields[0] == "Uid:" {
+			proc.uid = fields[1]
+			break
+		}
+	}
+
+	// The kernel threads and the zombies have no command line
+	cmdline, err := os.ReadFile(filepath.Join(dir, "cmdline"))
+	if err == nil {
+		proc.command = string(bytes.TrimSpace(bytes.ReplaceAll(cmdline, []byte{0}, []byte{' '})))
+	}
+	if proc.command == "" {
+		proc.command = "[" + proc.name + "]"
+	}
+
+	return nil
+}
+
+// diff returns the events of the processes started and exited between the scans.
+func (w *processWatcher) diff(prev, cur map[processKey]process, now time.Time) []models.Event {
+	var res []models.Event
+	for key, proc := range cur {
+		if _, ok := prev[key]; ok {
+			continue
+		}
+		res = append(res, models.Event{
+			Time:     proc.started,
+			Kind:     models.EventProcessStart,
+			Severity: models.SeverityInfo,
+			Message:  "Started " + proc.command,
+			Process:  w.eventProcess(key, proc, 0),
+		})
+	}
+	for key, proc := range prev {
+		if _, ok := cur[key]; ok {
+			continue
+		}
+		lifetime := now.Sub(proc.started)
+		res = append(res, models.Event{
+			Time:     now,
+			Kind:     models.EventProcessExit,
+			Severity: models.SeverityInfo,
+			Message:  fmt.Sprintf("Exited %s after %s", proc.command, lifetime.Round(time.Second)),
+			Process:  w.eventProcess(key, proc, lifetime),
+		})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if !res[i].Time.Equal(res[j].Time) {
+			return res[i].Time.Before(res[j].Time)
+		}
+		if res[i].Process.PID != res[j].Process.PID {
+			return res[i].Process.PID < res[j].Process.PID
+		}
+		// The process exits before the new one reuses its PID
+		return res[i].Kind == models.EventProcessExit
+	})
+
+	return res
+}
+
+// eventProcess returns the process of the lifecycle event.
+func (w *processWatcher) eventProcess(key processKey, proc process, lifetime time.Duration) models.EventProcess {
+	return models.EventProcess{
+		PID:         key.pid,
+		Name:        proc.name,
+		PPID:        proc.ppid,
+		Command:     proc.command,
+		User:        w.lookupUser(proc.uid),
+		LifetimeSec: lifetime.Seconds(),
+	}
+}
+
+// newUserLookup returns the function resolving the user names by the UIDs with the cache.
+// The users of the root filesystem mounted elsewhere, e.g. the host one in a container,
+// are looked up in its passwd file. The UID is returned as is if the user is unknown.
+func newUserLookup(root string) func(uid string) string {
+	var mu sync.Mutex
+	cache := make(map[string]string)
+
+	return func(uid string) string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if name, ok := cache[uid]; ok {
+			return name
+		}
+
+		name := uid
+		if filepath.Clean(root) == "/" {
+			if u, err := user.LookupId(uid); err == nil {
+				name = u.Username
+			}
+		} else if u, ok := lookupPasswd(filepath.Join(root, filePasswd), uid); ok {
+			name = u
+		}
+		cache[uid] = name
+
+		return name
+	}
+}
+
+// lookupPasswd returns the name of the user by the UID from the passwd file.
+func lookupPasswd(path, uid string) (string, bool) {
+	lines, err := fsUtils.ReadLines(path)
+	if err != nil {
+		return "", false
+	}
+
+	// name:password:UID:GID:GECOS:directory:shell
+	for _, line := range lines {
+		fields := strings.Split(line, ":")
+		if len(fields) > 2 && fields[2] == uid {
+			return fields[0], true
+		}
+	}
+
+	return "", false
+}
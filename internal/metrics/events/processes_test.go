@@ -0,0 +1,211 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+func Test_processWatcher_Run(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		execerMockFunc func(t *testing.T) cmd.Execer
+		paths          fs.Paths
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+	}{
+		{
+			name: "ok linux no changes",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/proc"},
+			},
+		},
+		{
+			name: "err linux no procfs",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/notexists"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "err darwin unsupported",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Darwin).
+						Once()
+
+					return execer
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			s := &sink{}
+			w := NewProcessWatcher(tt.fields.execerMockFunc(t), tt.fields.paths, s)
+			w.interval = 10 * time.Millisecond
+			err := w.Run(ctx)
+
+			require.Equalf(t, tt.wantErr, err != nil, "error = %v", err)
+			require.Empty(t, s.events)
+		})
+	}
+}
+
+func Test_processWatcher_scan(t *testing.T) {
+	t.Parallel()
+
+	bootTime := time.Unix(1760850000, 0)
+	w := NewProcessWatcher(cmd.NewMockExecer(t), fs.Paths{Proc: "testdata/proc"}, &sink{})
+
+	got, err := w.scan(nil, bootTime)
+	require.NoError(t, err)
+	want := map[processKey]process{
+		{pid: 1, startTime: 12}: {
+			name:    "systemd",
+			command: "/sbin/init splash",
+			uid:     "0",
+			started: bootTime.Add(120 * time.Millisecond),
+		},
+		{pid: 4242, startTime: 360000}: {
+			ppid:    1,
+			name:    "java",
+			command: "java -Xmx4g -jar app.jar",
+			uid:     "1000",
+			started: bootTime.Add(time.Hour),
+		},
+	}
+	require.Equal(t, want, got)
+
+	// The details of the processes seen are carried forward, not read again
+	prev := map[processKey]process{
+		{pid: 4242, startTime: 360000}: {
+			ppid:    1,
+			name:    "java",
+			command: "java -jar cached.jar",
+			uid:     "1000",
+			started: bootTime.Add(time.Hour),
+		},
+		// The PID is reused by the new process
+		{pid: 1, startTime: 10}: {name: "init", command: "/sbin/init"},
+	}
+	got, err = w.scan(prev, bootTime)
+	require.NoError(t, err)
+	want[processKey{pid: 4242, startTime: 360000}] = prev[processKey{pid: 4242, startTime: 360000}]
+	require.Equal(t, want, got)
+}
+
+func Test_processWatcher_diff(t *testing.T) {
+	t.Parallel()
+
+	bootTime := time.Unix(1760850000, 0)
+	now := bootTime.Add(2 * time.Hour)
+
+	w := NewProcessWatcher(cmd.NewMockExecer(t), fs.Paths{}, &sink{})
+	w.lookupUser = func(uid string) string {
+		return map[string]string{"0": "root"}[uid]
+	}
+
+	cron := process{ppid: 1, name: "cron", command: "/usr/sbin/cron -f", uid: "0", started: bootTime}
+	worker := process{ppid: 900, name: "worker", command: "worker --queue=mail", uid: "33", started: now.Add(-30 * time.Second)}
+	restarted := process{ppid: 900, name: "worker", command: "worker --queue=mail", uid: "33", started: now}
+	job := process{ppid: 500, name: "backup.sh", command: "/bin/sh /etc/cron.daily/backup.sh", uid: "0", started: now.Add(-time.Second)}
+
+	prev := map[processKey]process{
+		{pid: 500, startTime: 1}:  cron,
+		{pid: 901, startTime: 70}: worker,
+	}
+	cur := map[processKey]process{
+		{pid: 500, startTime: 1}:    cron,
+		{pid: 7001, startTime: 719}: job,
+		// The worker restarted with the same PID
+		{pid: 901, startTime: 720}: restarted,
+	}
+
+	require.Equal(t, []models.Event{
+		{
+			Time:     now.Add(-time.Second),
+			Kind:     models.EventProcessStart,
+			Severity: models.SeverityInfo,
+			Message:  "Started /bin/sh /etc/cron.daily/backup.sh",
+			Process: models.EventProcess{
+				PID: 7001, Name: "backup.sh", PPID: 500, Command: "/bin/sh /etc/cron.daily/backup.sh", User: "root",
+			},
+		},
+		{
+			Time:     now,
+			Kind:     models.EventProcessExit,
+			Severity: models.SeverityInfo,
+			Message:  "Exited worker --queue=mail after 30s",
+			Process: models.EventProcess{
+				PID: 901, Name: "worker", PPID: 900, Command: "worker --queue=mail", LifetimeSec: 30,
+			},
+		},
+		{
+			Time:     now,
+			Kind:     models.EventProcessStart,
+			Severity: models.SeverityInfo,
+			Message:  "Started worker --queue=mail",
+			Process:  models.EventProcess{PID: 901, Name: "worker", PPID: 900, Command: "worker --queue=mail"},
+		},
+	}, w.diff(prev, cur, now))
+}
+
+func Test_newUserLookup(t *testing.T) {
+	t.Parallel()
+
+	lookup := newUserLookup("testdata/rootfs")
+	require.Equal(t, "reports", lookup("1001"))
+	require.Equal(t, "root", lookup("0"))
+	require.Equal(t, "4242", lookup("4242"))
+
+	require.Equal(t, "4242", newUserLookup("testdata/notexists")("4242"))
+}
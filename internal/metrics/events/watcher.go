@@ -0,0 +1,63 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+var (
+	// fileKmsg is the device with the kernel log records.
+	fileKmsg = "/dev/kmsg"
+	// fileVMStat is the procfs file with the virtual memory counters.
+	fileVMStat = "vmstat"
+	// fileStat is the procfs file with the boot time.
+	fileStat = "stat"
+
+	// pollInterval is the interval to poll the OOM kills counter at.
+	pollInterval = time.Second
+)
+
+// Sink defines the interface for storing the detected events.
+type Sink interface {
+	// Add stores the event
+	Add(ctx context.Context, e models.Event)
+}
+
+// watcher - struct to hold the watcher dependencies.
+type watcher struct {
+	execer   cmd.Execer
+	paths    fs.Paths
+	kmsg     string
+	interval time.Duration
+	now      func() time.Time
+	sink     Sink
+}
+
+// NewWatcher returns a new watcher to detect the OOM kills and the kernel errors as the discrete events.
+//
+//nolint:revive
+func NewWatcher(execer cmd.Execer, paths fs.Paths, sink Sink) *watcher {
+	return &watcher{
+		execer:   execer,
+		paths:    paths,
+		kmsg:     fileKmsg,
+		interval: pollInterval,
+		now:      time.Now,
+		sink:     sink,
+	}
+}
+
+// Run watches the system for the events and adds them to the sink until the context is done.
+func (w *watcher) Run(ctx context.Context) error {
+	if w.execer.OS() == os.Linux {
+		return w.runForLinux(ctx)
+	}
+
+	return metrics.ErrUnsupportedOS
+}
@@ -0,0 +1,254 @@
+package events
+
+import (
+	"context"
+	stdos "os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// sink collects the events added by the watcher.
+type sink struct {
+	mu     sync.Mutex
+	events []models.Event
+}
+
+// Add stores the event.
+func (s *sink) Add(_ context.Context, e models.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = append(s.events, e)
+}
+
+func Test_watcher_Run(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		execerMockFunc func(t *testing.T) cmd.Execer
+		paths          fs.Paths
+		kmsg           string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []models.Event
+		wantErr bool
+	}{
+		{
+			name: "ok linux kernel log records before the start skipped",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/proc"},
+				kmsg:  "testdata/kmsg",
+			},
+			// The records logged before the start are skipped
+		},
+		{
+			name: "ok linux kernel log not readable",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/proc"},
+				kmsg:  "testdata/notexists",
+			},
+		},
+		{
+			name: "err linux no vmstat",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/notexists"},
+				kmsg:  "testdata/notexists",
+			},
+			wantErr: true,
+		},
+		{
+			name: "err darwin unsupported",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Darwin).
+						Once()
+
+					return execer
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			s := &sink{}
+			w := NewWatcher(tt.fields.execerMockFunc(t), tt.fields.paths, s)
+			w.kmsg = tt.fields.kmsg
+			w.interval = 10 * time.Millisecond
+			err := w.Run(ctx)
+
+			require.Equalf(t, tt.wantErr, err != nil, "error = %v", err)
+			require.Equal(t, tt.want, s.events)
+		})
+	}
+}
+
+func Test_watcher_watchKmsg(t *testing.T) {
+	t.Parallel()
+
+	bootTime := time.Unix(1760850000, 0)
+	f, err := stdos.Open("testdata/kmsg")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	s := &sink{}
+	w := NewWatcher(cmd.NewMockExecer(t), fs.Paths{Proc: "testdata/proc"}, s)
+	require.NoError(t, w.watchKmsg(ctx, f, bootTime))
+	require.Equal(t, []models.Event{
+		{
+			Time:     bootTime.Add(5120 * time.Millisecond),
+			Kind:     models.EventLinkFlap,
+			Severity: models.SeverityInfo,
+			Message:  "e1000e 0000:00:19.0 eth0: NIC Link is Up 1000 Mbps Full Duplex, Flow Control: Rx/Tx",
+			Device:   "eth0",
+		},
+		{
+			Time:     bootTime.Add(time.Hour),
+			Kind:     models.EventLinkFlap,
+			Severity: models.SeverityWarning,
+			Message:  "mlx5_core 0000:3b:00.0 ens1f0: Link down",
+			Device:   "ens1f0",
+		},
+		{
+			Time:     bootTime.Add(2 * time.Hour),
+			Kind:     models.EventHungTask,
+			Severity: models.SeverityError,
+			Message:  "INFO: task jbd2/sda1-8:312 blocked for more than 120 seconds.",
+			Process:  models.EventProcess{PID: 312, Name: "jbd2/sda1-8"},
+		},
+		{
+			Time:     bootTime.Add(2*time.Hour + 15*time.Minute),
+			Kind:     models.EventFSError,
+			Severity: models.SeverityError,
+			Message:  "EXT4-fs error (device sda1): ext4_find_entry:1455: inode #2: comm nginx: reading directory lblock 0",
+			Device:   "sda1",
+		},
+		{
+			Time:     bootTime.Add(150 * time.Minute),
+			Kind:     models.EventOOMKill,
+			Severity: models.SeverityCritical,
+			Message: "Out of memory: Killed process 4242 (java) total-vm:8123456kB, anon-rss:6123456kB, " +
+				"file-rss:0kB, shmem-rss:0kB, UID:1000 pgtables:12345kB oom_score_adj:0",
+			Process: models.EventProcess{PID: 4242, Name: "java"},
+		},
+	}, s.events)
+}
+
+func Test_parseRecord(t *testing.T) {
+	t.Parallel()
+
+	bootTime := time.Unix(1760850000, 0)
+	tests := []struct {
+		name string
+		line string
+		want models.Event
+		ok   bool
+	}{
+		{
+			name: "memory cgroup oom kill",
+			line: "3,500,1000000,-;Memory cgroup out of memory: Killed process 77 (php-fpm: pool) total-vm:1kB",
+			want: models.Event{
+				Time:     bootTime.Add(time.Second),
+				Kind:     models.EventOOMKill,
+				Severity: models.SeverityCritical,
+				Message:  "Memory cgroup out of memory: Killed process 77 (php-fpm: pool) total-vm:1kB",
+				Process:  models.EventProcess{PID: 77, Name: "php-fpm: pool"},
+			},
+			ok: true,
+		},
+		{
+			name: "block device error",
+			line: "3,501,2000000,-;I/O error, dev nvme0n1, sector 2048 op 0x0:(READ) flags 0x0 phys_seg 1 prio class 0",
+			want: models.Event{
+				Time:     bootTime.Add(2 * time.Second),
+				Kind:     models.EventFSError,
+				Severity: models.SeverityError,
+				Message:  "I/O error, dev nvme0n1, sector 2048 op 0x0:(READ) flags 0x0 phys_seg 1 prio class 0",
+				Device:   "nvme0n1",
+			},
+			ok: true,
+		},
+		{
+			name: "not event",
+			line: "6,502,3000000,-;systemd[1]: Started Daily apt upgrade and clean activities.",
+		},
+		{
+			name: "continuation line",
+			line: " SUBSYSTEM=block",
+		},
+		{
+			name: "invalid prefix",
+			line: "6,503;Out of memory: Killed process 1 (init)",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := parseRecord(tt.line, bootTime)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
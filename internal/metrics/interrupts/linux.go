@@ -0,0 +1,230 @@
+package interrupts
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/sampler"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// counters holds the interrupts counters per CPU read from a procfs file.
+type counters struct {
+	// cpus is the number of CPUs the counters are reported for.
+	cpus int
+	// irqs are the interrupts in the order of the file.
+	irqs []irqCounters
+}
+
+// snapshot holds the hardware and software interrupts counters read at once.
+type snapshot struct {
+	hard counters
+	soft counters
+}
+
+// irqCounters holds the counters of the single interrupt.
+type irqCounters struct {
+	name   string
+	device string
+	perCPU []uint64
+}
+
+// parseForLinux parses the interrupts distribution for Linux.
+func (p *parser) parseForLinux(_ context.Context) (models.InterruptsStats, error) {
+	curHard, curSoft, err := p.readCounters()
+	if err != nil {
+		return models.InterruptsStats{}, err
+	}
+
+	prev, seconds, err := p.sampler.Next(snapshot{hard: curHard, soft: curSoft})
+	if err != nil {
+		return models.InterruptsStats{}, err
+	}
+	prevHard, prevSoft := prev.hard, prev.soft
+
+	res := models.InterruptsStats{
+		CPUs:     curHard.cpus,
+		SoftIRQs: calcRates(prevSoft, curSoft, seconds),
+		PerCPU:   make([]float64, curHard.cpus),
+	}
+
+	// The local interrupts like the timer and the IPIs are raised on every CPU by the kernel itself,
+	// so they swamp the device interrupts the distribution is of and are reported apart
+	var devices []models.IRQStats
+	for _, irq := range calcRates(prevHard, curHard, seconds) {
+		if !isDevice(irq.IRQ) {
+			res.LocalIRQs = append(res.LocalIRQs, irq)
+			continue
+		}
+		devices = append(devices, irq)
+		for cpu, rate := range irq.PerCPU {
+			res.PerCPU[cpu] += rate
+		}
+	}
+	res.BusiestCPU, res.Imbalance = imbalance(res.PerCPU)
+
+	sort.SliceStable(devices, func(i, j int) bool {
+		return devices[i].Total > devices[j].Total
+	})
+	for _, irq := range devices {
+		if len(res.TopIRQs) == topN || irq.Total == 0 {
+			break
+		}
+		res.TopIRQs = append(res.TopIRQs, irq)
+	}
+
+	return res, nil
+}
+
+// readCounters reads the hardware and software interrupts counters.
+func (p *parser) readCounters() (counters, counters, error) {
+	lines, err := fs.ReadLines(filepath.Join(p.paths.Proc, fileInterrupts))
+	if err != nil {
+		return counters{}, counters{}, err
+	}
+	hard, err := parseCounters(lines)
+	if err != nil {
+		return counters{}, counters{}, fmt.Errorf("failed to parse %s: %w", fileInterrupts, err)
+	}
+
+	lines, err = fs.ReadLines(filepath.Join(p.paths.Proc, fileSoftIRQs))
+	if err != nil {
+		return counters{}, counters{}, err
+	}
+	soft, err := parseCounters(lines)
+	if err != nil {
+		return counters{}, counters{}, fmt.Errorf("failed to parse %s: %w", fileSoftIRQs, err)
+	}
+
+	return hard, soft, nil
+}
+
+// parseCounters parses the content of /proc/interrupts or /proc/softirqs.
+func parseCounters(lines []string) (counters, error) {
+	if len(lines) < 2 {
+		return counters{}, metrics.ErrInvalidOutput
+	}
+
+	// The first line lists the CPUs the columns are reported for
+	res := counters{cpus: len(strings.Fields(lines[0]))}
+	if res.cpus == 0 {
+		return counters{}, metrics.ErrInvalidOutput
+	}
+
+	for _, line := range lines[1:] {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		irq := irqCounters{
+			name:   strings.TrimSuffix(fields[0], ":"),
+			perCPU: make([]uint64, 0, res.cpus),
+		}
+		i := 1
+		for ; i < len(fields) && i <= res.cpus; i++ {
+			n, err := strconv.ParseUint(fields[i], 10, 64)
+			if err != nil {
+				break
+			}
+			irq.perCPU = append(irq.perCPU, n)
+		}
+		// Lines like ERR and MIS have the only total counter, they are not distributed per CPU
+		if len(irq.perCPU) != res.cpus {
+			continue
+		}
+		irq.device = parseDevice(irq.name, fields[i:])
+
+		res.irqs = append(res.irqs, irq)
+	}
+
+	return res, nil
+}
+
+// isDevice reports whether the interrupt is the numbered one of a device,
+// the named ones like LOC or RES are the local interrupts of the CPUs.
+func isDevice(name string) bool {
+	_, err := strconv.Atoi(name)
+
+	return err == nil
+}
+
+// parseDevice returns the device name of the interrupt by the description columns.
+// Numbered interrupts are described by the chip name, the trigger type and the device actions,
+// named ones like NMI or LOC are described by the text.
+func parseDevice(name string, fields []string) string {
+	if len(fields) == 0 {
+		return ""
+	}
+	if !isDevice(name) {
+		return strings.Join(fields, " ")
+	}
+
+	for i := len(fields) - 1; i >= 0; i-- {
+		for _, trigger := range []string{"edge", "level", "fasteoi"} {
+			if strings.HasSuffix(fields[i], trigger) && i < len(fields)-1 {
+				return strings.Join(fields[i+1:], " ")
+			}
+		}
+	}
+
+	return fields[len(fields)-1]
+}
+
+// calcRates calculates the rates per second of the interrupts present in both snapshots.
+func calcRates(prev, cur counters, seconds float64) []models.IRQStats {
+	prevByName := make(map[string]irqCounters, len(prev.irqs))
+	for _, irq := range prev.irqs {
+		prevByName[irq.name] = irq
+	}
+
+	res := make([]models.IRQStats, 0, len(cur.irqs))
+	for _, irq := range cur.irqs {
+		prevIRQ, ok := prevByName[irq.name]
+		if !ok || len(prevIRQ.perCPU) != len(irq.perCPU) {
+			continue
+		}
+
+		stats := models.IRQStats{
+			IRQ:    irq.name,
+			Device: irq.device,
+			PerCPU: make([]float64, len(irq.perCPU)),
+		}
+		for cpu, n := range irq.perCPU {
+			// The counters are unsigned int in the kernel wrapping around on the busy IRQs
+			// and may be reset when the device is reinitialized
+			stats.PerCPU[cpu] = sampler.Rate(prevIRQ.perCPU[cpu], n, 32, seconds)
+			stats.Total += stats.PerCPU[cpu]
+		}
+		stats.BusiestCPU, stats.Imbalance = imbalance(stats.PerCPU)
+
+		res = append(res, stats)
+	}
+
+	return res
+}
+
+// imbalance returns the index of the busiest CPU and the ratio of its rate to the mean one.
+// The ratio is 1 when the interrupts are spread evenly and equals to the number of CPUs
+// when all of them land on the single CPU.
+func imbalance(perCPU []float64) (int, float64) {
+	var busiest int
+	var total float64
+	for cpu, rate := range perCPU {
+		total += rate
+		if rate > perCPU[busiest] {
+			busiest = cpu
+		}
+	}
+	if total == 0 {
+		return 0, 0
+	}
+
+	return busiest, perCPU[busiest] / (total / float64(len(perCPU)))
+}
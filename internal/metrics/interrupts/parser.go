@@ -0,0 +1,55 @@
+package interrupts
+
+import (
+	"context"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/sampler"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+var (
+	// fileInterrupts is the procfs file with the hardware interrupts counters per CPU.
+	fileInterrupts = "interrupts"
+	// fileSoftIRQs is the procfs file with the software interrupts counters per CPU.
+	fileSoftIRQs = "softirqs"
+
+	// topN is the number of the busiest hardware interrupts to report.
+	topN = 10
+)
+
+// parser - struct to hold the parser dependencies.
+type parser struct {
+	execer  cmd.Execer
+	paths   fs.Paths
+	sampler *sampler.Sampler[snapshot]
+}
+
+// NewParser returns a new parser to parse the interrupts distribution.
+//
+//nolint:revive
+func NewParser(execer cmd.Execer, paths fs.Paths) *parser {
+	return &parser{
+		execer:  execer,
+		paths:   paths,
+		sampler: sampler.NewSampler[snapshot](),
+	}
+}
+
+// Parse parses the hardware and software interrupts rates per CPU.
+// The rates are calculated since the previous call, so the first one returns metrics.ErrWarmingUp.
+func (p *parser) Parse(ctx context.Context) (models.InterruptsStats, error) {
+	if p.execer.OS() == os.Linux {
+		return p.parseForLinux(ctx)
+	}
+
+	return models.InterruptsStats{}, metrics.ErrUnsupportedOS
+}
+
+// Reset forgets the previous counters, so the rates are calculated since the next call.
+func (p *parser) Reset() {
+	p.sampler.Reset()
+}
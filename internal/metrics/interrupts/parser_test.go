@@ -0,0 +1,222 @@
+package interrupts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/sampler"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+func Test_parser_Parse(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		execerMockFunc func(t *testing.T) cmd.Execer
+		paths          fs.Paths
+		// nextPaths is the paths of the counters read after the warm-up
+		nextPaths fs.Paths
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		warm    bool
+		fields  fields
+		args    args
+		want    models.InterruptsStats
+		wantErr bool
+	}{
+		{
+			name: "ok linux",
+			warm: true,
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Twice()
+
+					return execer
+				},
+				paths:     fs.Paths{Proc: "testdata"},
+				nextPaths: fs.Paths{Proc: "testdata/next"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			// The counters are read 2 seconds apart
+			want: models.InterruptsStats{
+				CPUs: 4,
+				TopIRQs: []models.IRQStats{
+					{IRQ: "24", Device: "eth0-TxRx-0", PerCPU: []float64{1000, 0, 0, 0}, Total: 1000, Imbalance: 4},
+					{
+						IRQ:        "25",
+						Device:     "eth0-TxRx-1",
+						PerCPU:     []float64{0, 500, 0, 0},
+						Total:      500,
+						BusiestCPU: 1,
+						Imbalance:  4,
+					},
+					{IRQ: "0", Device: "timer", PerCPU: []float64{10, 0, 0, 0}, Total: 10, Imbalance: 4},
+				},
+				LocalIRQs: []models.IRQStats{
+					{IRQ: "NMI", Device: "Non-maskable interrupts", PerCPU: []float64{1, 1, 1, 1}, Total: 4, Imbalance: 1},
+					{
+						IRQ:       "LOC",
+						Device:    "Local timer interrupts",
+						PerCPU:    []float64{989, 499, 499, 499},
+						Total:     2486,
+						Imbalance: 989 / 621.5,
+					},
+				},
+				SoftIRQs: []models.IRQStats{
+					{IRQ: "HI", PerCPU: []float64{0, 0, 0, 0}},
+					{IRQ: "TIMER", PerCPU: []float64{1000, 500, 500, 500}, Total: 2500, Imbalance: 1.6},
+					{IRQ: "NET_TX", PerCPU: []float64{2, 0, 0, 0}, Total: 2, Imbalance: 4},
+					{IRQ: "NET_RX", PerCPU: []float64{2000, 0, 0, 0}, Total: 2000, Imbalance: 4},
+					{IRQ: "BLOCK", PerCPU: []float64{100, 100, 100, 100}, Total: 400, Imbalance: 1},
+				},
+				// The local interrupts are not counted
+				PerCPU:    []float64{1010, 500, 0, 0},
+				Imbalance: 1010 / 377.5,
+			},
+		},
+		{
+			name: "err no procfs files",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/notexists"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "err darwin unsupported",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Darwin).
+						Once()
+
+					return execer
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+			p := &parser{
+				execer: tt.fields.execerMockFunc(t),
+				paths:  tt.fields.paths,
+				sampler: sampler.NewSamplerWithClock[snapshot](func() time.Time {
+					now = now.Add(2 * time.Second)
+					return now
+				}),
+			}
+			if tt.warm {
+				_, err := p.Parse(tt.args.ctx)
+				require.ErrorIs(t, err, metrics.ErrWarmingUp)
+				p.paths = tt.fields.nextPaths
+			}
+			got, err := p.Parse(tt.args.ctx)
+
+			require.Equalf(t, tt.wantErr, err != nil, "error = %v", err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseCounters(t *testing.T) {
+	t.Parallel()
+
+	lines, err := fs.ReadLines("testdata/interrupts")
+	require.NoError(t, err)
+
+	got, err := parseCounters(lines)
+	require.NoError(t, err)
+	require.Equal(t, counters{
+		cpus: 4,
+		irqs: []irqCounters{
+			{name: "0", device: "timer", perCPU: []uint64{22, 0, 0, 0}},
+			{name: "1", device: "i8042", perCPU: []uint64{0, 9, 0, 0}},
+			{name: "24", device: "eth0-TxRx-0", perCPU: []uint64{184021, 0, 0, 0}},
+			{name: "25", device: "eth0-TxRx-1", perCPU: []uint64{12, 30512, 0, 0}},
+			{name: "26", device: "ahci[0000:00:17.0]", perCPU: []uint64{0, 0, 0, 0}},
+			{name: "NMI", device: "Non-maskable interrupts", perCPU: []uint64{3, 2, 2, 2}},
+			{name: "LOC", device: "Local timer interrupts", perCPU: []uint64{852136, 791205, 788931, 790544}},
+		},
+	}, got)
+
+	_, err = parseCounters([]string{""})
+	require.Error(t, err)
+}
+
+func Test_calcRates(t *testing.T) {
+	t.Parallel()
+
+	prev := counters{
+		cpus: 2,
+		irqs: []irqCounters{
+			{name: "24", device: "eth0", perCPU: []uint64{100, 0}},
+			{name: "25", device: "eth1", perCPU: []uint64{100, 100}},
+			{name: "26", device: "nvme0", perCPU: []uint64{500, 0}},
+		},
+	}
+	cur := counters{
+		cpus: 2,
+		irqs: []irqCounters{
+			{name: "24", device: "eth0", perCPU: []uint64{300, 0}},
+			{name: "25", device: "eth1", perCPU: []uint64{150, 150}},
+			// Counter is reset
+			{name: "26", device: "nvme0", perCPU: []uint64{10, 0}},
+			// Not present in the previous snapshot
+			{name: "27", device: "nvme1", perCPU: []uint64{10, 10}},
+		},
+	}
+
+	got := calcRates(prev, cur, 2)
+	require.Equal(t, []models.IRQStats{
+		{IRQ: "24", Device: "eth0", PerCPU: []float64{100, 0}, Total: 100, BusiestCPU: 0, Imbalance: 2},
+		{IRQ: "25", Device: "eth1", PerCPU: []float64{25, 25}, Total: 50, BusiestCPU: 0, Imbalance: 1},
+		{IRQ: "26", Device: "nvme0", PerCPU: []float64{0, 0}},
+	}, got)
+}
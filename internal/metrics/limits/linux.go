@@ -0,0 +1,249 @@
+package limits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	fsUtils "github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// inotifyUsage holds the inotify usage of the single user.
+type inotifyUsage struct {
+	watches   uint64
+	instances uint64
+}
+
+// parseForLinux parses the kernel tables usage against the limits for Linux.
+func (p *parser) parseForLinux(_ context.Context) (models.LimitsStats, error) {
+	var res models.LimitsStats
+	var err error
+
+	if res.FileHandles, err = p.parseFileHandles(); err != nil {
+		return models.LimitsStats{}, fmt.Errorf("failed to parse file handles: %w", err)
+	}
+
+	if res.PIDs, err = p.parsePIDs(); err != nil {
+		return models.LimitsStats{}, fmt.Errorf("failed to parse pids: %w", err)
+	}
+
+	// The connection tracking files exist only when the nf_conntrack module is loaded
+	if res.Conntrack, err = p.parseUsage(fileConntrackCount, fileConntrackMax); err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			return models.LimitsStats{}, fmt.Errorf("failed to parse conntrack: %w", err)
+		}
+	}
+
+	if err = p.parseInotify(&res); err != nil {
+		return models.LimitsStats{}, fmt.Errorf("failed to parse inotify: %w", err)
+	}
+
+	return res, nil
+}
+
+// parseFileHandles parses the number of allocated file handles against fs.file-max.
+func (p *parser) parseFileHandles() (models.LimitUsage, error) {
+	lines, err := fsUtils.ReadLines(filepath.Join(p.paths.Proc, fileFileNr))
+	if err != nil {
+		return models.LimitUsage{}, err
+	}
+
+	fields := strings.Fields(lines[0])
+	if len(fields) != 3 {
+		return models.LimitUsage{}, metrics.ErrInvalidOutput
+	}
+
+	allocated, err := strconv.ParseUint(fields[0], 10, 64)
+	if err != nil {
+		return models.LimitUsage{}, err
+	}
+	// Unused handles are always 0 since Linux 2.6, but count them for older kernels
+	unused, err := strconv.ParseUint(fields[1], 10, 64)
+	if err != nil {
+		return models.LimitUsage{}, err
+	}
+	maximum, err := strconv.ParseUint(fields[2], 10, 64)
+	if err != nil {
+		return models.LimitUsage{}, err
+	}
+
+	return newLimitUsage(allocated-unused, maximum), nil
+}
+
+// parsePIDs parses the number of the used PIDs against kernel.pid_max.
+// Every thread holds the PID, so the number of all kernel scheduling entities is used.
+func (p *parser) parsePIDs() (models.LimitUsage, error) {
+	lines, err := fsUtils.ReadLines(filepath.Join(p.paths.Proc, fileLoadAvg))
+	if err != nil {
+		return models.LimitUsage{}, err
+	}
+
+	// The fourth field is the number of runnable entities and the number of existing ones like 1/523
+	fields := strings.Fields(lines[0])
+	if len(fields) < 4 {
+		return models.LimitUsage{}, metrics.ErrInvalidOutput
+	}
+	_, total, ok := strings.Cut(fields[3], "/")
+	if !ok {
+		return models.LimitUsage{}, metrics.ErrInvalidOutput
+	}
+	used, err := strconv.ParseUint(total, 10, 64)
+	if err != nil {
+		return models.LimitUsage{}, err
+	}
+
+	maximum, err := p.readUint(filePIDMax)
+	if err != nil {
+		return models.LimitUsage{}, err
+	}
+
+	return newLimitUsage(used, maximum), nil
+}
+
+// parseUsage parses the usage and the limit stored in the separate procfs files.
+func (p *parser) parseUsage(usedFile, maxFile string) (models.LimitUsage, error) {
+	used, err := p.readUint(usedFile)
+	if err != nil {
+		return models.LimitUsage{}, err
+	}
+
+	maximum, err := p.readUint(maxFile)
+	if err != nil {
+		return models.LimitUsage{}, err
+	}
+
+	return newLimitUsage(used, maximum), nil
+}
+
+// parseInotify parses the inotify watches and instances usage against the per-user limits
+// and fills the provided result struct with the usage of the user closest to the limits.
+func (p *parser) parseInotify(res *models.LimitsStats) error {
+	maxWatches, err := p.readUint(fileInotifyMaxWatches)
+	if err != nil {
+		return err
+	}
+	maxInstances, err := p.readUint(fileInotifyMaxInstances)
+	if err != nil {
+		return err
+	}
+
+	usage, err := p.readInotifyUsage()
+	if err != nil {
+		return err
+	}
+
+	res.InotifyWatches = newLimitUsage(0, maxWatches)
+	res.InotifyInstances = newLimitUsage(0, maxInstances)
+	for _, u := range usage {
+		if u.watches > res.InotifyWatches.Used {
+			res.InotifyWatches = newLimitUsage(u.watches, maxWatches)
+		}
+		if u.instances > res.InotifyInstances.Used {
+			res.InotifyInstances = newLimitUsage(u.instances, maxInstances)
+		}
+	}
+
+	return nil
+}
+
+// readInotifyUsage counts the inotify instances and watches held by the processes per user.
+// The processes not accessible to the current user are skipped.
+func (p *parser) readInotifyUsage() (map[string]inotifyUsage, error) {
+	entries, err := os.ReadDir(p.paths.Proc)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]inotifyUsage)
+	for _, entry := range entries {
+		if _, err = strconv.Atoi(entry.Name()); err != nil {
+			continue
+		}
+		procDir := filepath.Join(p.paths.Proc, entry.Name())
+
+		fds, err := os.ReadDir(filepath.Join(procDir, "fd"))
+		if err != nil {
+			continue
+		}
+
+		var usage inotifyUsage
+		for _, fd := range fds {
+			link, err := os.Readlink(filepath.Join(procDir, "fd", fd.Name()))
+			if err != nil || link != "anon_inode:inotify" {
+				continue
+			}
+			usage.instances++
+
+			lines, err := fsUtils.ReadLines(filepath.Join(procDir, "fdinfo", fd.Name()))
+			if err != nil {
+				continue
+			}
+			for _, line := range lines {
+				if strings.HasPrefix(line, "inotify wd:") {
+					usage.watches++
+				}
+			}
+		}
+		if usage.instances == 0 {
+			continue
+		}
+
+		uid, err := readUID(procDir)
+		if err != nil {
+			continue
+		}
+		total := res[uid]
+		total.instances += usage.instances
+		total.watches += usage.watches
+		res[uid] = total
+	}
+
+	return res, nil
+}
+
+// readUint reads the single unsigned integer from the procfs file.
+func (p *parser) readUint(file string) (uint64, error) {
+	lines, err := fsUtils.ReadLines(filepath.Join(p.paths.Proc, file))
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(strings.TrimSpace(lines[0]), 10, 64)
+}
+
+// readUID reads the real user ID of the process by its procfs directory.
+func readUID(procDir string) (string, error) {
+	lines, err := fsUtils.ReadLines(filepath.Join(procDir, "status"))
+	if err != nil {
+		return "", err
+	}
+
+	for _, line := range lines {
+		if fields := strings.Fields(line); len(fields) > 1 && fields[0] == "Uid:" {
+			return fields[1], nil
+		}
+	}
+
+	return "", metrics.ErrInvalidOutput
+}
+
+// newLimitUsage returns the usage of the limit with the utilisation percentage.
+func newLimitUsage(used, maximum uint64) models.LimitUsage {
+	res := models.LimitUsage{
+		Used:      used,
+		Max:       maximum,
+		Available: true,
+	}
+	if maximum > 0 {
+		res.UsedPercent = float64(used) / float64(maximum) * 100
+	}
+
+	return res
+}
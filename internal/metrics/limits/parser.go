@@ -0,0 +1,53 @@
+package limits
+
+import (
+	"context"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+var (
+	// fileFileNr is the procfs file with the number of allocated, unused and maximum file handles.
+	fileFileNr = "sys/fs/file-nr"
+	// fileLoadAvg is the procfs file with the number of existing kernel scheduling entities.
+	fileLoadAvg = "loadavg"
+	// filePIDMax is the procfs file with the maximum PID value.
+	filePIDMax = "sys/kernel/pid_max"
+	// fileConntrackCount is the procfs file with the number of the connection tracking entries.
+	fileConntrackCount = "sys/net/netfilter/nf_conntrack_count"
+	// fileConntrackMax is the procfs file with the maximum number of the connection tracking entries.
+	fileConntrackMax = "sys/net/netfilter/nf_conntrack_max"
+	// fileInotifyMaxWatches is the procfs file with the maximum number of inotify watches per user.
+	fileInotifyMaxWatches = "sys/fs/inotify/max_user_watches"
+	// fileInotifyMaxInstances is the procfs file with the maximum number of inotify instances per user.
+	fileInotifyMaxInstances = "sys/fs/inotify/max_user_instances"
+)
+
+// parser - struct to hold the parser dependencies.
+type parser struct {
+	execer cmd.Execer
+	paths  fs.Paths
+}
+
+// NewParser returns a new parser to parse the kernel tables usage against the limits.
+//
+//nolint:revive
+func NewParser(execer cmd.Execer, paths fs.Paths) *parser {
+	return &parser{
+		execer: execer,
+		paths:  paths,
+	}
+}
+
+// Parse parses the kernel tables usage against the limits of the system.
+func (p *parser) Parse(ctx context.Context) (models.LimitsStats, error) {
+	if p.execer.OS() == os.Linux {
+		return p.parseForLinux(ctx)
+	}
+
+	return models.LimitsStats{}, metrics.ErrUnsupportedOS
+}
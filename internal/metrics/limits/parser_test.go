@@ -0,0 +1,187 @@
+package limits
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+func Test_parser_Parse(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		execerMockFunc func(t *testing.T) cmd.Execer
+		paths          fs.Paths
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.LimitsStats
+		wantErr bool
+	}{
+		{
+			name: "ok linux",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/host"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.LimitsStats{
+				FileHandles: models.LimitUsage{
+					Used:        9376,
+					Max:         9223372036854775807,
+					UsedPercent: float64(9376) / float64(9223372036854775807) * 100,
+					Available:   true,
+				},
+				PIDs: models.LimitUsage{
+					Used:        523,
+					Max:         4194304,
+					UsedPercent: float64(523) / float64(4194304) * 100,
+					Available:   true,
+				},
+				Conntrack: models.LimitUsage{
+					Used:        1024,
+					Max:         262144,
+					UsedPercent: float64(1024) / float64(262144) * 100,
+					Available:   true,
+				},
+				InotifyWatches: models.LimitUsage{
+					Used:        3,
+					Max:         8192,
+					UsedPercent: float64(3) / float64(8192) * 100,
+					Available:   true,
+				},
+				InotifyInstances: models.LimitUsage{
+					Used:        2,
+					Max:         128,
+					UsedPercent: float64(2) / float64(128) * 100,
+					Available:   true,
+				},
+			},
+		},
+		{
+			name: "ok linux without conntrack",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/container"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.LimitsStats{
+				FileHandles: models.LimitUsage{
+					Used:        512,
+					Max:         1048576,
+					UsedPercent: float64(512) / float64(1048576) * 100,
+					Available:   true,
+				},
+				PIDs: models.LimitUsage{
+					Used:        4,
+					Max:         32768,
+					UsedPercent: float64(4) / float64(32768) * 100,
+					Available:   true,
+				},
+				InotifyWatches: models.LimitUsage{
+					Max:       8192,
+					Available: true,
+				},
+				InotifyInstances: models.LimitUsage{
+					Max:       128,
+					Available: true,
+				},
+			},
+		},
+		{
+			name: "err no procfs files",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/notexists"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "err darwin unsupported",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Darwin).
+						Once()
+
+					return execer
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &parser{
+				execer: tt.fields.execerMockFunc(t),
+				paths:  tt.fields.paths,
+			}
+			got, err := p.Parse(tt.args.ctx)
+
+			require.Equalf(t, tt.wantErr, err != nil, "error = %v", err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
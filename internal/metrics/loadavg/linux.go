@@ -0,0 +1,41 @@
+package loadavg
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// parseForLinux parses the load average of the system for Linux from /proc/loadavg.
+func (p *parser) parseForLinux(_ context.Context) (models.LoadAverageStats, error) {
+	lines, err := fs.ReadLines(filepath.Join(p.paths.Proc, fileLoadavg))
+	if err != nil {
+		return models.LoadAverageStats{}, err
+	}
+
+	// The load averages are followed by the running and total threads and the last PID like "0.52 0.58 0.59 2/1203 4242"
+	fields := strings.Fields(lines[0])
+	if len(fields) < 3 {
+		return models.LoadAverageStats{}, metrics.ErrInvalidOutput
+	}
+
+	var values [3]float64
+	for i, field := range fields[:3] {
+		values[i], err = strconv.ParseFloat(field, 64)
+		if err != nil {
+			return models.LoadAverageStats{}, fmt.Errorf("failed to parse load average: %w", err)
+		}
+	}
+
+	return models.LoadAverageStats{
+		OneMin:     values[0],
+		FiveMin:    values[1],
+		FifteenMin: values[2],
+	}, nil
+}
@@ -7,31 +7,41 @@ import (
 
 	"github.com/sitnikovik/sysmon/internal/metrics"
 	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
 	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
 	"github.com/sitnikovik/sysmon/internal/models"
 )
 
-// cmdUnix is the command to get the load average on Unix systems.
-var cmdUnix = "uptime"
+var (
+	// cmdUnix is the command to get the load average on Unix systems.
+	cmdUnix = "uptime"
+	// fileLoadavg is the procfs file with the load average on Linux systems.
+	fileLoadavg = "loadavg"
+)
 
 // parser is an implementation of Parser.
 type parser struct {
 	execer cmd.Execer
+	paths  fs.Paths
 }
 
 // NewParser returns a new parer to parse the load average.
 //
 //nolint:revive
-func NewParser(execer cmd.Execer) *parser {
+func NewParser(execer cmd.Execer, paths fs.Paths) *parser {
 	return &parser{
 		execer: execer,
+		paths:  paths,
 	}
 }
 
 // Parse parses the load average of the system.
 func (p *parser) Parse(ctx context.Context) (models.LoadAverageStats, error) {
-	if p.execer.OS() == os.Darwin || p.execer.OS() == os.Linux {
+	switch p.execer.OS() {
+	case os.Darwin:
 		return p.parseForUnix(ctx)
+	case os.Linux:
+		return p.parseForLinux(ctx)
 	}
 
 	return models.LoadAverageStats{}, metrics.ErrUnsupportedOS
@@ -4,9 +4,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
 	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
 	"github.com/sitnikovik/sysmon/internal/models"
 )
@@ -16,6 +18,7 @@ func Test_parser_Parse(t *testing.T) {
 
 	type fields struct {
 		execerMockFunc func(t *testing.T) cmd.Execer
+		paths          fs.Paths
 	}
 	type args struct {
 		ctx context.Context
@@ -36,7 +39,7 @@ func Test_parser_Parse(t *testing.T) {
 					execer := cmd.NewMockExecer(t)
 
 					execer.EXPECT().
-						Exec(cmdUnix).
+						Exec(mock.Anything, cmdUnix).
 						Return(&cmd.Result{
 							Bytes: []byte("23:04  up 42 days, 13:14, 1 user, load averages: 3.99 3.95 3.58"),
 						}, nil).Once()
@@ -66,19 +69,13 @@ func Test_parser_Parse(t *testing.T) {
 
 					execer := cmd.NewMockExecer(t)
 
-					execer.EXPECT().
-						Exec(cmdUnix).
-						Return(&cmd.Result{
-							Bytes: []byte("23:04  up 42 days, 13:14, 1 user, load average: 3.99 3.95 3.58"),
-						}, nil).
-						Once()
-
 					execer.EXPECT().
 						OS().
 						Return(os.Linux)
 
 					return execer
 				},
+				paths: fs.Paths{Proc: "testdata"},
 			},
 			args: args{
 				ctx: context.Background(),
@@ -90,6 +87,27 @@ func Test_parser_Parse(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "err linux no procfs",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux)
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/notexists"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -98,6 +116,7 @@ func Test_parser_Parse(t *testing.T) {
 
 			p := &parser{
 				execer: tt.fields.execerMockFunc(t),
+				paths:  tt.fields.paths,
 			}
 			got, err := p.Parse(tt.args.ctx)
 
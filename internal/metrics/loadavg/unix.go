@@ -11,8 +11,8 @@ import (
 )
 
 // paserForUnix parses the load average of the system for Unix.
-func (p *parser) parseForUnix(_ context.Context) (models.LoadAverageStats, error) {
-	cmdRes, err := p.execer.Exec(cmdUnix)
+func (p *parser) parseForUnix(ctx context.Context) (models.LoadAverageStats, error) {
+	cmdRes, err := p.execer.Exec(ctx, cmdUnix)
 	if err != nil {
 		return models.LoadAverageStats{}, err
 	}
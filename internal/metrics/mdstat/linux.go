@@ -0,0 +1,172 @@
+package mdstat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	fsUtils "github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+var (
+	// reDevices matches the number of the devices and the active ones like "[3/2]".
+	reDevices = regexp.MustCompile(`\[(\d+)/(\d+)\]`)
+	// reMember matches the array member like "sde1[4](F)".
+	reMember = regexp.MustCompile(`^(\S+)\[(\d+)\](?:\((\w)\))?$`)
+	// reSync matches the progress of the sync action like "recovery =  8.5%".
+	reSync = regexp.MustCompile(`(resync|recovery|reshape|check)\s*=\s*([\d.]+)%`)
+	// reSyncPending matches the delayed or pending sync action like "resync=DELAYED".
+	reSyncPending = regexp.MustCompile(`(resync|recovery|reshape|check)=(DELAYED|PENDING)`)
+	// reFinish matches the estimated time to finish the sync action like "finish=124.3min".
+	reFinish = regexp.MustCompile(`finish=([\d.]+)min`)
+	// reSpeed matches the speed of the sync action like "speed=119796K/sec".
+	reSpeed = regexp.MustCompile(`speed=(\d+)K/sec`)
+)
+
+// memberStates maps the member flags of mdstat to the member states.
+var memberStates = map[string]string{
+	"":  models.MDMemberActive,
+	"F": models.MDMemberFaulty,
+	"S": models.MDMemberSpare,
+	"W": models.MDMemberWriteMostly,
+	"R": models.MDMemberReplacement,
+}
+
+// parseForLinux parses the software RAID arrays status for Linux.
+func (p *parser) parseForLinux(_ context.Context) (models.MDStatStats, error) {
+	lines, err := fsUtils.ReadLines(filepath.Join(p.paths.Proc, fileMDStat))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return models.MDStatStats{}, metrics.ErrNotAvailable
+		}
+		return models.MDStatStats{}, err
+	}
+
+	arrays, err := parseArrays(lines)
+	if err != nil {
+		return models.MDStatStats{}, err
+	}
+
+	return models.MDStatStats{Arrays: arrays}, nil
+}
+
+// parseArrays parses the content of /proc/mdstat.
+// Every array starts with the line like "md0 : active raid1 sdb1[1] sda1[0]"
+// followed by the indented lines with its size, devices status and sync progress.
+func parseArrays(lines []string) ([]models.MDArray, error) {
+	res := make([]models.MDArray, 0)
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if strings.HasPrefix(fields[0], "md") && len(fields) > 2 && fields[1] == ":" {
+			array, err := parseHeader(fields[0], fields[2:])
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %w", fields[0], err)
+			}
+			res = append(res, array)
+			continue
+		}
+
+		// Indented lines belong to the last array
+		if len(res) == 0 || !strings.HasPrefix(line, " ") {
+			continue
+		}
+		if err := parseDetails(line, &res[len(res)-1]); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", res[len(res)-1].Name, err)
+		}
+	}
+
+	return res, nil
+}
+
+// parseHeader parses the fields of the array header line after the colon
+// like "active (auto-read-only) raid1 sdb1[1] sda1[0]".
+func parseHeader(name string, fields []string) (models.MDArray, error) {
+	array := models.MDArray{
+		Name:  name,
+		State: fields[0],
+	}
+
+	for _, field := range fields[1:] {
+		switch {
+		case strings.HasPrefix(field, "("):
+			array.State += " " + field
+		case strings.Contains(field, "["):
+			m := reMember.FindStringSubmatch(field)
+			if m == nil {
+				return models.MDArray{}, metrics.ErrInvalidOutput
+			}
+			slot, err := strconv.Atoi(m[2])
+			if err != nil {
+				return models.MDArray{}, err
+			}
+			state, ok := memberStates[m[3]]
+			if !ok {
+				state = m[3]
+			}
+			array.Members = append(array.Members, models.MDMember{
+				Name:  m[1],
+				Slot:  slot,
+				State: state,
+			})
+		default:
+			// Inactive arrays have no level
+			array.Level = field
+		}
+	}
+
+	return array, nil
+}
+
+// parseDetails parses the indented line of the array with the devices status or the sync progress.
+func parseDetails(line string, array *models.MDArray) error {
+	if m := reDevices.FindStringSubmatch(line); m != nil {
+		var err error
+		if array.Devices, err = strconv.Atoi(m[1]); err != nil {
+			return err
+		}
+		if array.ActiveDevices, err = strconv.Atoi(m[2]); err != nil {
+			return err
+		}
+		array.Degraded = array.ActiveDevices < array.Devices
+	}
+
+	if m := reSyncPending.FindStringSubmatch(line); m != nil {
+		array.Sync = models.MDSync{Action: m[1], Pending: true}
+		return nil
+	}
+
+	m := reSync.FindStringSubmatch(line)
+	if m == nil {
+		return nil
+	}
+
+	sync := models.MDSync{Action: m[1]}
+	var err error
+	if sync.Percent, err = strconv.ParseFloat(m[2], 64); err != nil {
+		return err
+	}
+	if m = reFinish.FindStringSubmatch(line); m != nil {
+		if sync.FinishMin, err = strconv.ParseFloat(m[1], 64); err != nil {
+			return err
+		}
+	}
+	if m = reSpeed.FindStringSubmatch(line); m != nil {
+		if sync.SpeedKBs, err = strconv.ParseUint(m[1], 10, 64); err != nil {
+			return err
+		}
+	}
+	array.Sync = sync
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package mdstat
+
+import (
+	"context"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// fileMDStat is the procfs file with the software RAID arrays status.
+var fileMDStat = "mdstat"
+
+// parser - struct to hold the parser dependencies.
+type parser struct {
+	execer cmd.Execer
+	paths  fs.Paths
+}
+
+// NewParser returns a new parser to parse the software RAID arrays status.
+//
+//nolint:revive
+func NewParser(execer cmd.Execer, paths fs.Paths) *parser {
+	return &parser{
+		execer: execer,
+		paths:  paths,
+	}
+}
+
+// Parse parses the software RAID arrays status of the system.
+// Returns metrics.ErrNotAvailable if the md driver is not loaded.
+func (p *parser) Parse(ctx context.Context) (models.MDStatStats, error) {
+	if p.execer.OS() == os.Linux {
+		return p.parseForLinux(ctx)
+	}
+
+	return models.MDStatStats{}, metrics.ErrUnsupportedOS
+}
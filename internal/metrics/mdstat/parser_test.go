@@ -0,0 +1,187 @@
+package mdstat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+func Test_parser_Parse(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		execerMockFunc func(t *testing.T) cmd.Execer
+		paths          fs.Paths
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.MDStatStats
+		wantErr error
+	}{
+		{
+			name: "ok linux",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.MDStatStats{
+				Arrays: []models.MDArray{
+					{
+						Name:          "md0",
+						State:         "active",
+						Level:         "raid1",
+						Devices:       2,
+						ActiveDevices: 2,
+						Members: []models.MDMember{
+							{Name: "sdb1", Slot: 1, State: models.MDMemberActive},
+							{Name: "sda1", Slot: 0, State: models.MDMemberActive},
+						},
+					},
+					{
+						Name:          "md1",
+						State:         "active",
+						Level:         "raid5",
+						Devices:       3,
+						ActiveDevices: 2,
+						Degraded:      true,
+						Members: []models.MDMember{
+							{Name: "sdd1", Slot: 3, State: models.MDMemberActive},
+							{Name: "sdc1", Slot: 1, State: models.MDMemberActive},
+							{Name: "sdb2", Slot: 0, State: models.MDMemberActive},
+							{Name: "sde1", Slot: 4, State: models.MDMemberFaulty},
+						},
+						Sync: models.MDSync{
+							Action:    "recovery",
+							Percent:   8.5,
+							FinishMin: 124.3,
+							SpeedKBs:  119796,
+						},
+					},
+					{
+						Name:          "md2",
+						State:         "active (auto-read-only)",
+						Level:         "raid1",
+						Devices:       2,
+						ActiveDevices: 2,
+						Members: []models.MDMember{
+							{Name: "sdf1", Slot: 0, State: models.MDMemberActive},
+							{Name: "sdg1", Slot: 1, State: models.MDMemberActive},
+							{Name: "sdh1", Slot: 2, State: models.MDMemberSpare},
+						},
+						Sync: models.MDSync{
+							Action:  "resync",
+							Pending: true,
+						},
+					},
+					{
+						Name:  "md127",
+						State: "inactive",
+						Members: []models.MDMember{
+							{Name: "sdi", Slot: 0, State: models.MDMemberSpare},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "err md driver not loaded",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/notexists"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: metrics.ErrNotAvailable,
+		},
+		{
+			name: "err darwin unsupported",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Darwin).
+						Once()
+
+					return execer
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: metrics.ErrUnsupportedOS,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &parser{
+				execer: tt.fields.execerMockFunc(t),
+				paths:  tt.fields.paths,
+			}
+			got, err := p.Parse(tt.args.ctx)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseArrays(t *testing.T) {
+	t.Parallel()
+
+	got, err := parseArrays([]string{
+		"Personalities : ",
+		"unused devices: <none>",
+	})
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	_, err = parseArrays([]string{
+		"md0 : active raid1 sdb1[x]",
+	})
+	require.Error(t, err)
+}
@@ -9,8 +9,8 @@ import (
 )
 
 // parseForDarwin parses the memory statistics for Darwin OS.
-func (p *parser) parseForDarwin(_ context.Context) (models.MemoryStats, error) {
-	cmdRes, err := p.execer.Exec(cmdDarwin)
+func (p *parser) parseForDarwin(ctx context.Context) (models.MemoryStats, error) {
+	cmdRes, err := p.execer.Exec(ctx, cmdDarwin)
 	if err != nil {
 		return models.MemoryStats{}, err
 	}
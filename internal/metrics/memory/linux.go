@@ -2,63 +2,242 @@ package memory
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/sitnikovik/sysmon/internal/metrics"
+	fsUtils "github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
 	"github.com/sitnikovik/sysmon/internal/models"
 )
 
-// parseForLinux parses memory statistics for Linux.
+// parseForLinux parses memory statistics for Linux from /proc/meminfo.
+// The used and cached memory is calculated the way free does.
 func (p *parser) parseForLinux(_ context.Context) (models.MemoryStats, error) {
-	cmdRes, err := p.execer.Exec(cmdLinux, cmdLinuxArgs...)
+	meminfo, err := readMeminfo(filepath.Join(p.paths.Proc, fileMeminfo), "")
 	if err != nil {
-		return models.MemoryStats{}, err
+		return models.MemoryStats{}, fmt.Errorf("failed to parse meminfo: %w", err)
 	}
 
-	lines := cmdRes.Lines()
-	if len(lines) < 4 {
+	total, ok := meminfo["MemTotal"]
+	if !ok {
 		return models.MemoryStats{}, metrics.ErrInvalidOutput
 	}
+	free := meminfo["MemFree"]
+	cached := meminfo["Buffers"] + meminfo["Cached"] + meminfo["SReclaimable"]
+	available, ok := meminfo["MemAvailable"]
+	if !ok {
+		// The kernels older than 3.14 do not estimate the available memory
+		available = free + cached
+	}
 
-	// Parse the second line for total, used, and free memory
-	memFields := strings.Fields(lines[1])
-	if len(memFields) < 7 {
-		return models.MemoryStats{}, metrics.ErrInvalidOutput
+	res := models.MemoryStats{
+		TotalMb:     total / 1024,
+		AvailableMb: available / 1024,
+		UsedMb:      (total - min(total, free+cached)) / 1024,
+		FreeMb:      free / 1024,
+		ActiveMb:    meminfo["Active"] / 1024,
+		InactiveMb:  meminfo["Inactive"] / 1024,
+		CachedMb:    cached / 1024,
 	}
 
-	totalMb, err := strconv.ParseUint(memFields[1], 10, 64)
-	if err != nil {
-		return models.MemoryStats{}, fmt.Errorf("failed to parse total memory: %w", err)
+	if err = p.parseBreakdown(meminfo, &res); err != nil {
+		return models.MemoryStats{}, err
 	}
 
-	usedMb, err := strconv.ParseUint(memFields[2], 10, 64)
+	return res, nil
+}
+
+// parseBreakdown parses the slab, hugepages and NUMA nodes memory breakdown.
+// Every source but meminfo is optional since it may be absent or unreadable, e.g. in a container.
+func (p *parser) parseBreakdown(meminfo map[string]uint64, res *models.MemoryStats) error {
+	res.SlabMb = meminfo["Slab"] / 1024
+	res.SlabReclaimableMb = meminfo["SReclaimable"] / 1024
+	res.HugetlbMb = meminfo["Hugetlb"] / 1024
+
+	var err error
+	if res.NUMANodes, err = p.parseNUMANodes(); err != nil {
+		return fmt.Errorf("failed to parse NUMA nodes: %w", err)
+	}
+	if res.HugePages, err = p.parseHugePages(); err != nil {
+		return fmt.Errorf("failed to parse hugepages: %w", err)
+	}
+	res.TopSlabCaches, err = p.parseSlabCaches()
+	if err != nil && !errors.Is(err, fs.ErrNotExist) && !errors.Is(err, fs.ErrPermission) {
+		return fmt.Errorf("failed to parse slabinfo: %w", err)
+	}
+
+	return nil
+}
+
+// parseNUMANodes parses the memory of every NUMA node.
+func (p *parser) parseNUMANodes() ([]models.NUMANodeMemory, error) {
+	dir := filepath.Join(p.paths.Sys, dirNodes)
+	entries, err := os.ReadDir(dir)
 	if err != nil {
-		return models.MemoryStats{}, fmt.Errorf("failed to parse used memory: %w", err)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
 	}
 
-	freeMb, err := strconv.ParseUint(memFields[3], 10, 64)
+	var res []models.NUMANodeMemory
+	for _, entry := range entries {
+		// The nodes directories are named like node0 among the other files like online and possible
+		suffix, ok := strings.CutPrefix(entry.Name(), "node")
+		if !ok {
+			continue
+		}
+		node, err := strconv.Atoi(suffix)
+		if err != nil {
+			continue
+		}
+
+		// The lines are prefixed with the node like "Node 0 MemTotal:       32768000 kB"
+		meminfo, err := readMeminfo(filepath.Join(dir, entry.Name(), fileMeminfo), fmt.Sprintf("Node %d ", node))
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, models.NUMANodeMemory{
+			Node:          node,
+			TotalMb:       meminfo["MemTotal"] / 1024,
+			FreeMb:        meminfo["MemFree"] / 1024,
+			UsedMb:        meminfo["MemUsed"] / 1024,
+			FilePagesMb:   meminfo["FilePages"] / 1024,
+			AnonPagesMb:   meminfo["AnonPages"] / 1024,
+			SlabMb:        meminfo["Slab"] / 1024,
+			HugePages:     meminfo["HugePages_Total"],
+			HugePagesFree: meminfo["HugePages_Free"],
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Node < res[j].Node
+	})
+
+	return res, nil
+}
+
+// parseHugePages parses the hugepage pools of every page size.
+func (p *parser) parseHugePages() ([]models.HugePagePool, error) {
+	dir := filepath.Join(p.paths.Sys, dirHugepages)
+	entries, err := os.ReadDir(dir)
 	if err != nil {
-		return models.MemoryStats{}, fmt.Errorf("failed to parse free memory: %w", err)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
 	}
 
-	buffersMb, err := strconv.ParseUint(memFields[5], 10, 64)
+	var res []models.HugePagePool
+	for _, entry := range entries {
+		// The directories are named by the page size like hugepages-2048kB
+		size, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(entry.Name(), "hugepages-"), "kB"), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		pool := models.HugePagePool{SizeKb: size}
+		counters := map[string]*uint64{
+			"nr_hugepages":      &pool.Total,
+			"free_hugepages":    &pool.Free,
+			"resv_hugepages":    &pool.Reserved,
+			"surplus_hugepages": &pool.Surplus,
+		}
+		for file, value := range counters {
+			lines, err := fsUtils.ReadLines(filepath.Join(dir, entry.Name(), file))
+			if err != nil {
+				return nil, err
+			}
+			if *value, err = strconv.ParseUint(strings.TrimSpace(lines[0]), 10, 64); err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+			}
+		}
+		res = append(res, pool)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].SizeKb < res[j].SizeKb
+	})
+
+	return res, nil
+}
+
+// parseSlabCaches parses the largest slab caches from /proc/slabinfo.
+func (p *parser) parseSlabCaches() ([]models.SlabCache, error) {
+	lines, err := fsUtils.ReadLines(filepath.Join(p.paths.Proc, fileSlabinfo))
 	if err != nil {
-		return models.MemoryStats{}, fmt.Errorf("failed to parse buffer memory: %w", err)
+		return nil, err
+	}
+
+	var res []models.SlabCache
+	for _, line := range lines {
+		// Skip the version and the header lines
+		if strings.HasPrefix(line, "slabinfo") || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// name active_objs num_objs objsize objperslab pagesperslab : tunables ... : slabdata active_slabs num_slabs sharedavail
+		fields := strings.Fields(line)
+		if len(fields) < 16 || fields[12] != "slabdata" {
+			return nil, metrics.ErrInvalidOutput
+		}
+
+		var values [4]uint64
+		for i, field := range []string{fields[1], fields[2], fields[5], fields[14]} {
+			if values[i], err = strconv.ParseUint(field, 10, 64); err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %w", fields[0], err)
+			}
+		}
+		activeObjs, numObjs, pagesPerSlab, numSlabs := values[0], values[1], values[2], values[3]
+
+		res = append(res, models.SlabCache{
+			Name:          fields[0],
+			ActiveObjects: activeObjs,
+			Objects:       numObjs,
+			SizeKb:        numSlabs * pagesPerSlab * p.pageSize / 1024,
+		})
 	}
-	cachedMb := buffersMb // Linux does not provide a separate value for cached memory
 
-	availableMb, err := strconv.ParseUint(memFields[6], 10, 64)
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].SizeKb > res[j].SizeKb
+	})
+	if len(res) > topSlabCaches {
+		res = res[:topSlabCaches]
+	}
+
+	return res, nil
+}
+
+// readMeminfo reads the meminfo file with the lines like "MemTotal: 32768000 kB" stripped of the prefix
+// and returns the values by their names.
+func readMeminfo(path, prefix string) (map[string]uint64, error) {
+	lines, err := fsUtils.ReadLines(path)
 	if err != nil {
-		return models.MemoryStats{}, fmt.Errorf("failed to parse available memory: %w", err)
+		return nil, err
+	}
+
+	res := make(map[string]uint64, len(lines))
+	for _, line := range lines {
+		name, value, ok := strings.Cut(strings.TrimPrefix(line, prefix), ":")
+		if !ok {
+			continue
+		}
+
+		fields := strings.Fields(value)
+		if len(fields) == 0 {
+			continue
+		}
+		n, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+		res[name] = n
 	}
 
-	return models.MemoryStats{
-		TotalMb:     totalMb,
-		UsedMb:      usedMb,
-		FreeMb:      freeMb,
-		CachedMb:    cachedMb,
-		AvailableMb: availableMb,
-	}, nil
+	return res, nil
 }
@@ -2,9 +2,11 @@ package memory
 
 import (
 	"context"
+	"syscall"
 
 	"github.com/sitnikovik/sysmon/internal/metrics"
 	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
 	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
 	"github.com/sitnikovik/sysmon/internal/models"
 )
@@ -13,23 +15,34 @@ var (
 	// cmdDarwin is the command to get memory statistics on Darwin.
 	cmdDarwin = "vm_stat"
 
-	// cmdLinux is the command to get memory statistics on Linux.
-	cmdLinux = "free"
-	// cmdLinuxArgs are the arguments for the command to get memory statistics on Linux.
-	cmdLinuxArgs = []string{"-m"}
+	// fileMeminfo is the procfs file with the memory statistics.
+	fileMeminfo = "meminfo"
+	// fileSlabinfo is the procfs file with the slab caches statistics, readable by root only.
+	fileSlabinfo = "slabinfo"
+	// dirNodes is the sysfs directory with the NUMA nodes.
+	dirNodes = "devices/system/node"
+	// dirHugepages is the sysfs directory with the hugepage pools of every size.
+	dirHugepages = "kernel/mm/hugepages"
+
+	// topSlabCaches is the number of the largest slab caches to report.
+	topSlabCaches = 10
 )
 
 // parser is an implementation of Parser.
 type parser struct {
-	execer cmd.Execer
+	execer   cmd.Execer
+	paths    fs.Paths
+	pageSize uint64
 }
 
 // NewParser returns a new instance of Parser.
 //
 //nolint:revive
-func NewParser(execer cmd.Execer) *parser {
+func NewParser(execer cmd.Execer, paths fs.Paths) *parser {
 	return &parser{
-		execer: execer,
+		execer:   execer,
+		paths:    paths,
+		pageSize: uint64(syscall.Getpagesize()),
 	}
 }
 
@@ -4,10 +4,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
-	"github.com/sitnikovik/sysmon/internal/metrics/utils/strings"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
 	"github.com/sitnikovik/sysmon/internal/models"
 )
 
@@ -16,6 +17,7 @@ func Test_parser_Parse(t *testing.T) {
 
 	type fields struct {
 		execerMockFunc func(t *testing.T) cmd.Execer
+		paths          fs.Paths
 	}
 	type args struct {
 		ctx context.Context
@@ -36,7 +38,7 @@ func Test_parser_Parse(t *testing.T) {
 					execer := cmd.NewMockExecer(t)
 
 					execer.EXPECT().
-						Exec(cmdDarwin).
+						Exec(mock.Anything, cmdDarwin).
 						Return(&cmd.Result{
 							Bytes: []byte(
 								"Mach Virtual Memory Statistics: (page size of 16384 bytes)\n" +
@@ -85,7 +87,7 @@ func Test_parser_Parse(t *testing.T) {
 			},
 		},
 		{
-			name: "ok linux",
+			name: "err linux no meminfo",
 			fields: fields{
 				execerMockFunc: func(t *testing.T) cmd.Execer {
 					t.Helper()
@@ -93,16 +95,25 @@ func Test_parser_Parse(t *testing.T) {
 					execer := cmd.NewMockExecer(t)
 
 					execer.EXPECT().
-						Exec(cmdLinux, strings.ToInterfaces(cmdLinuxArgs)...).
-						Return(&cmd.Result{
-							// free -m
-							Bytes: []byte(
-								"              total        used        free      shared  buff/cache   available\n" +
-									"Mem:          32159       10659        1234        1234       20265       20265\n" +
-									"Swap:          2047          10        2037\n",
-							),
-						}, nil).
-						Once()
+						OS().
+						Return("linux")
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/notexists", Sys: "testdata/sys"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "ok linux with NUMA nodes, hugepages and slab caches",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
 
 					execer.EXPECT().
 						OS().
@@ -110,16 +121,42 @@ func Test_parser_Parse(t *testing.T) {
 
 					return execer
 				},
+				paths: fs.Paths{Proc: "testdata/proc", Sys: "testdata/sys"},
 			},
 			args: args{
 				ctx: context.Background(),
 			},
 			want: models.MemoryStats{
-				TotalMb:     32159,
-				AvailableMb: 20265,
-				FreeMb:      1234,
-				UsedMb:      10659,
-				CachedMb:    20265,
+				TotalMb:           32159,
+				AvailableMb:       20265,
+				FreeMb:            1234,
+				UsedMb:            10327,
+				ActiveMb:          14336,
+				InactiveMb:        12288,
+				CachedMb:          20598,
+				SlabMb:            1536,
+				SlabReclaimableMb: 1024,
+				HugetlbMb:         2048,
+				NUMANodes: []models.NUMANodeMemory{
+					{
+						Node: 0, TotalMb: 16079, FreeMb: 500, UsedMb: 15579, FilePagesMb: 9600, AnonPagesMb: 4096,
+						SlabMb: 768, HugePages: 256, HugePagesFree: 128,
+					},
+					{
+						Node: 1, TotalMb: 16079, FreeMb: 734, UsedMb: 15345, FilePagesMb: 9571, AnonPagesMb: 4716,
+						SlabMb: 768, HugePages: 256, HugePagesFree: 256,
+					},
+				},
+				HugePages: []models.HugePagePool{
+					{SizeKb: 2048, Total: 512, Free: 384, Reserved: 64},
+					{SizeKb: 1048576, Total: 1, Free: 1},
+				},
+				TopSlabCaches: []models.SlabCache{
+					{Name: "ext4_inode_cache", ActiveObjects: 412340, Objects: 413280, SizeKb: 472320},
+					{Name: "dentry", ActiveObjects: 986412, Objects: 990990, SizeKb: 188760},
+					{Name: "radix_tree_node", ActiveObjects: 80120, Objects: 82040, SizeKb: 46880},
+					{Name: "kmalloc-64", ActiveObjects: 120345, Objects: 122880, SizeKb: 7680},
+				},
 			},
 		},
 	}
@@ -129,11 +166,13 @@ func Test_parser_Parse(t *testing.T) {
 			t.Parallel()
 
 			p := &parser{
-				execer: tt.fields.execerMockFunc(t),
+				execer:   tt.fields.execerMockFunc(t),
+				paths:    tt.fields.paths,
+				pageSize: 4096,
 			}
 			got, err := p.Parse(tt.args.ctx)
 
-			require.NoError(t, err, "unexpected error = %v", err)
+			require.Equalf(t, tt.wantErr, err != nil, "error = %v", err)
 			require.Equal(t, tt.want, got)
 		})
 	}
@@ -0,0 +1,135 @@
+package netdev
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/sampler"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// ifaceCounters holds the traffic counters of the network interface since it was created.
+type ifaceCounters struct {
+	rxBytes   uint64
+	rxPackets uint64
+	rxErrors  uint64
+	rxDropped uint64
+	txBytes   uint64
+	txPackets uint64
+	txErrors  uint64
+	txDropped uint64
+}
+
+// counters holds the traffic counters by the interface name.
+type counters map[string]ifaceCounters
+
+// parseForLinux parses the traffic of the network interfaces for Linux.
+func (p *parser) parseForLinux(_ context.Context) (models.NetDevStats, error) {
+	lines, err := fs.ReadLines(filepath.Join(p.paths.Proc, fileNetDev))
+	if err != nil {
+		return models.NetDevStats{}, err
+	}
+
+	cur, err := parseCounters(lines)
+	if err != nil {
+		return models.NetDevStats{}, err
+	}
+
+	prev, seconds, err := p.sampler.Next(cur)
+	if err != nil {
+		return models.NetDevStats{}, err
+	}
+
+	return calcStats(prev, cur, seconds), nil
+}
+
+// parseCounters parses the content of /proc/net/dev.
+// The first two lines are the headers, then every line is the interface name followed by a colon
+// and 8 receive and 8 transmit counters.
+func parseCounters(lines []string) (counters, error) {
+	if len(lines) < 2 {
+		return nil, metrics.ErrInvalidOutput
+	}
+
+	res := make(counters, len(lines)-2)
+	for _, line := range lines[2:] {
+		// The counters may stick to the colon like "eth0:1234" on the older kernels
+		name, values, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, metrics.ErrInvalidOutput
+		}
+		name = strings.TrimSpace(name)
+
+		fields := strings.Fields(values)
+		if len(fields) < 16 {
+			return nil, metrics.ErrInvalidOutput
+		}
+
+		// bytes, packets, errs and drop of the receive and transmit columns
+		var n [8]uint64
+		for i, field := range []string{
+			fields[0], fields[1], fields[2], fields[3],
+			fields[8], fields[9], fields[10], fields[11],
+		} {
+			v, err := strconv.ParseUint(field, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s counters: %w", name, err)
+			}
+			n[i] = v
+		}
+
+		res[name] = ifaceCounters{
+			rxBytes:   n[0],
+			rxPackets: n[1],
+			rxErrors:  n[2],
+			rxDropped: n[3],
+			txBytes:   n[4],
+			txPackets: n[5],
+			txErrors:  n[6],
+			txDropped: n[7],
+		}
+	}
+
+	return res, nil
+}
+
+// calcStats calculates the rates of the interfaces present in both snapshots sorted by the name.
+// The counters of the re-created interface start over, so they give no traffic for the snapshot.
+func calcStats(prev, cur counters, seconds float64) models.NetDevStats {
+	rate := func(prev, cur uint64) float64 {
+		return sampler.Rate(prev, cur, sampler.NativeBits, seconds)
+	}
+
+	res := models.NetDevStats{
+		Interfaces: make([]models.NetInterfaceStats, 0, len(cur)),
+	}
+	for name, c := range cur {
+		p, ok := prev[name]
+		if !ok {
+			continue
+		}
+
+		res.Interfaces = append(res.Interfaces, models.NetInterfaceStats{
+			Name:      name,
+			RxBytes:   rate(p.rxBytes, c.rxBytes),
+			TxBytes:   rate(p.txBytes, c.txBytes),
+			RxPackets: rate(p.rxPackets, c.rxPackets),
+			TxPackets: rate(p.txPackets, c.txPackets),
+			RxErrors:  rate(p.rxErrors, c.rxErrors),
+			TxErrors:  rate(p.txErrors, c.txErrors),
+			RxDropped: rate(p.rxDropped, c.rxDropped),
+			TxDropped: rate(p.txDropped, c.txDropped),
+		})
+	}
+	sort.Slice(res.Interfaces, func(i, j int) bool {
+		return res.Interfaces[i].Name < res.Interfaces[j].Name
+	})
+
+	return res
+}
@@ -0,0 +1,177 @@
+package netdev
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/sampler"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+func Test_parser_Parse(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		execerMockFunc func(t *testing.T) cmd.Execer
+		paths          fs.Paths
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		warm    bool
+		fields  fields
+		args    args
+		want    models.NetDevStats
+		wantErr error
+	}{
+		{
+			name: "ok linux",
+			warm: true,
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Twice()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.NetDevStats{
+				Interfaces: []models.NetInterfaceStats{
+					{Name: "eth0"},
+					{Name: "lo"},
+				},
+			},
+		},
+		{
+			name: "err warming up",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: metrics.ErrWarmingUp,
+		},
+		{
+			name: "err darwin unsupported",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Darwin).
+						Once()
+
+					return execer
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: metrics.ErrUnsupportedOS,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &parser{
+				execer:  tt.fields.execerMockFunc(t),
+				paths:   tt.fields.paths,
+				sampler: sampler.NewSampler[counters](),
+			}
+			if tt.warm {
+				_, err := p.Parse(tt.args.ctx)
+				require.ErrorIs(t, err, metrics.ErrWarmingUp)
+			}
+			got, err := p.Parse(tt.args.ctx)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseCounters(t *testing.T) {
+	t.Parallel()
+
+	lines, err := fs.ReadLines("testdata/net/dev")
+	require.NoError(t, err)
+
+	got, err := parseCounters(lines)
+	require.NoError(t, err)
+	require.Equal(t, counters{
+		"lo": {
+			rxBytes: 8123456, rxPackets: 61234,
+			txBytes: 8123456, txPackets: 61234,
+		},
+		"eth0": {
+			rxBytes: 987654321, rxPackets: 1203456, rxErrors: 2, rxDropped: 17,
+			txBytes: 123456789, txPackets: 804321,
+		},
+	}, got)
+
+	_, err = parseCounters([]string{"header"})
+	require.ErrorIs(t, err, metrics.ErrInvalidOutput)
+	_, err = parseCounters([]string{"h1", "h2", "eth0 1 2 3"})
+	require.ErrorIs(t, err, metrics.ErrInvalidOutput)
+	_, err = parseCounters([]string{"h1", "h2", "eth0: 1 2 3 4 5 6 7 8 x 10 11 12 13 14 15 16"})
+	require.Error(t, err)
+}
+
+func Test_calcStats(t *testing.T) {
+	t.Parallel()
+
+	prev := counters{
+		"eth0":  {rxBytes: 1000, txBytes: 2000, rxPackets: 10, txPackets: 20, rxDropped: 4},
+		"veth1": {rxBytes: 5000, txBytes: 5000},
+	}
+	cur := counters{
+		"eth0": {rxBytes: 3000, txBytes: 2600, rxPackets: 30, txPackets: 26, rxDropped: 6},
+		// Interface is re-created
+		"veth1": {rxBytes: 100, txBytes: 100},
+		// Not present in the previous snapshot
+		"veth2": {rxBytes: 100, txBytes: 100},
+	}
+
+	require.Equal(t, models.NetDevStats{
+		Interfaces: []models.NetInterfaceStats{
+			{Name: "eth0", RxBytes: 1000, TxBytes: 300, RxPackets: 10, TxPackets: 3, RxDropped: 1},
+			{Name: "veth1"},
+		},
+	}, calcStats(prev, cur, 2))
+}
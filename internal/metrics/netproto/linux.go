@@ -0,0 +1,123 @@
+package netproto
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/sampler"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// counters holds the protocol counters keyed by the protocol and the counter name like "Tcp.RetransSegs".
+type counters map[string]int64
+
+// parseForLinux parses the network protocols statistics for Linux.
+func (p *parser) parseForLinux(_ context.Context) (models.NetProtoStats, error) {
+	cur, err := p.readCounters()
+	if err != nil {
+		return models.NetProtoStats{}, err
+	}
+
+	prev, seconds, err := p.sampler.Next(cur)
+	if err != nil {
+		return models.NetProtoStats{}, err
+	}
+
+	return calcStats(prev, cur, seconds), nil
+}
+
+// readCounters reads the counters from the snmp and netstat procfs files.
+func (p *parser) readCounters() (counters, error) {
+	res := make(counters)
+	for _, file := range []string{fileSNMP, fileNetstat} {
+		lines, err := fs.ReadLines(filepath.Join(p.paths.Proc, file))
+		if err != nil {
+			return nil, err
+		}
+		if err = parseCounters(lines, res); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+	}
+
+	return res, nil
+}
+
+// parseCounters parses the content of /proc/net/snmp or /proc/net/netstat into the provided counters.
+// The files consist of the pairs of lines: the first one names the counters of the protocol,
+// the second one holds their values.
+func parseCounters(lines []string, res counters) error {
+	for i := 0; i+1 < len(lines); i += 2 {
+		names := strings.Fields(lines[i])
+		values := strings.Fields(lines[i+1])
+		if len(names) == 0 || len(names) != len(values) || names[0] != values[0] {
+			return metrics.ErrInvalidOutput
+		}
+
+		proto := strings.TrimSuffix(names[0], ":")
+		for j := 1; j < len(names); j++ {
+			n, err := strconv.ParseInt(values[j], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse %s.%s: %w", proto, names[j], err)
+			}
+			res[proto+"."+names[j]] = n
+		}
+	}
+
+	return nil
+}
+
+// calcStats calculates the statistics by two snapshots of the counters.
+func calcStats(prev, cur counters, seconds float64) models.NetProtoStats {
+	rate := func(name string) float64 {
+		// The counters are unsigned long in the kernel and may be absent on the older kernels
+		return sampler.Rate(uint64(prev[name]), uint64(cur[name]), sampler.NativeBits, seconds)
+	}
+
+	res := models.NetProtoStats{
+		IP: models.IPStats{
+			InReceives:  rate("Ip.InReceives"),
+			OutRequests: rate("Ip.OutRequests"),
+			InDiscards:  rate("Ip.InDiscards"),
+			OutDiscards: rate("Ip.OutDiscards"),
+			InHdrErrors: rate("Ip.InHdrErrors"),
+		},
+		TCP: models.TCPStats{
+			CurrEstab:       uint64(max(cur["Tcp.CurrEstab"], 0)),
+			ActiveOpens:     rate("Tcp.ActiveOpens"),
+			PassiveOpens:    rate("Tcp.PassiveOpens"),
+			AttemptFails:    rate("Tcp.AttemptFails"),
+			OutSegs:         rate("Tcp.OutSegs"),
+			RetransSegs:     rate("Tcp.RetransSegs"),
+			ResetsSent:      rate("Tcp.OutRsts"),
+			EstabResets:     rate("Tcp.EstabResets"),
+			InErrs:          rate("Tcp.InErrs"),
+			ListenOverflows: rate("TcpExt.ListenOverflows"),
+			ListenDrops:     rate("TcpExt.ListenDrops"),
+		},
+		UDP: models.UDPStats{
+			InDatagrams:  rate("Udp.InDatagrams"),
+			OutDatagrams: rate("Udp.OutDatagrams"),
+			NoPorts:      rate("Udp.NoPorts"),
+			InErrors:     rate("Udp.InErrors"),
+			RcvbufErrors: rate("Udp.RcvbufErrors"),
+			SndbufErrors: rate("Udp.SndbufErrors"),
+		},
+		ICMP: models.ICMPStats{
+			InMsgs:         rate("Icmp.InMsgs"),
+			OutMsgs:        rate("Icmp.OutMsgs"),
+			InErrors:       rate("Icmp.InErrors"),
+			OutErrors:      rate("Icmp.OutErrors"),
+			InDestUnreachs: rate("Icmp.InDestUnreachs"),
+		},
+	}
+	if res.TCP.OutSegs > 0 {
+		res.TCP.RetransPercent = res.TCP.RetransSegs / res.TCP.OutSegs * 100
+	}
+
+	return res
+}
@@ -0,0 +1,177 @@
+package netproto
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/sampler"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+func Test_parser_Parse(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		execerMockFunc func(t *testing.T) cmd.Execer
+		paths          fs.Paths
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		warm    bool
+		fields  fields
+		args    args
+		want    models.NetProtoStats
+		wantErr bool
+	}{
+		{
+			name: "ok linux",
+			warm: true,
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Twice()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.NetProtoStats{
+				TCP: models.TCPStats{
+					CurrEstab: 27,
+				},
+			},
+		},
+		{
+			name: "err no procfs files",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/notexists"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "err darwin unsupported",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Darwin).
+						Once()
+
+					return execer
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &parser{
+				execer:  tt.fields.execerMockFunc(t),
+				paths:   tt.fields.paths,
+				sampler: sampler.NewSampler[counters](),
+			}
+			if tt.warm {
+				_, err := p.Parse(tt.args.ctx)
+				require.ErrorIs(t, err, metrics.ErrWarmingUp)
+			}
+			got, err := p.Parse(tt.args.ctx)
+
+			require.Equalf(t, tt.wantErr, err != nil, "error = %v", err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseCounters(t *testing.T) {
+	t.Parallel()
+
+	lines, err := fs.ReadLines("testdata/net/snmp")
+	require.NoError(t, err)
+
+	got := make(counters)
+	require.NoError(t, parseCounters(lines, got))
+	require.Equal(t, int64(4021), got["Tcp.RetransSegs"])
+	require.Equal(t, int64(-1), got["Tcp.MaxConn"])
+	require.Equal(t, int64(17), got["Udp.RcvbufErrors"])
+	require.Equal(t, int64(398), got["IcmpMsg.InType3"])
+
+	require.Error(t, parseCounters([]string{"Tcp: RtoAlgorithm RtoMin", "Udp: 1 200"}, got))
+	require.Error(t, parseCounters([]string{"Tcp: RtoAlgorithm RtoMin", "Tcp: 1"}, got))
+}
+
+func Test_calcStats(t *testing.T) {
+	t.Parallel()
+
+	prev := counters{
+		"Tcp.CurrEstab":          10,
+		"Tcp.OutSegs":            1000,
+		"Tcp.RetransSegs":        10,
+		"Tcp.OutRsts":            5,
+		"Tcp.EstabResets":        100,
+		"TcpExt.ListenOverflows": 4,
+		"Udp.RcvbufErrors":       8,
+	}
+	cur := counters{
+		"Tcp.CurrEstab":          12,
+		"Tcp.OutSegs":            3000,
+		"Tcp.RetransSegs":        50,
+		"Tcp.OutRsts":            9,
+		"Tcp.EstabResets":        0, // counter reset
+		"TcpExt.ListenOverflows": 10,
+		"Udp.RcvbufErrors":       8,
+	}
+
+	require.Equal(t, models.NetProtoStats{
+		TCP: models.TCPStats{
+			CurrEstab:       12,
+			OutSegs:         1000,
+			RetransSegs:     20,
+			RetransPercent:  2,
+			ResetsSent:      2,
+			ListenOverflows: 3,
+		},
+	}, calcStats(prev, cur, 2))
+}
@@ -0,0 +1,181 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sitnikovik/sysmon/internal/models"
+	v1 "github.com/sitnikovik/sysmon/pkg/v1/api"
+)
+
+const (
+	// ProtocolVersion is the version of the plugin protocol the plugins must speak.
+	ProtocolVersion = 1
+	// EnvSocket is the environment variable with the path of the unix socket the launched plugin must listen on.
+	EnvSocket = "SYSMON_PLUGIN_SOCKET"
+)
+
+var (
+	// errNotConnected is an error returned when the plugin is not connected yet or is being restarted.
+	errNotConnected = errors.New("plugin is not connected")
+	// errInvalidSpec is an error returned when the plugin is declared wrong.
+	errInvalidSpec = errors.New("invalid plugin")
+)
+
+// Spec describes the collector plugin to launch or to connect to.
+type Spec struct {
+	// Name is the name of the collector.
+	Name string
+	// Description is the title of the metrics, the one the plugin describes itself with if empty.
+	Description string
+	// Command is the plugin binary to launch. The plugin is not launched but connected to on the socket if empty.
+	Command string
+	// Args is the arguments of the plugin binary.
+	Args []string
+	// Socket is the path of the unix socket the plugin listens on.
+	// The launched plugin gets a socket in the temporary directory if empty.
+	Socket string
+}
+
+// collector is an implementation of metrics.Collector collecting the samples from the plugin
+// running as a separate process, so the plugin crashes do not affect the daemon.
+type collector struct {
+	spec Spec
+
+	mu      sync.Mutex
+	client  v1.CollectorPluginClient
+	info    *v1.DescribeResponse
+	lost    chan struct{}
+	lastErr error
+}
+
+// NewCollector returns a new collector of the samples of the plugin.
+// The plugin is collected only while Run supervises it.
+//
+//nolint:revive
+func NewCollector(spec Spec) (*collector, error) {
+	if spec.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", errInvalidSpec)
+	}
+	if spec.Command == "" && spec.Socket == "" {
+		return nil, fmt.Errorf("%w %s: command or socket is required", errInvalidSpec, spec.Name)
+	}
+	if spec.Socket == "" {
+		spec.Socket = filepath.Join(os.TempDir(), fmt.Sprintf("sysmon-plugin-%s-%d.sock", spec.Name, os.Getpid()))
+	}
+
+	return &collector{spec: spec}, nil
+}
+
+// Name returns the name of the plugin.
+func (c *collector) Name() string {
+	return c.spec.Name
+}
+
+// Description returns the title of the plugin metrics.
+func (c *collector) Description() string {
+	if c.spec.Description != "" {
+		return c.spec.Description
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.info != nil && c.info.GetDescription() != "" {
+		return c.info.GetDescription()
+	}
+
+	return c.spec.Name
+}
+
+// Platforms returns nil since the plugin is collected on every platform it runs on.
+func (c *collector) Platforms() []string {
+	return nil
+}
+
+// OptIn reports false since the plugin is declared in the configuration to be collected.
+func (c *collector) OptIn() bool {
+	return false
+}
+
+// Collect collects the samples from the plugin.
+func (c *collector) Collect(ctx context.Context) (models.Stats, error) {
+	c.mu.Lock()
+	client, lost, lastErr := c.client, c.lost, c.lastErr
+	c.mu.Unlock()
+
+	if client == nil {
+		if lastErr != nil {
+			return nil, fmt.Errorf("%w: %w", errNotConnected, lastErr)
+		}
+		return nil, errNotConnected
+	}
+
+	res, err := client.Collect(ctx, &v1.CollectRequest{})
+	if err != nil {
+		// The plugin is gone, make the supervisor restart it instead of waiting for it to exit
+		if status.Code(err) == codes.Unavailable {
+			c.disconnect(lost, err)
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	stats := models.PluginStats{
+		Plugin:    c.spec.Name,
+		Collected: make([]models.Sample, 0, len(res.GetSamples())),
+	}
+	for _, s := range res.GetSamples() {
+		if s.GetName() == "" {
+			continue
+		}
+
+		sample := models.Sample{
+			Name:      s.GetName(),
+			Labels:    s.GetLabels(),
+			Unit:      s.GetUnit(),
+			Type:      models.SampleType(s.GetType()),
+			Value:     s.GetValue(),
+			Timestamp: now,
+		}
+		if sample.Type == "" {
+			sample.Type = models.SampleTypeGauge
+		}
+		if s.GetTimeMs() > 0 {
+			sample.Timestamp = time.UnixMilli(s.GetTimeMs())
+		}
+		stats.Collected = append(stats.Collected, sample)
+	}
+
+	return stats, nil
+}
+
+// connect makes the collector collect the samples with the client of the plugin handshaken.
+func (c *collector) connect(client v1.CollectorPluginClient, info *v1.DescribeResponse, lost chan struct{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.client, c.info, c.lost, c.lastErr = client, info, lost, nil
+}
+
+// disconnect stops collecting the samples with the client of the connection lost for the reason.
+// The lost channel is closed to notify the supervisor once, the stale ones are ignored.
+func (c *collector) disconnect(lost chan struct{}, reason error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lastErr = reason
+	if lost == nil || c.lost != lost {
+		return
+	}
+
+	close(c.lost)
+	c.client, c.lost = nil, nil
+}
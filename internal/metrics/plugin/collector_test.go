@@ -0,0 +1,213 @@
+//go:build unix
+
+package plugin
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/sitnikovik/sysmon/internal/models"
+	v1 "github.com/sitnikovik/sysmon/pkg/v1/api"
+)
+
+// fakePlugin is the plugin serving the fixed samples.
+type fakePlugin struct {
+	v1.UnimplementedCollectorPluginServer
+	version uint32
+}
+
+func (p *fakePlugin) Describe(_ context.Context, _ *v1.DescribeRequest) (*v1.DescribeResponse, error) {
+	return &v1.DescribeResponse{ProtocolVersion: p.version, Name: "gpu", Description: "GPU Usage"}, nil
+}
+
+func (p *fakePlugin) Collect(_ context.Context, _ *v1.CollectRequest) (*v1.CollectResponse, error) {
+	return &v1.CollectResponse{
+		Samples: []*v1.Sample{
+			{Name: "gpu_used_percent", Labels: map[string]string{"gpu": "0"}, Unit: models.UnitPercent, Value: 42},
+			{Name: "gpu_memory_used_bytes", Unit: models.UnitBytes, Type: "gauge", Value: 1024, TimeMs: 1727784000000},
+			{Value: 1},
+		},
+	}, nil
+}
+
+// servePlugin serves the plugin on the unix socket until the returned server is stopped.
+func servePlugin(t *testing.T, socket string, plugin v1.CollectorPluginServer) *grpc.Server {
+	t.Helper()
+
+	lis, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	s := grpc.NewServer()
+	v1.RegisterCollectorPluginServer(s, plugin)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+
+	return s
+}
+
+// runCollector supervises the collector until the test ends.
+func runCollector(t *testing.T, spec Spec) *collector {
+	t.Helper()
+
+	c, err := NewCollector(spec)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	return c
+}
+
+func TestNewCollector(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewCollector(Spec{Command: "gpu-plugin"})
+	require.ErrorIs(t, err, errInvalidSpec)
+
+	_, err = NewCollector(Spec{Name: "gpu"})
+	require.ErrorIs(t, err, errInvalidSpec)
+
+	c, err := NewCollector(Spec{Name: "gpu", Command: "gpu-plugin"})
+	require.NoError(t, err)
+	require.NotEmpty(t, c.spec.Socket)
+	require.Equal(t, "gpu", c.Description())
+}
+
+func Test_collector_Collect(t *testing.T) {
+	t.Parallel()
+
+	socket := filepath.Join(t.TempDir(), "gpu.sock")
+	servePlugin(t, socket, &fakePlugin{version: ProtocolVersion})
+	c := runCollector(t, Spec{Name: "gpu", Socket: socket})
+
+	var got models.Stats
+	require.Eventually(t, func() bool {
+		var err error
+		got, err = c.Collect(context.Background())
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	stats, ok := got.(models.PluginStats)
+	require.True(t, ok)
+	require.Equal(t, "gpu", stats.Plugin)
+	require.Len(t, stats.Collected, 2)
+	require.Equal(t, "gpu_used_percent", stats.Collected[0].Name)
+	require.Equal(t, models.SampleTypeGauge, stats.Collected[0].Type)
+	require.Equal(t, map[string]string{"gpu": "0"}, stats.Collected[0].Labels)
+	require.Equal(t, 42.0, stats.Collected[0].Value)
+	require.False(t, stats.Collected[0].Timestamp.IsZero())
+	require.Equal(t, time.UnixMilli(1727784000000), stats.Collected[1].Timestamp)
+	require.Equal(t, "GPU Usage", c.Description())
+}
+
+func Test_collector_Collect_reconnect(t *testing.T) {
+	t.Parallel()
+
+	socket := filepath.Join(t.TempDir(), "gpu.sock")
+	s := servePlugin(t, socket, &fakePlugin{version: ProtocolVersion})
+	c := runCollector(t, Spec{Name: "gpu", Socket: socket})
+
+	require.Eventually(t, func() bool {
+		_, err := c.Collect(context.Background())
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The plugin crashed
+	s.Stop()
+	require.Eventually(t, func() bool {
+		_, err := c.Collect(context.Background())
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The plugin is back
+	servePlugin(t, socket, &fakePlugin{version: ProtocolVersion})
+	require.Eventually(t, func() bool {
+		_, err := c.Collect(context.Background())
+		return err == nil
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func Test_collector_Collect_protocolMismatch(t *testing.T) {
+	t.Parallel()
+
+	socket := filepath.Join(t.TempDir(), "gpu.sock")
+	servePlugin(t, socket, &fakePlugin{version: ProtocolVersion + 1})
+	c := runCollector(t, Spec{Name: "gpu", Socket: socket})
+
+	require.Eventually(t, func() bool {
+		_, err := c.Collect(context.Background())
+		return err != nil && err.Error() != errNotConnected.Error()
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, err := c.Collect(context.Background())
+	require.ErrorIs(t, err, errNotConnected)
+	require.ErrorContains(t, err, "protocol version")
+}
+
+func Test_collector_Collect_pluginExited(t *testing.T) {
+	t.Parallel()
+
+	c := runCollector(t, Spec{
+		Name:    "gpu",
+		Command: "sh",
+		Args:    []string{"-c", "echo no GPU found >&2; exit 3"},
+		Socket:  filepath.Join(t.TempDir(), "gpu.sock"),
+	})
+
+	require.Eventually(t, func() bool {
+		_, err := c.Collect(context.Background())
+		return err != nil && err.Error() != errNotConnected.Error()
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, err := c.Collect(context.Background())
+	require.ErrorIs(t, err, errNotConnected)
+	require.ErrorContains(t, err, "exit status 3: no GPU found, restarting in")
+}
+
+func Test_collector_Run_socketRemoved(t *testing.T) {
+	t.Parallel()
+
+	// The plugin fails if the stale socket is not removed before it is launched
+	socket := filepath.Join(t.TempDir(), "gpu.sock")
+	require.NoError(t, os.WriteFile(socket, nil, 0o600))
+	c, err := NewCollector(Spec{
+		Name:    "gpu",
+		Command: "sh",
+		Args:    []string{"-c", `[ -e "$` + EnvSocket + `" ] && exit 4; touch "$` + EnvSocket + `"; exec sleep 10`},
+		Socket:  socket,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(socket)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+
+	require.NoFileExists(t, socket)
+}
@@ -0,0 +1,208 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	v1 "github.com/sitnikovik/sysmon/pkg/v1/api"
+)
+
+var (
+	// handshakeTimeout is the time the plugin must start serving and describe itself in.
+	handshakeTimeout = 10 * time.Second
+	// minBackoff is the delay of the first restart of the plugin failed.
+	minBackoff = time.Second
+	// maxBackoff is the maximum delay of the restart of the plugin failing repeatedly,
+	// the plugin running longer than it is restarted with minBackoff again.
+	maxBackoff = time.Minute
+)
+
+// stderrTailSize is the number of the last bytes of the plugin stderr kept to report why it exited.
+const stderrTailSize = 512
+
+// Run launches or connects to the plugin and restarts or reconnects it on failures
+// with the exponential backoff until the context is done. The launched plugin is killed then.
+// The failures are reported as the error of the collection until the plugin is connected again.
+func (c *collector) Run(ctx context.Context) {
+	backoff := minBackoff
+	for {
+		started := time.Now()
+		err := c.serve(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(started) > maxBackoff {
+			backoff = minBackoff
+		}
+		c.disconnect(nil, fmt.Errorf("%w, restarting in %s", err, backoff))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+// serve launches the plugin if it is not running by itself, handshakes it
+// and keeps it collected until it exits or the connection is lost.
+func (c *collector) serve(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// The launched plugin is done when its process exits
+	var exited chan struct{}
+	var exitErr error
+	stderr := &tailWriter{}
+	if c.spec.Command != "" {
+		// The socket left by the previous run is removed, so the plugin can listen on it
+		if err := os.Remove(c.spec.Socket); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove the stale socket: %w", err)
+		}
+
+		p := cmd.Command(ctx, c.spec.Command, c.spec.Args...)
+		p.Env = append(os.Environ(), EnvSocket+"="+c.spec.Socket)
+		p.Stderr = stderr
+		if err := p.Start(); err != nil {
+			return fmt.Errorf("failed to launch: %w", err)
+		}
+
+		exited = make(chan struct{})
+		go func() {
+			exitErr = p.Wait()
+			close(exited)
+		}()
+		// The socket is removed once the plugin exits or is killed, so it is not left in the temp dir
+		defer func() {
+			cancel()
+			<-exited
+			_ = os.Remove(c.spec.Socket)
+		}()
+	}
+
+	conn, err := grpc.NewClient("unix://"+c.spec.Socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := v1.NewCollectorPluginClient(conn)
+	info, err := c.handshake(ctx, client, exited)
+	if err != nil {
+		if isClosed(exited) {
+			return exitError(exitErr, stderr)
+		}
+		return err
+	}
+
+	lost := make(chan struct{})
+	c.connect(client, info, lost)
+	defer c.disconnect(lost, errNotConnected)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-exited:
+		return exitError(exitErr, stderr)
+	case <-lost:
+		c.mu.Lock()
+		reason := c.lastErr
+		c.mu.Unlock()
+
+		return fmt.Errorf("connection lost: %w", reason)
+	}
+}
+
+// handshake waits for the plugin to start serving and checks it speaks the protocol version of sysmon.
+// The handshake is aborted if the launched plugin exits before.
+func (c *collector) handshake(
+	ctx context.Context, client v1.CollectorPluginClient, exited <-chan struct{},
+) (*v1.DescribeResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, handshakeTimeout)
+	defer cancel()
+	go func() {
+		select {
+		case <-exited:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	info, err := client.Describe(ctx, &v1.DescribeRequest{ProtocolVersion: ProtocolVersion}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, fmt.Errorf("handshake failed: %w", err)
+	}
+	if info.GetProtocolVersion() != ProtocolVersion {
+		return nil, fmt.Errorf(
+			"handshake failed: protocol version %d is not supported, expected %d",
+			info.GetProtocolVersion(), ProtocolVersion,
+		)
+	}
+
+	return info, nil
+}
+
+// exitError returns the error of the plugin exited with the last line it wrote to stderr.
+func exitError(err error, stderr *tailWriter) error {
+	if err == nil {
+		err = errors.New("exit status 0")
+	}
+	if line := stderr.lastLine(); line != "" {
+		return fmt.Errorf("plugin exited: %w: %s", err, line)
+	}
+
+	return fmt.Errorf("plugin exited: %w", err)
+}
+
+// isClosed reports whether the channel is closed, the nil one is never closed.
+func isClosed(ch <-chan struct{}) bool {
+	if ch == nil {
+		return false
+	}
+
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
+// tailWriter keeps the last bytes written to it.
+type tailWriter struct {
+	mu  sync.Mutex
+	buf []byte
+}
+
+// Write keeps the last stderrTailSize bytes written.
+func (w *tailWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf = append(w.buf, p...)
+	if len(w.buf) > stderrTailSize {
+		w.buf = w.buf[len(w.buf)-stderrTailSize:]
+	}
+
+	return len(p), nil
+}
+
+// lastLine returns the last non-empty line written.
+func (w *tailWriter) lastLine() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	lines := strings.Split(strings.TrimSpace(string(w.buf)), "\n")
+
+	return strings.TrimSpace(lines[len(lines)-1])
+}
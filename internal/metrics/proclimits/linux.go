@@ -0,0 +1,188 @@
+package proclimits
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// rootUID is the uid of root exempt from the processes limit.
+const rootUID = "0"
+
+// process holds the resources usage and the soft limits of the single process.
+type process struct {
+	pid       int
+	name      string
+	uid       string
+	openFiles uint64
+	threads   uint64
+	lockedKb  uint64
+	// limits are the soft limits by their names, 0 means unlimited.
+	limits map[string]uint64
+}
+
+// parseForLinux parses the processes usage against their resource limits for Linux.
+func (p *parser) parseForLinux(_ context.Context) (models.ProcessLimitsStats, error) {
+	entries, err := os.ReadDir(p.paths.Proc)
+	if err != nil {
+		return models.ProcessLimitsStats{}, err
+	}
+
+	// The processes limit applies to all the threads of the user, so they are counted per user
+	userThreads := make(map[string]uint64)
+	procs := make([]process, 0, len(entries))
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		// The process may exit or be inaccessible to the current user
+		proc, err := p.readProcess(pid)
+		if err != nil {
+			continue
+		}
+		userThreads[proc.uid] += proc.threads
+		procs = append(procs, proc)
+	}
+
+	res := models.ProcessLimitsStats{
+		Processes: make([]models.ProcessLimits, 0, len(procs)),
+	}
+	for _, proc := range procs {
+		// Root is exempt from the processes limit, so its usage against the limit is meaningless
+		processesLimit := proc.limits[limitProcesses]
+		if proc.uid == rootUID {
+			processesLimit = 0
+		}
+
+		limits := models.ProcessLimits{
+			PID:            proc.pid,
+			Name:           proc.name,
+			Watched:        slices.Contains(p.watch, proc.name),
+			OpenFiles:      newLimitUsage(proc.openFiles, proc.limits[limitOpenFiles]),
+			Processes:      newLimitUsage(userThreads[proc.uid], processesLimit),
+			LockedMemoryKb: newLimitUsage(proc.lockedKb, proc.limits[limitLockedMemory]/1024),
+		}
+		limits.MaxUsedPercent = max(
+			limits.OpenFiles.UsedPercent,
+			limits.Processes.UsedPercent,
+			limits.LockedMemoryKb.UsedPercent,
+		)
+		res.Processes = append(res.Processes, limits)
+	}
+
+	sort.SliceStable(res.Processes, func(i, j int) bool {
+		return res.Processes[i].MaxUsedPercent > res.Processes[j].MaxUsedPercent
+	})
+	top := make([]models.ProcessLimits, 0, p.top+len(p.watch))
+	for i, proc := range res.Processes {
+		if i < p.top || proc.Watched {
+			top = append(top, proc)
+		}
+	}
+	res.Processes = top
+
+	return res, nil
+}
+
+// readProcess reads the resources usage and the soft limits of the process.
+func (p *parser) readProcess(pid int) (process, error) {
+	dir := filepath.Join(p.paths.Proc, strconv.Itoa(pid))
+	proc := process{pid: pid}
+
+	status, err := fs.ReadLines(filepath.Join(dir, "status"))
+	if err != nil {
+		return process{}, err
+	}
+	for _, line := range status {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		switch fields[0] {
+		case "Name:":
+			// The name may contain spaces like tmux: server
+			proc.name = strings.TrimSpace(strings.TrimPrefix(line, "Name:"))
+		case "Uid:":
+			proc.uid = fields[1]
+		case "Threads:":
+			proc.threads, _ = strconv.ParseUint(fields[1], 10, 64)
+		case "VmLck:":
+			proc.lockedKb, _ = strconv.ParseUint(fields[1], 10, 64)
+		}
+	}
+
+	fds, err := os.ReadDir(filepath.Join(dir, "fd"))
+	if err != nil {
+		return process{}, err
+	}
+	proc.openFiles = uint64(len(fds))
+
+	limits, err := fs.ReadLines(filepath.Join(dir, "limits"))
+	if err != nil {
+		return process{}, err
+	}
+	if proc.limits, err = parseLimits(limits); err != nil {
+		return process{}, err
+	}
+
+	return proc, nil
+}
+
+// parseLimits parses the soft limits of the process from the content of /proc/<pid>/limits.
+// Unlimited values are returned as 0.
+func parseLimits(lines []string) (map[string]uint64, error) {
+	if len(lines) < 2 || !strings.HasPrefix(lines[0], "Limit") {
+		return nil, metrics.ErrInvalidOutput
+	}
+
+	res := make(map[string]uint64)
+	for _, line := range lines[1:] {
+		for _, name := range []string{limitOpenFiles, limitProcesses, limitLockedMemory} {
+			if !strings.HasPrefix(line, name) {
+				continue
+			}
+
+			fields := strings.Fields(strings.TrimPrefix(line, name))
+			if len(fields) < 2 {
+				return nil, metrics.ErrInvalidOutput
+			}
+			if fields[0] == "unlimited" {
+				res[name] = 0
+				continue
+			}
+
+			soft, err := strconv.ParseUint(fields[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			res[name] = soft
+		}
+	}
+
+	return res, nil
+}
+
+// newLimitUsage returns the usage of the limit with the utilisation percentage.
+// The unlimited resources are marked as unavailable.
+func newLimitUsage(used, limit uint64) models.LimitUsage {
+	res := models.LimitUsage{
+		Used:      used,
+		Max:       limit,
+		Available: limit > 0,
+	}
+	if res.Available {
+		res.UsedPercent = float64(used) / float64(limit) * 100
+	}
+
+	return res
+}
@@ -0,0 +1,59 @@
+package proclimits
+
+import (
+	"context"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+var (
+	// limitOpenFiles is the name of the open files limit in /proc/<pid>/limits.
+	limitOpenFiles = "Max open files"
+	// limitProcesses is the name of the processes limit in /proc/<pid>/limits.
+	limitProcesses = "Max processes"
+	// limitLockedMemory is the name of the locked memory limit in /proc/<pid>/limits.
+	limitLockedMemory = "Max locked memory"
+
+	// defaultTop is the default number of the processes closest to their limits to report.
+	defaultTop = 10
+)
+
+// parser - struct to hold the parser dependencies.
+type parser struct {
+	execer cmd.Execer
+	paths  fs.Paths
+	// watch is the names of the processes to report regardless of their usage.
+	watch []string
+	// top is the number of the processes closest to their limits to report.
+	top int
+}
+
+// NewParser returns a new parser to parse the processes usage against their resource limits.
+// The watched processes are reported along with the top ones closest to their limits.
+//
+//nolint:revive
+func NewParser(execer cmd.Execer, paths fs.Paths, watch []string, top int) *parser {
+	if top <= 0 {
+		top = defaultTop
+	}
+
+	return &parser{
+		execer: execer,
+		paths:  paths,
+		watch:  watch,
+		top:    top,
+	}
+}
+
+// Parse parses the processes usage against their resource limits.
+func (p *parser) Parse(ctx context.Context) (models.ProcessLimitsStats, error) {
+	if p.execer.OS() == os.Linux {
+		return p.parseForLinux(ctx)
+	}
+
+	return models.ProcessLimitsStats{}, metrics.ErrUnsupportedOS
+}
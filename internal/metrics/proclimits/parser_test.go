@@ -0,0 +1,208 @@
+package proclimits
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+//nolint:funlen
+func Test_parser_Parse(t *testing.T) {
+	t.Parallel()
+
+	nginx := models.ProcessLimits{
+		PID:  200,
+		Name: "nginx",
+		OpenFiles: models.LimitUsage{
+			Used: 6, Max: 8, UsedPercent: 75, Available: true,
+		},
+		Processes: models.LimitUsage{
+			Used: 4, Max: 100, UsedPercent: 4, Available: true,
+		},
+		LockedMemoryKb: models.LimitUsage{
+			Used: 32, Max: 64, UsedPercent: 50, Available: true,
+		},
+		MaxUsedPercent: 75,
+	}
+
+	type fields struct {
+		execerMockFunc func(t *testing.T) cmd.Execer
+		watch          []string
+		top            int
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.ProcessLimitsStats
+		wantErr bool
+	}{
+		{
+			name: "ok linux top",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				top: 1,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.ProcessLimitsStats{
+				Processes: []models.ProcessLimits{nginx},
+			},
+		},
+		{
+			name: "ok linux top with watched",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				watch: []string{"tmux: server"},
+				top:   1,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.ProcessLimitsStats{
+				Processes: []models.ProcessLimits{
+					nginx,
+					{
+						PID:     300,
+						Name:    "tmux: server",
+						Watched: true,
+						OpenFiles: models.LimitUsage{
+							Used: 1, Max: 1024, UsedPercent: float64(1) / 1024 * 100, Available: true,
+						},
+						Processes:      models.LimitUsage{Used: 1},
+						LockedMemoryKb: models.LimitUsage{},
+						MaxUsedPercent: float64(1) / 1024 * 100,
+					},
+				},
+			},
+		},
+		{
+			name: "ok linux root exempt from processes limit",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				watch: []string{"systemd"},
+				top:   1,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.ProcessLimitsStats{
+				Processes: []models.ProcessLimits{
+					nginx,
+					{
+						PID:     1,
+						Name:    "systemd",
+						Watched: true,
+						OpenFiles: models.LimitUsage{
+							Used: 3, Max: 1024, UsedPercent: float64(3) / 1024 * 100, Available: true,
+						},
+						Processes: models.LimitUsage{Used: 1},
+						LockedMemoryKb: models.LimitUsage{
+							Max: 8192, Available: true,
+						},
+						MaxUsedPercent: float64(3) / 1024 * 100,
+					},
+				},
+			},
+		},
+		{
+			name: "err darwin unsupported",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Darwin).
+						Once()
+
+					return execer
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &parser{
+				execer: tt.fields.execerMockFunc(t),
+				paths:  fs.Paths{Proc: "testdata"},
+				watch:  tt.fields.watch,
+				top:    tt.fields.top,
+			}
+			got, err := p.Parse(tt.args.ctx)
+
+			require.Equalf(t, tt.wantErr, err != nil, "error = %v", err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseLimits(t *testing.T) {
+	t.Parallel()
+
+	lines, err := fs.ReadLines("testdata/300/limits")
+	require.NoError(t, err)
+
+	got, err := parseLimits(lines)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{
+		limitOpenFiles:    1024,
+		limitProcesses:    0,
+		limitLockedMemory: 0,
+	}, got)
+
+	_, err = parseLimits([]string{"Max open files 1024 4096 files"})
+	require.Error(t, err)
+}
@@ -0,0 +1,169 @@
+package procstate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// stat holds the fields of the /proc/<pid>/stat file the summary needs.
+type stat struct {
+	name      string
+	state     byte
+	ppid      int
+	threads   int
+	startTime uint64
+}
+
+// parseForLinux parses the processes and threads states summary for Linux.
+func (p *parser) parseForLinux(_ context.Context) (models.ProcStateStats, error) {
+	entries, err := os.ReadDir(p.paths.Proc)
+	if err != nil {
+		return models.ProcStateStats{}, err
+	}
+
+	now := p.now()
+	res := models.ProcStateStats{}
+	names := make(map[int]string)
+	zombies := make(map[int]int)
+	blocked := make(map[task]string)
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		// The process may exit during the scan
+		proc, err := readStat(filepath.Join(p.paths.Proc, entry.Name(), "stat"))
+		if err != nil {
+			continue
+		}
+		names[pid] = proc.name
+		res.Processes.Add(proc.state)
+		res.TotalProcesses++
+		res.TotalThreads += proc.threads
+		if proc.state == 'Z' {
+			zombies[proc.ppid]++
+		}
+
+		tasks, err := os.ReadDir(filepath.Join(p.paths.Proc, entry.Name(), "task"))
+		if err != nil {
+			continue
+		}
+		for _, t := range tasks {
+			tid, err := strconv.Atoi(t.Name())
+			if err != nil {
+				continue
+			}
+			thread, err := readStat(filepath.Join(p.paths.Proc, entry.Name(), "task", t.Name(), "stat"))
+			if err != nil {
+				continue
+			}
+			res.Threads.Add(thread.state)
+			if thread.state == 'D' {
+				blocked[task{tid: tid, startTime: thread.startTime}] = thread.name
+			}
+		}
+	}
+
+	res.ZombieParents = make([]models.ZombieParent, 0, len(zombies))
+	for ppid, n := range zombies {
+		res.ZombieParents = append(res.ZombieParents, models.ZombieParent{
+			PID:     ppid,
+			Name:    names[ppid],
+			Zombies: n,
+		})
+	}
+	sort.Slice(res.ZombieParents, func(i, j int) bool {
+		if res.ZombieParents[i].Zombies != res.ZombieParents[j].Zombies {
+			return res.ZombieParents[i].Zombies > res.ZombieParents[j].Zombies
+		}
+		return res.ZombieParents[i].PID < res.ZombieParents[j].PID
+	})
+
+	res.Blocked = p.trackBlocked(blocked, now)
+
+	return res, nil
+}
+
+// trackBlocked remembers the tasks currently in the uninterruptible sleep
+// and returns the ones blocked longer than the threshold.
+func (p *parser) trackBlocked(blocked map[task]string, now time.Time) []models.BlockedTask {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	since := make(map[task]time.Time, len(blocked))
+	res := make([]models.BlockedTask, 0)
+	for t, name := range blocked {
+		first, ok := p.blockedSince[t]
+		if !ok {
+			first = now
+		}
+		since[t] = first
+
+		if d := now.Sub(first); d >= blockedThreshold {
+			res = append(res, models.BlockedTask{
+				TID:        t.tid,
+				Name:       name,
+				BlockedSec: d.Seconds(),
+			})
+		}
+	}
+	// The tasks woken up are forgotten
+	p.blockedSince = since
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].BlockedSec != res[j].BlockedSec {
+			return res[i].BlockedSec > res[j].BlockedSec
+		}
+		return res[i].TID < res[j].TID
+	})
+
+	return res
+}
+
+// readStat reads the stat file of the process or the thread.
+// The command name is in parentheses and may contain spaces and parentheses itself,
+// so the fields are split after the last closing parenthesis.
+func readStat(path string) (stat, error) {
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return stat{}, err
+	}
+
+	s := string(bb)
+	start := strings.IndexByte(s, '(')
+	end := strings.LastIndexByte(s, ')')
+	if start == -1 || end < start {
+		return stat{}, metrics.ErrInvalidOutput
+	}
+
+	// Fields starting from the state which is the 3rd field of the file
+	fields := strings.Fields(s[end+1:])
+	if len(fields) < 20 || len(fields[0]) != 1 {
+		return stat{}, metrics.ErrInvalidOutput
+	}
+
+	res := stat{
+		name:  s[start+1 : end],
+		state: fields[0][0],
+	}
+	if res.ppid, err = strconv.Atoi(fields[1]); err != nil {
+		return stat{}, err
+	}
+	if res.threads, err = strconv.Atoi(fields[17]); err != nil {
+		return stat{}, err
+	}
+	if res.startTime, err = strconv.ParseUint(fields[19], 10, 64); err != nil {
+		return stat{}, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,56 @@
+package procstate
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// blockedThreshold is the time the task should stay in the uninterruptible sleep to be reported.
+var blockedThreshold = 10 * time.Second
+
+// task identifies the thread across the scans, the start time protects from the reused TIDs.
+type task struct {
+	tid       int
+	startTime uint64
+}
+
+// parser - struct to hold the parser dependencies.
+// The parser is stateful: it remembers since when the tasks are blocked between the calls,
+// so it should be created once and reused.
+type parser struct {
+	execer cmd.Execer
+	paths  fs.Paths
+	now    func() time.Time
+
+	mu sync.Mutex
+	// blockedSince holds the time the tasks in the uninterruptible sleep were first seen at.
+	blockedSince map[task]time.Time
+}
+
+// NewParser returns a new parser to parse the processes and threads states summary.
+//
+//nolint:revive
+func NewParser(execer cmd.Execer, paths fs.Paths) *parser {
+	return &parser{
+		execer:       execer,
+		paths:        paths,
+		now:          time.Now,
+		blockedSince: make(map[task]time.Time),
+	}
+}
+
+// Parse parses the processes and threads states summary of the system.
+func (p *parser) Parse(ctx context.Context) (models.ProcStateStats, error) {
+	if p.execer.OS() == os.Linux {
+		return p.parseForLinux(ctx)
+	}
+
+	return models.ProcStateStats{}, metrics.ErrUnsupportedOS
+}
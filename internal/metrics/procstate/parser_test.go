@@ -0,0 +1,181 @@
+package procstate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+func Test_parser_Parse(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		execerMockFunc func(t *testing.T) cmd.Execer
+		paths          fs.Paths
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.ProcStateStats
+		wantErr bool
+	}{
+		{
+			name: "ok linux",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.ProcStateStats{
+				Processes: models.StateCounts{
+					Sleeping:  2,
+					DiskSleep: 1,
+					Zombie:    2,
+					Stopped:   1,
+					Idle:      1,
+				},
+				Threads: models.StateCounts{
+					Running:   1,
+					Sleeping:  2,
+					DiskSleep: 1,
+					Zombie:    2,
+					Stopped:   1,
+					Idle:      1,
+				},
+				TotalProcesses: 7,
+				TotalThreads:   8,
+				ZombieParents: []models.ZombieParent{
+					{PID: 50, Name: "supervisor", Zombies: 2},
+				},
+				Blocked: []models.BlockedTask{},
+			},
+		},
+		{
+			name: "err no procfs",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/notexists"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "err darwin unsupported",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Darwin).
+						Once()
+
+					return execer
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := NewParser(tt.fields.execerMockFunc(t), tt.fields.paths)
+			got, err := p.Parse(tt.args.ctx)
+
+			require.Equalf(t, tt.wantErr, err != nil, "error = %v", err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parser_Parse_blocked(t *testing.T) {
+	t.Parallel()
+
+	execer := cmd.NewMockExecer(t)
+	execer.EXPECT().
+		OS().
+		Return(os.Linux).
+		Times(3)
+
+	now := time.Date(2026, 10, 19, 3, 0, 0, 0, time.UTC)
+	p := NewParser(execer, fs.Paths{Proc: "testdata"})
+	p.now = func() time.Time { return now }
+
+	// The task is just seen blocked
+	got, err := p.Parse(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, got.Blocked)
+
+	now = now.Add(5 * time.Second)
+	got, err = p.Parse(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, got.Blocked)
+
+	now = now.Add(10 * time.Second)
+	got, err = p.Parse(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []models.BlockedTask{
+		{TID: 100, Name: "postgres: (wal)", BlockedSec: 15},
+	}, got.Blocked)
+}
+
+func Test_readStat(t *testing.T) {
+	t.Parallel()
+
+	got, err := readStat("testdata/100/stat")
+	require.NoError(t, err)
+	require.Equal(t, stat{
+		name:      "postgres: (wal)",
+		state:     'D',
+		ppid:      50,
+		threads:   2,
+		startTime: 5000,
+	}, got)
+
+	_, err = readStat("testdata/uptime")
+	require.Error(t, err)
+}
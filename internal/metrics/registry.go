@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"fmt"
+	"sync"
+)
+
+// registry is the registry of the metrics collectors by their names.
+type registry struct {
+	mu         sync.RWMutex
+	collectors []Collector
+	byName     map[string]Collector
+}
+
+// NewRegistry returns a new instance of the empty collectors registry.
+//
+//nolint:revive
+func NewRegistry() *registry {
+	return &registry{
+		byName: make(map[string]Collector),
+	}
+}
+
+// Register adds the collector to the registry.
+// Returns an error if the collector with the same name is already registered.
+func (r *registry) Register(c Collector) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if c.Name() == "" {
+		return fmt.Errorf("collector has no name")
+	}
+	if _, ok := r.byName[c.Name()]; ok {
+		return fmt.Errorf("collector %s is already registered", c.Name())
+	}
+
+	r.collectors = append(r.collectors, c)
+	r.byName[c.Name()] = c
+
+	return nil
+}
+
+// Get returns the collector by the metric name.
+func (r *registry) Get(name string) (Collector, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.byName[name]
+
+	return c, ok
+}
+
+// List returns the collectors in the order they were registered.
+func (r *registry) List() []Collector {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make([]Collector, len(r.collectors))
+	copy(res, r.collectors)
+
+	return res
+}
@@ -0,0 +1,104 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// loadAverageParser is a stub parser returning the fixed load average.
+type loadAverageParser struct{}
+
+// Parse returns the fixed load average.
+func (loadAverageParser) Parse(_ context.Context) (models.LoadAverageStats, error) {
+	return models.LoadAverageStats{OneMin: 1.5}, nil
+}
+
+func Test_registry_Register(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		specs   []CollectorSpec
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "ok in registration order",
+			specs: []CollectorSpec{{Name: "loadavg"}, {Name: "cpu"}},
+			want:  []string{"loadavg", "cpu"},
+		},
+		{
+			name:    "err duplicate name",
+			specs:   []CollectorSpec{{Name: "loadavg"}, {Name: "loadavg"}},
+			want:    []string{"loadavg"},
+			wantErr: true,
+		},
+		{
+			name:    "err no name",
+			specs:   []CollectorSpec{{}},
+			want:    []string{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := NewRegistry()
+			var err error
+			for _, spec := range tt.specs {
+				if err = r.Register(NewCollector[models.LoadAverageStats](spec, loadAverageParser{})); err != nil {
+					break
+				}
+			}
+			require.Equalf(t, tt.wantErr, err != nil, "error = %v", err)
+
+			got := make([]string, 0, len(tt.want))
+			for _, c := range r.List() {
+				got = append(got, c.Name())
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_collector_Collect(t *testing.T) {
+	t.Parallel()
+
+	r := NewRegistry()
+	require.NoError(t, r.Register(NewCollector[models.LoadAverageStats](
+		CollectorSpec{Name: "loadavg", Platforms: []string{os.Linux}},
+		loadAverageParser{},
+	)))
+
+	c, ok := r.Get("loadavg")
+	require.True(t, ok)
+	require.True(t, Supports(c, os.Linux))
+	require.False(t, Supports(c, os.Darwin))
+
+	s, err := c.Collect(context.Background())
+	require.NoError(t, err)
+
+	stored, ok := s.(models.StoredStats)
+	require.True(t, ok)
+
+	var m models.Metrics
+	stored.Store(&m)
+	require.Equal(t, models.LoadAverageStats{OneMin: 1.5}, m.LoadAverageStats)
+	require.Contains(t, s.Samples(), models.Sample{
+		Name:   "loadavg",
+		Labels: map[string]string{"period": "1m"},
+		Unit:   models.UnitCount,
+		Type:   models.SampleTypeGauge,
+		Value:  1.5,
+	})
+
+	_, ok = r.Get("cpu")
+	require.False(t, ok)
+}
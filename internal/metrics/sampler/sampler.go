@@ -0,0 +1,92 @@
+package sampler
+
+import (
+	"math/bits"
+	"sync"
+	"time"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+)
+
+// NativeBits is the size of the counters the kernel keeps as unsigned long like the ones of /proc/diskstats.
+const NativeBits = bits.UintSize
+
+// Sampler keeps the previous snapshot of the raw counters to calculate the rates by the consecutive snapshots,
+// so the rate covers exactly the time between the collections and the collection does not wait to measure it.
+type Sampler[T any] struct {
+	mu       sync.Mutex
+	prev     T
+	prevTime time.Time
+	warm     bool
+	now      func() time.Time
+}
+
+// NewSampler returns a new instance of the sampler of the counters snapshots.
+func NewSampler[T any]() *Sampler[T] {
+	return NewSamplerWithClock[T](time.Now)
+}
+
+// NewSamplerWithClock returns a new instance of the sampler taking the time of the snapshots from the clock.
+func NewSamplerWithClock[T any](now func() time.Time) *Sampler[T] {
+	return &Sampler[T]{
+		now: now,
+	}
+}
+
+// Next stores the current snapshot and returns the previous one with the seconds elapsed since it was taken.
+// Returns metrics.ErrWarmingUp on the first call since there is no snapshot to compare with yet.
+func (s *Sampler[T]) Next(cur T) (prev T, seconds float64, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	prev, prevTime, warm := s.prev, s.prevTime, s.warm
+	s.prev, s.prevTime, s.warm = cur, now, true
+
+	if !warm {
+		return prev, 0, metrics.ErrWarmingUp
+	}
+
+	return prev, now.Sub(prevTime).Seconds(), nil
+}
+
+// Reset forgets the previous snapshot, so the next call of Next returns metrics.ErrWarmingUp.
+// The rates are not calculated over the time the counters were not sampled, e.g. the collector was disabled.
+func (s *Sampler[T]) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var zero T
+	s.prev, s.prevTime, s.warm = zero, time.Time{}, false
+}
+
+// Delta returns the increase of the counter of the bits size between the snapshots.
+// The counter smaller than the previous one has either wrapped around or been reset,
+// e.g. the network interface was re-created. The wraparound is assumed
+// only if the increase it implies is less than the half of the counter range.
+// The reset counter gives no increase since it is unknown when it was reset.
+func Delta(prev, cur uint64, size int) uint64 {
+	if cur >= prev {
+		return cur - prev
+	}
+
+	if size < 64 {
+		limit := uint64(1) << size
+		if prev < limit {
+			if wrapped := limit - prev + cur; wrapped < limit/2 {
+				return wrapped
+			}
+		}
+	}
+
+	return 0
+}
+
+// Rate returns the increase of the counter of the bits size per second.
+func Rate(prev, cur uint64, size int, seconds float64) float64 {
+	if seconds <= 0 {
+		return 0
+	}
+
+	return float64(Delta(prev, cur, size)) / seconds
+}
@@ -0,0 +1,112 @@
+package sampler
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+)
+
+func Test_sampler_Next(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	s := NewSampler[uint64]()
+	s.now = func() time.Time {
+		return now
+	}
+
+	_, _, err := s.Next(10)
+	require.ErrorIs(t, err, metrics.ErrWarmingUp)
+
+	now = now.Add(2 * time.Second)
+	prev, seconds, err := s.Next(30)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), prev)
+	require.Equal(t, 2.0, seconds)
+
+	now = now.Add(500 * time.Millisecond)
+	prev, seconds, err = s.Next(40)
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), prev)
+	require.Equal(t, 0.5, seconds)
+}
+
+func Test_sampler_Reset(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	s := NewSamplerWithClock[uint64](func() time.Time {
+		return now
+	})
+
+	_, _, err := s.Next(10)
+	require.ErrorIs(t, err, metrics.ErrWarmingUp)
+
+	// The rates are not calculated over the time since the snapshot before the reset
+	s.Reset()
+	now = now.Add(time.Minute)
+	_, _, err = s.Next(30)
+	require.ErrorIs(t, err, metrics.ErrWarmingUp)
+
+	now = now.Add(2 * time.Second)
+	prev, seconds, err := s.Next(40)
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), prev)
+	require.Equal(t, 2.0, seconds)
+}
+
+func TestDelta(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		prev uint64
+		cur  uint64
+		size int
+		want uint64
+	}{
+		{
+			name: "increase",
+			prev: 100,
+			cur:  150,
+			size: 64,
+			want: 50,
+		},
+		{
+			name: "32 bits wraparound",
+			prev: math.MaxUint32 - 9,
+			cur:  20,
+			size: 32,
+			want: 30,
+		},
+		{
+			name: "32 bits reset",
+			prev: 1_000_000,
+			cur:  20,
+			size: 32,
+			want: 0,
+		},
+		{
+			name: "64 bits reset",
+			prev: math.MaxUint64 - 9,
+			cur:  20,
+			size: 64,
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, Delta(tt.prev, tt.cur, tt.size))
+		})
+	}
+
+	require.Equal(t, 25.0, Rate(100, 150, 64, 2))
+	require.Zero(t, Rate(100, 150, 64, 0))
+}
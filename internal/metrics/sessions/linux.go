@@ -0,0 +1,101 @@
+package sessions
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// Layout of the glibc utmp record on the 64-bit and 32-bit platforms.
+const (
+	// recordSize is the size of the utmp record.
+	recordSize = 384
+
+	// offType is the offset of the record type.
+	offType = 0
+	// offPID is the offset of the login process PID.
+	offPID = 4
+	// offLine is the offset of the device name of the tty.
+	offLine = 8
+	// offUser is the offset of the user name.
+	offUser = 44
+	// offHost is the offset of the remote host name.
+	offHost = 76
+	// offTime is the offset of the login time seconds.
+	offTime = 340
+
+	// sizeLine is the size of the device name of the tty.
+	sizeLine = 32
+	// sizeUser is the size of the user name.
+	sizeUser = 32
+	// sizeHost is the size of the remote host name.
+	sizeHost = 256
+
+	// userProcess is the type of the record of the logged-in user session.
+	userProcess = 7
+)
+
+// parseForLinux parses the logged-in users sessions for Linux.
+func (p *parser) parseForLinux(_ context.Context) (models.SessionsStats, error) {
+	bb, err := os.ReadFile(filepath.Join(p.paths.Run, fileUtmp))
+	if err != nil {
+		return models.SessionsStats{}, err
+	}
+
+	sessions, err := parseUtmp(bb)
+	if err != nil {
+		return models.SessionsStats{}, err
+	}
+
+	perUser := make(map[string]int)
+	for _, s := range sessions {
+		perUser[s.User]++
+	}
+
+	return models.SessionsStats{
+		Sessions: sessions,
+		PerUser:  perUser,
+	}, nil
+}
+
+// parseUtmp parses the content of the utmp file and returns the sessions of the logged-in users.
+// The file is the sequence of the fixed size records in the native byte order.
+func parseUtmp(bb []byte) ([]models.Session, error) {
+	if len(bb)%recordSize != 0 {
+		return nil, metrics.ErrInvalidOutput
+	}
+
+	res := make([]models.Session, 0)
+	for off := 0; off < len(bb); off += recordSize {
+		rec := bb[off : off+recordSize]
+		// Other records are the boot time, run level, terminals waiting for login and ended sessions
+		if binary.NativeEndian.Uint16(rec[offType:]) != userProcess {
+			continue
+		}
+
+		res = append(res, models.Session{
+			User:      cString(rec[offUser : offUser+sizeUser]),
+			TTY:       cString(rec[offLine : offLine+sizeLine]),
+			Host:      cString(rec[offHost : offHost+sizeHost]),
+			PID:       int(int32(binary.NativeEndian.Uint32(rec[offPID:]))),
+			LoginTime: time.Unix(int64(int32(binary.NativeEndian.Uint32(rec[offTime:]))), 0).UTC(),
+		})
+	}
+
+	return res, nil
+}
+
+// cString returns the string of the null-terminated byte array.
+func cString(bb []byte) string {
+	if i := bytes.IndexByte(bb, 0); i != -1 {
+		bb = bb[:i]
+	}
+
+	return string(bb)
+}
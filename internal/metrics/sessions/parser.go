@@ -0,0 +1,39 @@
+package sessions
+
+import (
+	"context"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// fileUtmp is the file with the login records relative to the runtime state directory.
+var fileUtmp = "utmp"
+
+// parser - struct to hold the parser dependencies.
+type parser struct {
+	execer cmd.Execer
+	paths  fs.Paths
+}
+
+// NewParser returns a new parser to parse the logged-in users sessions.
+//
+//nolint:revive
+func NewParser(execer cmd.Execer, paths fs.Paths) *parser {
+	return &parser{
+		execer: execer,
+		paths:  paths,
+	}
+}
+
+// Parse parses the active login sessions of the system.
+func (p *parser) Parse(ctx context.Context) (models.SessionsStats, error) {
+	if p.execer.OS() == os.Linux {
+		return p.parseForLinux(ctx)
+	}
+
+	return models.SessionsStats{}, metrics.ErrUnsupportedOS
+}
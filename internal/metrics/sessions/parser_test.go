@@ -0,0 +1,157 @@
+package sessions
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	osUtils "github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+func Test_parser_Parse(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		execerMockFunc func(t *testing.T) cmd.Execer
+		paths          fs.Paths
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.SessionsStats
+		wantErr bool
+	}{
+		{
+			name: "ok linux",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(osUtils.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Run: "testdata"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.SessionsStats{
+				Sessions: []models.Session{
+					{
+						User:      "alice",
+						TTY:       "pts/0",
+						Host:      "10.0.0.5",
+						PID:       40211,
+						LoginTime: time.Date(2025, 10, 19, 3, 0, 0, 0, time.UTC),
+					},
+					{
+						User:      "bob",
+						TTY:       "tty2",
+						PID:       1022,
+						LoginTime: time.Date(2025, 10, 19, 6, 0, 0, 0, time.UTC),
+					},
+					{
+						User:      "alice",
+						TTY:       "pts/1",
+						Host:      "laptop.example.com",
+						PID:       41002,
+						LoginTime: time.Date(2025, 10, 19, 7, 0, 0, 0, time.UTC),
+					},
+				},
+				PerUser: map[string]int{
+					"alice": 2,
+					"bob":   1,
+				},
+			},
+		},
+		{
+			name: "err no utmp file",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(osUtils.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Run: "testdata/notexists"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "err darwin unsupported",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(osUtils.Darwin).
+						Once()
+
+					return execer
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &parser{
+				execer: tt.fields.execerMockFunc(t),
+				paths:  tt.fields.paths,
+			}
+			got, err := p.Parse(tt.args.ctx)
+
+			require.Equalf(t, tt.wantErr, err != nil, "error = %v", err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseUtmp(t *testing.T) {
+	t.Parallel()
+
+	bb, err := os.ReadFile("testdata/utmp")
+	require.NoError(t, err)
+
+	// Truncated record
+	_, err = parseUtmp(bb[:len(bb)-1])
+	require.Error(t, err)
+
+	got, err := parseUtmp(nil)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
@@ -0,0 +1,215 @@
+package sockstat
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// counters holds the sockstat counters keyed by the protocol and the counter name like "TCP.tw".
+type counters map[string]uint64
+
+// parseForLinux parses the sockets usage and the network memory statistics for Linux.
+func (p *parser) parseForLinux(_ context.Context) (models.SockStatStats, error) {
+	c := make(counters)
+	for _, file := range []string{fileSockstat, fileSockstat6} {
+		lines, err := fs.ReadLines(filepath.Join(p.paths.Proc, file))
+		if err != nil {
+			return models.SockStatStats{}, err
+		}
+		if err = parseCounters(lines, c); err != nil {
+			return models.SockStatStats{}, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+	}
+
+	tcpMem, err := p.readMemLimits(fileTCPMem)
+	if err != nil {
+		return models.SockStatStats{}, err
+	}
+	udpMem, err := p.readMemLimits(fileUDPMem)
+	if err != nil {
+		return models.SockStatStats{}, err
+	}
+
+	res := models.SockStatStats{
+		SocketsUsed: c["sockets.used"],
+		TCP: models.SockStatTCP{
+			InUse:    c["TCP.inuse"],
+			InUse6:   c["TCP6.inuse"],
+			Orphan:   c["TCP.orphan"],
+			TimeWait: c["TCP.tw"],
+			Alloc:    c["TCP.alloc"],
+			Memory:   newSockMemory(c["TCP.mem"], tcpMem, p.pageSize),
+		},
+		UDP: models.SockStatUDP{
+			InUse:  c["UDP.inuse"],
+			InUse6: c["UDP6.inuse"],
+			Memory: newSockMemory(c["UDP.mem"], udpMem, p.pageSize),
+		},
+		RawInUse: c["RAW.inuse"] + c["RAW6.inuse"],
+	}
+
+	for _, file := range filesUDP {
+		lines, err := fs.ReadLines(filepath.Join(p.paths.Proc, file))
+		if err != nil {
+			return models.SockStatStats{}, err
+		}
+		drops, err := parseUDPDrops(lines)
+		if err != nil {
+			return models.SockStatStats{}, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		res.UDPDrops = append(res.UDPDrops, drops...)
+	}
+	sort.SliceStable(res.UDPDrops, func(i, j int) bool {
+		return res.UDPDrops[i].Drops > res.UDPDrops[j].Drops
+	})
+	if len(res.UDPDrops) > topUDPDrops {
+		res.UDPDrops = res.UDPDrops[:topUDPDrops]
+	}
+
+	return res, nil
+}
+
+// parseCounters parses the content of /proc/net/sockstat or /proc/net/sockstat6 into the provided counters.
+// Every line holds the protocol and the pairs of the counter names and values like "TCP: inuse 12 orphan 0".
+func parseCounters(lines []string, res counters) error {
+	for _, line := range lines {
+		proto, values, ok := strings.Cut(line, ":")
+		if !ok {
+			return metrics.ErrInvalidOutput
+		}
+
+		fields := strings.Fields(values)
+		if len(fields)%2 != 0 {
+			return metrics.ErrInvalidOutput
+		}
+		for i := 0; i < len(fields); i += 2 {
+			n, err := strconv.ParseUint(fields[i+1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse %s.%s: %w", proto, fields[i], err)
+			}
+			res[proto+"."+fields[i]] = n
+		}
+	}
+
+	return nil
+}
+
+// readMemLimits reads the min, pressure and max memory limits in pages like tcp_mem.
+func (p *parser) readMemLimits(file string) ([3]uint64, error) {
+	lines, err := fs.ReadLines(filepath.Join(p.paths.Proc, file))
+	if err != nil {
+		return [3]uint64{}, err
+	}
+
+	fields := strings.Fields(lines[0])
+	if len(fields) != 3 {
+		return [3]uint64{}, metrics.ErrInvalidOutput
+	}
+
+	var res [3]uint64
+	for i, field := range fields {
+		if res[i], err = strconv.ParseUint(field, 10, 64); err != nil {
+			return [3]uint64{}, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+	}
+
+	return res, nil
+}
+
+// newSockMemory returns the memory usage of the protocol against its limits.
+// The kernel starts moderating the memory allocation above the pressure threshold.
+func newSockMemory(used uint64, limits [3]uint64, pageSize uint64) models.SockMemory {
+	res := models.SockMemory{
+		UsedPages:     used,
+		PressurePages: limits[1],
+		MaxPages:      limits[2],
+		UsedBytes:     used * pageSize,
+		UnderPressure: limits[1] > 0 && used >= limits[1],
+	}
+	if limits[2] > 0 {
+		res.UsedPercent = float64(used) / float64(limits[2]) * 100
+	}
+
+	return res
+}
+
+// parseUDPDrops parses the content of /proc/net/udp or /proc/net/udp6
+// and returns the sockets with the datagrams dropped.
+func parseUDPDrops(lines []string) ([]models.UDPSocketDrops, error) {
+	var res []models.UDPSocketDrops
+	// The first line is the header
+	for _, line := range lines[1:] {
+		fields := strings.Fields(line)
+		// sl local_address rem_address st tx_queue:rx_queue tr:tm->when retrnsmt uid timeout inode ref pointer drops
+		if len(fields) < 13 {
+			return nil, metrics.ErrInvalidOutput
+		}
+
+		drops, err := strconv.ParseUint(fields[12], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		if drops == 0 {
+			continue
+		}
+
+		local, err := parseAddress(fields[1])
+		if err != nil {
+			return nil, err
+		}
+		_, rxQueue, _ := strings.Cut(fields[4], ":")
+		rx, err := strconv.ParseUint(rxQueue, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		inode, err := strconv.ParseUint(fields[9], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, models.UDPSocketDrops{
+			LocalAddress: local,
+			Inode:        inode,
+			Drops:        drops,
+			RxQueueBytes: rx,
+		})
+	}
+
+	return res, nil
+}
+
+// parseAddress parses the hex encoded address like "0100007F:0035" to "127.0.0.1:53".
+// The IP address is stored as the 32-bit words in the host byte order.
+func parseAddress(s string) (string, error) {
+	ipHex, portHex, ok := strings.Cut(s, ":")
+	if !ok {
+		return "", metrics.ErrInvalidOutput
+	}
+
+	bb, err := hex.DecodeString(ipHex)
+	if err != nil || (len(bb) != net.IPv4len && len(bb) != net.IPv6len) {
+		return "", metrics.ErrInvalidOutput
+	}
+	ip := make(net.IP, len(bb))
+	for i := 0; i < len(bb); i += 4 {
+		binary.BigEndian.PutUint32(ip[i:], binary.NativeEndian.Uint32(bb[i:]))
+	}
+
+	port, err := strconv.ParseUint(portHex, 16, 16)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(ip.String(), strconv.FormatUint(port, 10)), nil
+}
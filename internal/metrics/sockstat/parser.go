@@ -0,0 +1,55 @@
+package sockstat
+
+import (
+	"context"
+	"syscall"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+var (
+	// fileSockstat is the procfs file with the IPv4 sockets usage.
+	fileSockstat = "net/sockstat"
+	// fileSockstat6 is the procfs file with the IPv6 sockets usage.
+	fileSockstat6 = "net/sockstat6"
+	// fileTCPMem is the procfs file with the TCP memory limits in pages.
+	fileTCPMem = "sys/net/ipv4/tcp_mem"
+	// fileUDPMem is the procfs file with the UDP memory limits in pages.
+	fileUDPMem = "sys/net/ipv4/udp_mem"
+	// filesUDP are the procfs files with the IPv4 and IPv6 UDP sockets.
+	filesUDP = []string{"net/udp", "net/udp6"}
+
+	// topUDPDrops is the number of the UDP sockets with the most drops to report.
+	topUDPDrops = 10
+)
+
+// parser - struct to hold the parser dependencies.
+type parser struct {
+	execer   cmd.Execer
+	paths    fs.Paths
+	pageSize uint64
+}
+
+// NewParser returns a new parser to parse the sockets usage and the network memory statistics.
+//
+//nolint:revive
+func NewParser(execer cmd.Execer, paths fs.Paths) *parser {
+	return &parser{
+		execer:   execer,
+		paths:    paths,
+		pageSize: uint64(syscall.Getpagesize()),
+	}
+}
+
+// Parse parses the sockets usage and the network memory statistics of the system.
+func (p *parser) Parse(ctx context.Context) (models.SockStatStats, error) {
+	if p.execer.OS() == os.Linux {
+		return p.parseForLinux(ctx)
+	}
+
+	return models.SockStatStats{}, metrics.ErrUnsupportedOS
+}
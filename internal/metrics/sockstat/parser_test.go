@@ -0,0 +1,175 @@
+package sockstat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+func Test_parser_Parse(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		execerMockFunc func(t *testing.T) cmd.Execer
+		paths          fs.Paths
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.SockStatStats
+		wantErr bool
+	}{
+		{
+			name: "ok linux",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.SockStatStats{
+				SocketsUsed: 1294,
+				TCP: models.SockStatTCP{
+					InUse:    312,
+					InUse6:   41,
+					Orphan:   7,
+					TimeWait: 4821,
+					Alloc:    340,
+					Memory: models.SockMemory{
+						UsedPages:     190000,
+						UsedBytes:     778240000,
+						PressurePages: 125423,
+						MaxPages:      188130,
+						UsedPercent:   100.99399351512252,
+						UnderPressure: true,
+					},
+				},
+				UDP: models.SockStatUDP{
+					InUse:  14,
+					InUse6: 3,
+					Memory: models.SockMemory{
+						UsedPages:     96,
+						UsedBytes:     393216,
+						PressurePages: 250846,
+						MaxPages:      376266,
+						UsedPercent:   0.025513865191114798,
+					},
+				},
+				RawInUse: 3,
+				UDPDrops: []models.UDPSocketDrops{
+					{LocalAddress: "0.0.0.0:8080", Inode: 22814, Drops: 1532, RxQueueBytes: 240000},
+					{LocalAddress: "[::1]:5353", Inode: 19001, Drops: 40},
+					{LocalAddress: "10.2.0.15:514", Inode: 31337, Drops: 12},
+				},
+			},
+		},
+		{
+			name: "err no procfs files",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/notexists"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "err darwin unsupported",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Darwin).
+						Once()
+
+					return execer
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &parser{
+				execer:   tt.fields.execerMockFunc(t),
+				paths:    tt.fields.paths,
+				pageSize: 4096,
+			}
+			got, err := p.Parse(tt.args.ctx)
+
+			require.Equalf(t, tt.wantErr, err != nil, "error = %v", err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseAddress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{name: "ipv4 loopback", s: "0100007F:0035", want: "127.0.0.1:53"},
+		{name: "ipv6 any", s: "00000000000000000000000000000000:0222", want: "[::]:546"},
+		{name: "ipv6 link local", s: "000080FE00000000FF005450B6AC37FE:0222", want: "[fe80::5054:ff:fe37:acb6]:546"},
+		{name: "no port", s: "0100007F", wantErr: true},
+		{name: "invalid ip", s: "0100:0035", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseAddress(tt.s)
+			require.Equalf(t, tt.wantErr, err != nil, "error = %v", err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
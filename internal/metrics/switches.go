@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"sync"
+)
+
+// switches is the set of the collectors disabled at runtime.
+type switches struct {
+	mu       sync.RWMutex
+	disabled map[string]struct{}
+}
+
+// NewSwitches returns a new instance of the switches of the collectors with the ones of the names disabled.
+//
+//nolint:revive
+func NewSwitches(disabled ...string) *switches {
+	s := &switches{
+		disabled: make(map[string]struct{}, len(disabled)),
+	}
+	for _, name := range disabled {
+		s.disabled[name] = struct{}{}
+	}
+
+	return s
+}
+
+// Enabled reports whether the collector of the name is enabled.
+func (s *switches) Enabled(name string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, disabled := s.disabled[name]
+
+	return !disabled
+}
+
+// SetEnabled enables or disables the collector of the name.
+func (s *switches) SetEnabled(name string, enabled bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if enabled {
+		delete(s.disabled, name)
+		return
+	}
+	s.disabled[name] = struct{}{}
+}
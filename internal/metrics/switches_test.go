@@ -0,0 +1,24 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_switches_SetEnabled(t *testing.T) {
+	t.Parallel()
+
+	s := NewSwitches("interrupts")
+	require.True(t, s.Enabled("cpu"))
+	require.False(t, s.Enabled("interrupts"))
+
+	s.SetEnabled("cpu", false)
+	s.SetEnabled("interrupts", true)
+	require.False(t, s.Enabled("cpu"))
+	require.True(t, s.Enabled("interrupts"))
+
+	// Enabling the enabled collector changes nothing
+	s.SetEnabled("interrupts", true)
+	require.True(t, s.Enabled("interrupts"))
+}
@@ -0,0 +1,55 @@
+package sysinfo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// cachedParser - struct to hold the inventory parsed the last time.
+type cachedParser struct {
+	parser *parser
+	ttl    time.Duration
+
+	mu       sync.Mutex
+	info     models.SystemInfo
+	parsedAt time.Time
+}
+
+// NewCachedParser returns a new parser to parse the hardware and OS inventory
+// that re-parses the inventory not more often than once per the provided ttl.
+//
+//nolint:revive
+func NewCachedParser(execer cmd.Execer, paths fs.Paths, ttl time.Duration) *cachedParser {
+	return &cachedParser{
+		parser: NewParser(execer, paths),
+		ttl:    ttl,
+	}
+}
+
+// Parse returns the cached inventory of the system or parses it if the cache is expired.
+// The uptime is always calculated for the moment of the call.
+func (c *cachedParser) Parse(ctx context.Context) (models.SystemInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.parsedAt.IsZero() || time.Since(c.parsedAt) > c.ttl {
+		info, err := c.parser.Parse(ctx)
+		if err != nil {
+			return models.SystemInfo{}, err
+		}
+		c.info = info
+		c.parsedAt = time.Now()
+	}
+
+	res := c.info
+	if !res.BootTime.IsZero() {
+		res.Uptime = time.Since(res.BootTime).Truncate(time.Second)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,93 @@
+package sysinfo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// parseForDarwin parses the hardware and OS inventory for Darwin.
+func (p *parser) parseForDarwin(ctx context.Context) (models.SystemInfo, error) {
+	res := models.SystemInfo{
+		Vendor: "Apple",
+	}
+	var err error
+
+	if res.Hostname, err = os.Hostname(); err != nil {
+		return models.SystemInfo{}, fmt.Errorf("failed to get hostname: %w", err)
+	}
+
+	if err = p.parseSysctlForDarwin(ctx, &res); err != nil {
+		return models.SystemInfo{}, err
+	}
+
+	cmdRes, err := p.execer.Exec(ctx, cmdDarwinSwVers)
+	if err != nil {
+		return models.SystemInfo{}, err
+	}
+	versions := make(map[string]string)
+	for _, line := range cmdRes.Lines() {
+		if key, value, ok := strings.Cut(line, ":"); ok {
+			versions[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+	res.Distro = strings.TrimSpace(versions["ProductName"] + " " + versions["ProductVersion"])
+
+	cmdRes, err = p.execer.Exec(ctx, cmdDarwinIoreg, argsDarwinIoreg...)
+	if err != nil {
+		return models.SystemInfo{}, err
+	}
+	for _, line := range cmdRes.Lines() {
+		// "IOPlatformUUID" = "2B9B3F1E-7C5A-5E5B-9C2D-0D9F4E1A7B3C"
+		if _, value, ok := strings.Cut(line, `"IOPlatformUUID" = `); ok {
+			res.MachineID = strings.Trim(value, `"`)
+			break
+		}
+	}
+
+	return res, nil
+}
+
+// parseSysctlForDarwin parses the kernel and hardware inventory for Darwin and fills the provided result struct.
+func (p *parser) parseSysctlForDarwin(ctx context.Context, res *models.SystemInfo) error {
+	cmdRes, err := p.execer.Exec(ctx, cmdDarwinSysctl, argsDarwinSysctl...)
+	if err != nil {
+		return err
+	}
+
+	lines := cmdRes.Lines()
+	if len(lines) < len(argsDarwinSysctl)-1 {
+		return metrics.ErrInvalidOutput
+	}
+
+	res.KernelRelease = lines[0]
+	res.CPUModel = lines[1]
+	ints := map[int]*int{2: &res.CPUSockets, 3: &res.CPUCores, 4: &res.CPUThreads}
+	for i, value := range ints {
+		if *value, err = strconv.Atoi(lines[i]); err != nil {
+			return fmt.Errorf("failed to parse %s: %w", argsDarwinSysctl[i+1], err)
+		}
+	}
+
+	memBytes, err := strconv.ParseUint(lines[5], 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse memory size: %w", err)
+	}
+	res.TotalMemoryMb = memBytes / 1024 / 1024
+
+	// { sec = 1727683215, usec = 409524 } Mon Sep 30 11:00:15 2024
+	var sec, usec int64
+	if _, err = fmt.Sscanf(lines[6], "{ sec = %d, usec = %d }", &sec, &usec); err != nil {
+		return fmt.Errorf("failed to parse boot time: %w", err)
+	}
+	res.BootTime = time.Unix(sec, 0)
+	res.Product = lines[7]
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package sysinfo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	fsUtils "github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// parseForLinux parses the hardware and OS inventory for Linux.
+func (p *parser) parseForLinux(_ context.Context) (models.SystemInfo, error) {
+	var res models.SystemInfo
+	var err error
+
+	if res.Hostname, err = p.readValue(filepath.Join(p.paths.Proc, "sys/kernel/hostname")); err != nil {
+		return models.SystemInfo{}, fmt.Errorf("failed to read hostname: %w", err)
+	}
+
+	if res.KernelRelease, err = p.readValue(filepath.Join(p.paths.Proc, "sys/kernel/osrelease")); err != nil {
+		return models.SystemInfo{}, fmt.Errorf("failed to read kernel release: %w", err)
+	}
+
+	if err = p.parseCPUInfo(&res); err != nil {
+		return models.SystemInfo{}, fmt.Errorf("failed to parse cpuinfo: %w", err)
+	}
+
+	if err = p.parseMemInfo(&res); err != nil {
+		return models.SystemInfo{}, fmt.Errorf("failed to parse meminfo: %w", err)
+	}
+
+	if err = p.parseBootTime(&res); err != nil {
+		return models.SystemInfo{}, fmt.Errorf("failed to parse boot time: %w", err)
+	}
+
+	// The files below are optional: containers may have no os-release or machine-id
+	// and DMI is not available on most of ARM boards and virtual machines
+	if res.Distro, err = p.parseDistro(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return models.SystemInfo{}, fmt.Errorf("failed to parse os-release: %w", err)
+	}
+	optional := map[string]*string{
+		filepath.Join(p.paths.Sys, "class/dmi/id/sys_vendor"):   &res.Vendor,
+		filepath.Join(p.paths.Sys, "class/dmi/id/product_name"): &res.Product,
+		filepath.Join(p.paths.Root, dirEtc, "machine-id"):       &res.MachineID,
+	}
+	for path, value := range optional {
+		if *value, err = p.readValue(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return models.SystemInfo{}, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+	}
+
+	return res, nil
+}
+
+// parseCPUInfo parses the CPU model and topology from /proc/cpuinfo and fills the provided result struct.
+func (p *parser) parseCPUInfo(res *models.SystemInfo) error {
+	lines, err := fsUtils.ReadLines(filepath.Join(p.paths.Proc, "cpuinfo"))
+	if err != nil {
+		return err
+	}
+
+	sockets := make(map[string]struct{})
+	cores := make(map[string]struct{})
+	var socket string
+	for _, line := range lines {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+
+		switch strings.TrimSpace(key) {
+		case "processor":
+			res.CPUThreads++
+		case "model name":
+			res.CPUModel = value
+		case "physical id":
+			socket = value
+			sockets[socket] = struct{}{}
+		case "core id":
+			cores[socket+"/"+value] = struct{}{}
+		}
+	}
+	if res.CPUThreads == 0 {
+		return metrics.ErrInvalidOutput
+	}
+
+	// Some architectures do not report the topology, so every thread is counted as the core of the single socket
+	res.CPUSockets = max(len(sockets), 1)
+	res.CPUCores = len(cores)
+	if res.CPUCores == 0 {
+		res.CPUCores = res.CPUThreads
+	}
+
+	return nil
+}
+
+// parseMemInfo parses the total memory from /proc/meminfo and fills the provided result struct.
+func (p *parser) parseMemInfo(res *models.SystemInfo) error {
+	lines, err := fsUtils.ReadLines(filepath.Join(p.paths.Proc, "meminfo"))
+	if err != nil {
+		return err
+	}
+
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "MemTotal:" {
+			continue
+		}
+
+		totalKb, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return err
+		}
+		res.TotalMemoryMb = totalKb / 1024
+
+		return nil
+	}
+
+	return metrics.ErrInvalidOutput
+}
+
+// parseBootTime parses the boot time from /proc/stat and fills the provided result struct.
+func (p *parser) parseBootTime(res *models.SystemInfo) error {
+	lines, err := fsUtils.ReadLines(filepath.Join(p.paths.Proc, "stat"))
+	if err != nil {
+		return err
+	}
+
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "btime" {
+			continue
+		}
+
+		btime, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return err
+		}
+		res.BootTime = time.Unix(btime, 0)
+
+		return nil
+	}
+
+	return metrics.ErrInvalidOutput
+}
+
+// parseDistro parses the distribution name from /etc/os-release.
+func (p *parser) parseDistro() (string, error) {
+	lines, err := fsUtils.ReadLines(filepath.Join(p.paths.Root, dirEtc, "os-release"))
+	if err != nil {
+		return "", err
+	}
+
+	values := make(map[string]string)
+	for _, line := range lines {
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		values[key] = strings.Trim(value, `"'`)
+	}
+
+	if name, ok := values["PRETTY_NAME"]; ok {
+		return name, nil
+	}
+
+	return strings.TrimSpace(values["NAME"] + " " + values["VERSION_ID"]), nil
+}
+
+// readValue reads the single line value from the file.
+func (p *parser) readValue(path string) (string, error) {
+	lines, err := fsUtils.ReadLines(path)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(lines[0]), nil
+}
@@ -0,0 +1,78 @@
+package sysinfo
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+var (
+	// dirEtc is the directory of the root filesystem with the system configuration files.
+	dirEtc = "etc"
+
+	// cmdDarwinSysctl is the command to get the kernel and hardware inventory on Darwin.
+	cmdDarwinSysctl = "sysctl"
+	// argsDarwinSysctl are the arguments to get the kernel and hardware inventory on Darwin.
+	// The values are printed line by line in the order of the keys.
+	argsDarwinSysctl = []string{
+		"-n",
+		"kern.osrelease",
+		"machdep.cpu.brand_string",
+		"hw.packages",
+		"hw.physicalcpu",
+		"hw.logicalcpu",
+		"hw.memsize",
+		"kern.boottime",
+		"hw.model",
+	}
+	// cmdDarwinSwVers is the command to get the macOS version.
+	cmdDarwinSwVers = "sw_vers"
+	// cmdDarwinIoreg is the command to get the platform UUID on Darwin.
+	cmdDarwinIoreg = "ioreg"
+	// argsDarwinIoreg are the arguments to get the platform UUID on Darwin.
+	argsDarwinIoreg = []string{"-rd1", "-c", "IOPlatformExpertDevice"}
+)
+
+// parser - struct to hold the parser dependencies.
+type parser struct {
+	execer cmd.Execer
+	paths  fs.Paths
+}
+
+// NewParser returns a new parser to parse the hardware and OS inventory.
+//
+//nolint:revive
+func NewParser(execer cmd.Execer, paths fs.Paths) *parser {
+	return &parser{
+		execer: execer,
+		paths:  paths,
+	}
+}
+
+// Parse parses the hardware and OS inventory of the system.
+func (p *parser) Parse(ctx context.Context) (models.SystemInfo, error) {
+	var res models.SystemInfo
+	var err error
+
+	switch p.execer.OS() {
+	case os.Darwin:
+		res, err = p.parseForDarwin(ctx)
+	case os.Linux:
+		res, err = p.parseForLinux(ctx)
+	default:
+		return models.SystemInfo{}, metrics.ErrUnsupportedOS
+	}
+	if err != nil {
+		return models.SystemInfo{}, err
+	}
+
+	res.OS = p.execer.OS()
+	res.Arch = runtime.GOARCH
+
+	return res, nil
+}
@@ -0,0 +1,219 @@
+package sysinfo
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/strings"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+//nolint:funlen
+func Test_parser_Parse(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		execerMockFunc func(t *testing.T) cmd.Execer
+		paths          fs.Paths
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.SystemInfo
+		wantErr bool
+	}{
+		{
+			name: "ok linux",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux)
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/proc", Sys: "testdata/sys", Root: "testdata"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.SystemInfo{
+				Hostname:      "db-01",
+				OS:            os.Linux,
+				Arch:          runtime.GOARCH,
+				KernelRelease: "6.8.0-45-generic",
+				Distro:        "Ubuntu 24.04.1 LTS",
+				CPUModel:      "Intel(R) Xeon(R) Gold 6230 CPU @ 2.10GHz",
+				CPUSockets:    2,
+				CPUCores:      4,
+				CPUThreads:    8,
+				TotalMemoryMb: 64300,
+				BootTime:      time.Unix(1727683215, 0),
+				Vendor:        "Dell Inc.",
+				Product:       "PowerEdge R640",
+				MachineID:     "3f1c0e8a9b2d4c6e8f0a1b2c3d4e5f60",
+			},
+		},
+		{
+			name: "ok linux without optional files",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux)
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/proc", Sys: "testdata/notexists", Root: "testdata/notexists"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.SystemInfo{
+				Hostname:      "db-01",
+				OS:            os.Linux,
+				Arch:          runtime.GOARCH,
+				KernelRelease: "6.8.0-45-generic",
+				CPUModel:      "Intel(R) Xeon(R) Gold 6230 CPU @ 2.10GHz",
+				CPUSockets:    2,
+				CPUCores:      4,
+				CPUThreads:    8,
+				TotalMemoryMb: 64300,
+				BootTime:      time.Unix(1727683215, 0),
+			},
+		},
+		{
+			name: "ok darwin",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						Exec(mock.Anything, cmdDarwinSysctl, strings.ToInterfaces(argsDarwinSysctl)...).
+						Return(&cmd.Result{
+							Bytes: []byte(
+								"23.6.0\n" +
+									"Apple M2 Pro\n" +
+									"1\n" +
+									"12\n" +
+									"12\n" +
+									"34359738368\n" +
+									"{ sec = 1727683215, usec = 409524 } Mon Sep 30 11:00:15 2024\n" +
+									"Mac14,10\n",
+							),
+						}, nil).
+						Once()
+
+					execer.EXPECT().
+						Exec(mock.Anything, cmdDarwinSwVers).
+						Return(&cmd.Result{
+							Bytes: []byte(
+								"ProductName:		macOS\n" +
+									"ProductVersion:		14.6.1\n" +
+									"BuildVersion:		23G93\n",
+							),
+						}, nil).
+						Once()
+
+					execer.EXPECT().
+						Exec(mock.Anything, cmdDarwinIoreg, strings.ToInterfaces(argsDarwinIoreg)...).
+						Return(&cmd.Result{
+							Bytes: []byte(
+								"+-o Mac14,10  <class IOPlatformExpertDevice, id 0x100000212, registered, matched, active>\n" +
+									"    {\n" +
+									"      \"IOPlatformSerialNumber\" = \"C02XXXXXXXXX\"\n" +
+									"      \"IOPlatformUUID\" = \"2B9B3F1E-7C5A-5E5B-9C2D-0D9F4E1A7B3C\"\n" +
+									"    }\n",
+							),
+						}, nil).
+						Once()
+
+					execer.EXPECT().
+						OS().
+						Return(os.Darwin)
+
+					return execer
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.SystemInfo{
+				OS:            os.Darwin,
+				Arch:          runtime.GOARCH,
+				KernelRelease: "23.6.0",
+				Distro:        "macOS 14.6.1",
+				CPUModel:      "Apple M2 Pro",
+				CPUSockets:    1,
+				CPUCores:      12,
+				CPUThreads:    12,
+				TotalMemoryMb: 32768,
+				BootTime:      time.Unix(1727683215, 0),
+				Vendor:        "Apple",
+				Product:       "Mac14,10",
+				MachineID:     "2B9B3F1E-7C5A-5E5B-9C2D-0D9F4E1A7B3C",
+			},
+		},
+		{
+			name: "err windows unsupported",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Windows)
+
+					return execer
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &parser{
+				execer: tt.fields.execerMockFunc(t),
+				paths:  tt.fields.paths,
+			}
+			got, err := p.Parse(tt.args.ctx)
+			// The hostname of Darwin is taken from the host running the test
+			if got.OS == os.Darwin {
+				got.Hostname = ""
+			}
+
+			require.Equalf(t, tt.wantErr, err != nil, "error = %v", err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
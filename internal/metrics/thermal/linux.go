@@ -0,0 +1,285 @@
+package thermal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	fsUtils "github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+var (
+	// reCPUDir matches the directory names of the CPUs like cpu12.
+	reCPUDir = regexp.MustCompile(`^cpu(\d+)$`)
+	// reTempInput matches the names of the hwmon temperature files like temp1_input.
+	reTempInput = regexp.MustCompile(`^temp(\d+)_input$`)
+	// reTripPointType matches the names of the thermal zone trip point types like trip_point_0_type.
+	reTripPointType = regexp.MustCompile(`^trip_point_(\d+)_type$`)
+)
+
+// parseForLinux parses the CPU frequency, throttling and temperatures for Linux.
+// Any of the sources may be absent, e.g. on virtual machines, so they are parsed independently.
+func (p *parser) parseForLinux(_ context.Context) (models.ThermalStats, error) {
+	var res models.ThermalStats
+	var err error
+
+	if res.CPUs, err = p.parseCPUs(); err != nil {
+		return models.ThermalStats{}, fmt.Errorf("failed to parse cpufreq: %w", err)
+	}
+
+	hwmon, err := p.parseHwmon()
+	if err != nil {
+		return models.ThermalStats{}, fmt.Errorf("failed to parse hwmon: %w", err)
+	}
+
+	zones, err := p.parseThermalZones()
+	if err != nil {
+		return models.ThermalStats{}, fmt.Errorf("failed to parse thermal zones: %w", err)
+	}
+	res.Sensors = append(hwmon, zones...)
+
+	return res, nil
+}
+
+// parseCPUs parses the frequency and the throttle counters of every CPU.
+func (p *parser) parseCPUs() ([]models.CPUFreq, error) {
+	dir := filepath.Join(p.paths.Sys, dirCPUs)
+	entries, err := readDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]models.CPUFreq, 0, len(entries))
+	for _, entry := range entries {
+		match := reCPUDir.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		cpu, _ := strconv.Atoi(match[1])
+		cpuDir := filepath.Join(dir, entry.Name())
+
+		freq := models.CPUFreq{CPU: cpu}
+		values := map[string]*float64{
+			"cpufreq/scaling_cur_freq": &freq.CurMHz,
+			"cpufreq/cpuinfo_min_freq": &freq.MinMHz,
+			"cpufreq/cpuinfo_max_freq": &freq.MaxMHz,
+		}
+		for file, value := range values {
+			kHz, err := readInt(filepath.Join(cpuDir, file))
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return nil, err
+			}
+			*value = float64(kHz) / 1000
+		}
+
+		counters := map[string]*uint64{
+			"thermal_throttle/core_throttle_count":    &freq.CoreThrottles,
+			"thermal_throttle/package_throttle_count": &freq.PackageThrottles,
+		}
+		for file, value := range counters {
+			n, err := readInt(filepath.Join(cpuDir, file))
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return nil, err
+			}
+			*value = uint64(max(n, 0))
+		}
+
+		res = append(res, freq)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].CPU < res[j].CPU
+	})
+
+	return res, nil
+}
+
+// parseHwmon parses the temperature sensors of the hardware monitoring chips.
+func (p *parser) parseHwmon() ([]models.Sensor, error) {
+	dir := filepath.Join(p.paths.Sys, dirHwmon)
+	entries, err := readDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []models.Sensor
+	for _, entry := range entries {
+		chipDir := filepath.Join(dir, entry.Name())
+		chip, err := readString(filepath.Join(chipDir, "name"))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+
+		sensors, err := p.parseHwmonTemps(chipDir, chip)
+		if err != nil {
+			return nil, err
+		}
+		// The older kernels keep the sensor files in the device directory
+		if len(sensors) == 0 {
+			if sensors, err = p.parseHwmonTemps(filepath.Join(chipDir, "device"), chip); err != nil {
+				return nil, err
+			}
+		}
+		res = append(res, sensors...)
+	}
+
+	return res, nil
+}
+
+// parseHwmonTemps parses the temperature sensors from the hwmon chip directory.
+func (p *parser) parseHwmonTemps(dir, chip string) ([]models.Sensor, error) {
+	entries, err := readDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []models.Sensor
+	for _, entry := range entries {
+		match := reTempInput.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		prefix := filepath.Join(dir, "temp"+match[1])
+
+		milli, err := readInt(prefix + "_input")
+		if err != nil {
+			// The sensor may be present but fail to read, e.g. when the device is powered off
+			continue
+		}
+		sensor := models.Sensor{
+			Source: models.SensorSourceHwmon,
+			Chip:   chip,
+			Label:  "temp" + match[1],
+			TempC:  float64(milli) / 1000,
+		}
+
+		label, err := readString(prefix + "_label")
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		if label != "" {
+			sensor.Label = label
+		}
+
+		crit, err := readInt(prefix + "_crit")
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		sensor.CritC = float64(crit) / 1000
+
+		res = append(res, sensor)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Label < res[j].Label
+	})
+
+	return res, nil
+}
+
+// parseThermalZones parses the temperatures of the thermal zones with their critical trip points.
+func (p *parser) parseThermalZones() ([]models.Sensor, error) {
+	dir := filepath.Join(p.paths.Sys, dirThermal)
+	entries, err := readDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []models.Sensor
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), "thermal_zone") {
+			continue
+		}
+		zoneDir := filepath.Join(dir, entry.Name())
+
+		milli, err := readInt(filepath.Join(zoneDir, "temp"))
+		if err != nil {
+			continue
+		}
+		zoneType, err := readString(filepath.Join(zoneDir, "type"))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		sensor := models.Sensor{
+			Source: models.SensorSourceThermal,
+			Chip:   zoneType,
+			Label:  entry.Name(),
+			TempC:  float64(milli) / 1000,
+		}
+
+		if sensor.CritC, err = p.parseCriticalTripPoint(zoneDir); err != nil {
+			return nil, err
+		}
+
+		res = append(res, sensor)
+	}
+
+	return res, nil
+}
+
+// parseCriticalTripPoint parses the temperature of the critical trip point of the thermal zone.
+func (p *parser) parseCriticalTripPoint(zoneDir string) (float64, error) {
+	entries, err := readDir(zoneDir)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, entry := range entries {
+		match := reTripPointType.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		tripType, err := readString(filepath.Join(zoneDir, entry.Name()))
+		if err != nil || tripType != "critical" {
+			continue
+		}
+
+		milli, err := readInt(filepath.Join(zoneDir, "trip_point_"+match[1]+"_temp"))
+		if err != nil {
+			return 0, err
+		}
+
+		return float64(milli) / 1000, nil
+	}
+
+	return 0, nil
+}
+
+// readDir reads the directory entries and returns no entries if the directory does not exist.
+func readDir(dir string) ([]os.DirEntry, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	return entries, err
+}
+
+// readString reads the single line value from the sysfs file.
+func readString(path string) (string, error) {
+	lines, err := fsUtils.ReadLines(path)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(lines[0]), nil
+}
+
+// readInt reads the single integer value from the sysfs file.
+func readInt(path string) (int64, error) {
+	s, err := readString(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(s, 10, 64)
+}
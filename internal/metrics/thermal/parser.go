@@ -0,0 +1,45 @@
+package thermal
+
+import (
+	"context"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+var (
+	// dirCPUs is the sysfs directory with the CPUs frequency and throttling.
+	dirCPUs = "devices/system/cpu"
+	// dirHwmon is the sysfs directory with the hardware monitoring sensors.
+	dirHwmon = "class/hwmon"
+	// dirThermal is the sysfs directory with the thermal zones.
+	dirThermal = "class/thermal"
+)
+
+// parser - struct to hold the parser dependencies.
+type parser struct {
+	execer cmd.Execer
+	paths  fs.Paths
+}
+
+// NewParser returns a new parser to parse the CPU frequency, throttling and temperatures.
+//
+//nolint:revive
+func NewParser(execer cmd.Execer, paths fs.Paths) *parser {
+	return &parser{
+		execer: execer,
+		paths:  paths,
+	}
+}
+
+// Parse parses the CPU frequency, throttling and temperatures of the system.
+func (p *parser) Parse(ctx context.Context) (models.ThermalStats, error) {
+	if p.execer.OS() == os.Linux {
+		return p.parseForLinux(ctx)
+	}
+
+	return models.ThermalStats{}, metrics.ErrUnsupportedOS
+}
@@ -0,0 +1,126 @@
+package thermal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+func Test_parser_Parse(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		execerMockFunc func(t *testing.T) cmd.Execer
+		paths          fs.Paths
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.ThermalStats
+		wantErr bool
+	}{
+		{
+			name: "ok linux",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Sys: "testdata/sys"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.ThermalStats{
+				CPUs: []models.CPUFreq{
+					{CPU: 0, CurMHz: 3600, MinMHz: 800, MaxMHz: 3900, CoreThrottles: 12, PackageThrottles: 3},
+					{CPU: 1, CurMHz: 1200, MinMHz: 800, MaxMHz: 3900, PackageThrottles: 3},
+				},
+				Sensors: []models.Sensor{
+					{Source: models.SensorSourceHwmon, Chip: "coretemp", Label: "Core 0", TempC: 68, CritC: 100},
+					{Source: models.SensorSourceHwmon, Chip: "coretemp", Label: "Package id 0", TempC: 71, CritC: 100},
+					{Source: models.SensorSourceHwmon, Chip: "acpitz", Label: "temp1", TempC: 27.8},
+					{Source: models.SensorSourceThermal, Chip: "x86_pkg_temp", Label: "thermal_zone0", TempC: 71, CritC: 105},
+				},
+			},
+		},
+		{
+			name: "ok linux virtual machine without sensors",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Sys: "testdata/notexists"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.ThermalStats{
+				CPUs: []models.CPUFreq{},
+			},
+		},
+		{
+			name: "err darwin unsupported",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Darwin).
+						Once()
+
+					return execer
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &parser{
+				execer: tt.fields.execerMockFunc(t),
+				paths:  tt.fields.paths,
+			}
+			got, err := p.Parse(tt.args.ctx)
+
+			require.Equalf(t, tt.wantErr, err != nil, "error = %v", err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
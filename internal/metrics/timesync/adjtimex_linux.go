@@ -0,0 +1,26 @@
+//go:build linux
+
+package timesync
+
+import (
+	"syscall"
+)
+
+// adjtimex reads the kernel clock discipline state without modifying it.
+func adjtimex() (timex, error) {
+	var tx syscall.Timex
+	state, err := syscall.Adjtimex(&tx)
+	if err != nil {
+		return timex{}, err
+	}
+
+	return timex{
+		offset:   int64(tx.Offset),
+		freq:     int64(tx.Freq),
+		maxError: int64(tx.Maxerror),
+		estError: int64(tx.Esterror),
+		status:   tx.Status,
+		tai:      tx.Tai,
+		state:    state,
+	}, nil
+}
@@ -0,0 +1,12 @@
+//go:build !linux
+
+package timesync
+
+import (
+	"github.com/sitnikovik/sysmon/internal/metrics"
+)
+
+// adjtimex is not available on the platforms other than Linux.
+func adjtimex() (timex, error) {
+	return timex{}, metrics.ErrUnsupportedOS
+}
@@ -0,0 +1,64 @@
+package timesync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+const (
+	// staUnsync is the status flag set when the clock is not synchronised.
+	staUnsync = 0x0040
+	// staNano is the status flag set when the offset is in nanoseconds instead of microseconds.
+	staNano = 0x2000
+
+	// timeError is the clock state when the clock is not synchronised.
+	timeError = 5
+
+	// freqScale is the scale of the frequency adjustment with 16-bit fractional part.
+	freqScale = 1 << 16
+)
+
+// clockStates maps the clock states returned by adjtimex to their names.
+var clockStates = map[int]string{
+	0:         "ok",
+	1:         "insert leap second",
+	2:         "delete leap second",
+	3:         "leap second in progress",
+	4:         "leap second occurred",
+	timeError: "error",
+}
+
+// parseForLinux parses the time synchronisation status for Linux.
+func (p *parser) parseForLinux(_ context.Context) (models.TimeSyncStats, error) {
+	tx, err := p.adjtimex()
+	if err != nil {
+		return models.TimeSyncStats{}, fmt.Errorf("failed to call adjtimex: %w", err)
+	}
+
+	return calcStats(tx), nil
+}
+
+// calcStats converts the clock discipline state to the statistics.
+func calcStats(tx timex) models.TimeSyncStats {
+	offsetMs := float64(tx.offset) / 1e3
+	if tx.status&staNano != 0 {
+		offsetMs = float64(tx.offset) / 1e6
+	}
+
+	state, ok := clockStates[tx.state]
+	if !ok {
+		state = fmt.Sprintf("unknown (%d)", tx.state)
+	}
+
+	return models.TimeSyncStats{
+		Synchronised: tx.status&staUnsync == 0 && tx.state != timeError,
+		State:        state,
+		OffsetMs:     offsetMs,
+		MaxErrorMs:   float64(tx.maxError) / 1e3,
+		EstErrorMs:   float64(tx.estError) / 1e3,
+		FreqPPM:      float64(tx.freq) / freqScale,
+		TAIOffsetSec: int(tx.tai),
+	}
+}
@@ -0,0 +1,53 @@
+package timesync
+
+import (
+	"context"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// timex holds the kernel clock discipline state returned by the adjtimex syscall.
+type timex struct {
+	// offset is the clock offset in microseconds or nanoseconds if staNano is set.
+	offset int64
+	// freq is the frequency adjustment in ppm with 16-bit fractional part.
+	freq int64
+	// maxError is the maximum error in microseconds.
+	maxError int64
+	// estError is the estimated error in microseconds.
+	estError int64
+	// status is the clock status flags.
+	status int32
+	// tai is the offset of TAI from UTC in seconds.
+	tai int32
+	// state is the clock state returned by the syscall.
+	state int
+}
+
+// parser - struct to hold the parser dependencies.
+type parser struct {
+	execer   cmd.Execer
+	adjtimex func() (timex, error)
+}
+
+// NewParser returns a new parser to parse the time synchronisation status.
+//
+//nolint:revive
+func NewParser(execer cmd.Execer) *parser {
+	return &parser{
+		execer:   execer,
+		adjtimex: adjtimex,
+	}
+}
+
+// Parse parses the time synchronisation status of the system clock.
+func (p *parser) Parse(ctx context.Context) (models.TimeSyncStats, error) {
+	if p.execer.OS() == os.Linux {
+		return p.parseForLinux(ctx)
+	}
+
+	return models.TimeSyncStats{}, metrics.ErrUnsupportedOS
+}
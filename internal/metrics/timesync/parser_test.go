@@ -0,0 +1,167 @@
+package timesync
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+func Test_parser_Parse(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		execerMockFunc func(t *testing.T) cmd.Execer
+		adjtimex       func() (timex, error)
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.TimeSyncStats
+		wantErr bool
+	}{
+		{
+			name: "ok linux synchronised",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				adjtimex: func() (timex, error) {
+					return timex{
+						offset:   -1250,
+						freq:     -1062208,
+						maxError: 48500,
+						estError: 120,
+						status:   0x0001,
+						tai:      37,
+					}, nil
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.TimeSyncStats{
+				Synchronised: true,
+				State:        "ok",
+				OffsetMs:     -1.25,
+				MaxErrorMs:   48.5,
+				EstErrorMs:   0.12,
+				FreqPPM:      -16.2080078125,
+				TAIOffsetSec: 37,
+			},
+		},
+		{
+			name: "ok linux unsynchronised with nanoseconds offset",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				adjtimex: func() (timex, error) {
+					return timex{
+						offset:   2500000,
+						maxError: 16000000,
+						estError: 16000000,
+						status:   staUnsync | staNano,
+						state:    timeError,
+					}, nil
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.TimeSyncStats{
+				State:      "error",
+				OffsetMs:   2.5,
+				MaxErrorMs: 16000,
+				EstErrorMs: 16000,
+			},
+		},
+		{
+			name: "err adjtimex failed",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				adjtimex: func() (timex, error) {
+					return timex{}, errors.New("operation not permitted")
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "err darwin unsupported",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Darwin).
+						Once()
+
+					return execer
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &parser{
+				execer:   tt.fields.execerMockFunc(t),
+				adjtimex: tt.fields.adjtimex,
+			}
+			got, err := p.Parse(tt.args.ctx)
+
+			require.Equalf(t, tt.wantErr, err != nil, "error = %v", err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// tracker is the tracker of the health of the metrics collectors by their collections.
+type tracker struct {
+	mu       sync.Mutex
+	statuses []models.CollectorStatus
+	byName   map[string]int
+}
+
+// NewTracker returns a new instance of the tracker of the collectors health.
+//
+//nolint:revive
+func NewTracker() *tracker {
+	return &tracker{
+		byName: make(map[string]int),
+	}
+}
+
+// Observe records the collection of the collector finished at the time.
+// The collection lasted for the duration and produced the samples or failed with the error.
+// The collection warming up or of the metric not available is not a failure
+// since the collector is working as expected.
+func (t *tracker) Observe(name string, at time.Time, duration time.Duration, samples int, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	i, ok := t.byName[name]
+	if !ok {
+		i = len(t.statuses)
+		t.byName[name] = i
+		t.statuses = append(t.statuses, models.CollectorStatus{Name: name})
+	}
+	s := &t.statuses[i]
+
+	s.Collections++
+	s.LastDuration = duration
+	s.Duration.Observe(duration)
+
+	switch {
+	case err == nil:
+		s.ConsecutiveFailures = 0
+		s.LastSuccess = at
+		s.LastSamples = samples
+		s.Samples += uint64(samples)
+		s.Stale = false
+	case errors.Is(err, ErrWarmingUp), errors.Is(err, ErrNotAvailable):
+		s.ConsecutiveFailures = 0
+	default:
+		s.Failures++
+		s.ConsecutiveFailures++
+		s.LastError = err.Error()
+		s.LastErrorTime = at
+		// The metric of the last successful collection is kept
+		s.Stale = !s.LastSuccess.IsZero()
+	}
+}
+
+// List returns the health of the collectors in the order they were first observed.
+func (t *tracker) List() models.CollectorsStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	res := make(models.CollectorsStatus, len(t.statuses))
+	for i, s := range t.statuses {
+		s.Duration.Counts = slices.Clone(s.Duration.Counts)
+		res[i] = s
+	}
+
+	return res
+}
@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+func Test_tracker_Observe(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	tr := NewTracker()
+
+	tr.Observe("cpu", now, 2*time.Millisecond, 0, ErrWarmingUp)
+	tr.Observe("disk", now, 20*time.Millisecond, 12, nil)
+	tr.Observe("cpu", now.Add(time.Second), 3*time.Millisecond, 5, nil)
+	tr.Observe("disk", now.Add(time.Second), 11*time.Second, 0, ErrTimeout)
+	tr.Observe("disk", now.Add(2*time.Second), 30*time.Millisecond, 0, errors.New("exit status 1"))
+
+	got := tr.List()
+	require.Len(t, got, 2)
+
+	cpu := got[0]
+	require.Equal(t, "cpu", cpu.Name)
+	require.Equal(t, uint64(2), cpu.Collections)
+	require.Zero(t, cpu.Failures)
+	require.False(t, cpu.Failing())
+	require.False(t, cpu.Stale)
+	require.Equal(t, now.Add(time.Second), cpu.LastSuccess)
+	require.Equal(t, 5, cpu.LastSamples)
+	require.Equal(t, uint64(5), cpu.Samples)
+	require.Equal(t, uint64(2), cpu.Duration.Counts[1])
+	require.Equal(t, 5*time.Millisecond, cpu.Duration.Sum)
+
+	disk := got[1]
+	require.Equal(t, "disk", disk.Name)
+	require.Equal(t, uint64(3), disk.Collections)
+	require.Equal(t, uint64(2), disk.Failures)
+	require.Equal(t, uint64(2), disk.ConsecutiveFailures)
+	require.True(t, disk.Failing())
+	require.True(t, disk.Stale)
+	require.Equal(t, now, disk.LastSuccess)
+	require.Equal(t, "exit status 1", disk.LastError)
+	require.Equal(t, now.Add(2*time.Second), disk.LastErrorTime)
+	require.Equal(t, 30*time.Millisecond, disk.LastDuration)
+	require.Equal(t, uint64(12), disk.Samples)
+	require.Equal(t, []uint64{0, 0, 0, 2, 0, 0, 0, 0, 0, 1}, disk.Duration.Counts)
+	require.Equal(t, uint64(3), disk.Duration.Count)
+
+	// The failing collector recovers
+	tr.Observe("disk", now.Add(3*time.Second), 20*time.Millisecond, 12, nil)
+	disk = tr.List()[1]
+	require.False(t, disk.Failing())
+	require.False(t, disk.Stale)
+	require.Equal(t, models.DurationHistogram{
+		Counts: []uint64{0, 0, 0, 3, 0, 0, 0, 0, 0, 1},
+		Sum:    11*time.Second + 70*time.Millisecond,
+		Count:  4,
+	}, disk.Duration)
+}
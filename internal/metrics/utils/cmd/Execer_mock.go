@@ -2,7 +2,11 @@
 
 package cmd
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockExecer is an autogenerated mock type for the Execer type
 type MockExecer struct {
@@ -17,14 +21,14 @@ func (_m *MockExecer) EXPECT() *MockExecer_Expecter {
 	return &MockExecer_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function with given fields: cmd, args
-func (_m *MockExecer) Exec(cmd string, args ...string) (*Result, error) {
+// Exec provides a mock function with given fields: ctx, cmd, args
+func (_m *MockExecer) Exec(ctx context.Context, cmd string, args ...string) (*Result, error) {
 	_va := make([]interface{}, len(args))
 	for _i := range args {
 		_va[_i] = args[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, cmd)
+	_ca = append(_ca, ctx, cmd)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
@@ -34,19 +38,19 @@ func (_m *MockExecer) Exec(cmd string, args ...string) (*Result, error) {
 
 	var r0 *Result
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, ...string) (*Result, error)); ok {
-		return rf(cmd, args...)
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...string) (*Result, error)); ok {
+		return rf(ctx, cmd, args...)
 	}
-	if rf, ok := ret.Get(0).(func(string, ...string) *Result); ok {
-		r0 = rf(cmd, args...)
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...string) *Result); ok {
+		r0 = rf(ctx, cmd, args...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*Result)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, ...string) error); ok {
-		r1 = rf(cmd, args...)
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...string) error); ok {
+		r1 = rf(ctx, cmd, args...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -60,22 +64,23 @@ type MockExecer_Exec_Call struct {
 }
 
 // Exec is a helper method to define mock.On call
+//   - ctx context.Context
 //   - cmd string
 //   - args ...string
-func (_e *MockExecer_Expecter) Exec(cmd interface{}, args ...interface{}) *MockExecer_Exec_Call {
+func (_e *MockExecer_Expecter) Exec(ctx interface{}, cmd interface{}, args ...interface{}) *MockExecer_Exec_Call {
 	return &MockExecer_Exec_Call{Call: _e.mock.On("Exec",
-		append([]interface{}{cmd}, args...)...)}
+		append([]interface{}{ctx, cmd}, args...)...)}
 }
 
-func (_c *MockExecer_Exec_Call) Run(run func(cmd string, args ...string)) *MockExecer_Exec_Call {
+func (_c *MockExecer_Exec_Call) Run(run func(ctx context.Context, cmd string, args ...string)) *MockExecer_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]string, len(args)-1)
-		for i, a := range args[1:] {
+		variadicArgs := make([]string, len(args)-2)
+		for i, a := range args[2:] {
 			if a != nil {
 				variadicArgs[i] = a.(string)
 			}
 		}
-		run(args[0].(string), variadicArgs...)
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
 	})
 	return _c
 }
@@ -85,7 +90,7 @@ func (_c *MockExecer_Exec_Call) Return(_a0 *Result, _a1 error) *MockExecer_Exec_
 	return _c
 }
 
-func (_c *MockExecer_Exec_Call) RunAndReturn(run func(string, ...string) (*Result, error)) *MockExecer_Exec_Call {
+func (_c *MockExecer_Exec_Call) RunAndReturn(run func(context.Context, string, ...string) (*Result, error)) *MockExecer_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1,14 +1,21 @@
 package cmd
 
 import (
+	"context"
 	"os/exec"
 	"runtime"
+	"time"
 )
 
+// waitDelay is the time to wait for the output pipes to be closed after the command is killed,
+// since they may be held open by its orphaned children.
+const waitDelay = time.Second
+
 // Execer represents an struct to execute commands.
 type Execer interface {
 	// Exec runs a command and returns its result.
-	Exec(cmd string, args ...string) (*Result, error)
+	// The command and its children are killed when the context is done.
+	Exec(ctx context.Context, cmd string, args ...string) (*Result, error)
 	// OS returns current operating system name.
 	OS() string
 }
@@ -22,15 +29,30 @@ func NewExecer() Execer {
 }
 
 // Exec runs a command and returns its output as a byte slice.
-func (r *execer) Exec(cmd string, args ...string) (*Result, error) {
-	bb, err := exec.Command(cmd, args...).Output()
+func (r *execer) Exec(ctx context.Context, cmd string, args ...string) (*Result, error) {
+	c := Command(ctx, cmd, args...)
+
+	bb, err := c.Output()
 	if err != nil {
+		// Report the deadline instead of the "signal: killed" of the command
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
 		return nil, err
 	}
 
 	return &Result{Bytes: bb}, nil
 }
 
+// Command returns the command killed with its children when the context is done.
+func Command(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+	c := exec.CommandContext(ctx, cmd, args...)
+	c.WaitDelay = waitDelay
+	killProcessGroup(c)
+
+	return c
+}
+
 // OS returns current operating system name.
 func (r *execer) OS() string {
 	return runtime.GOOS
@@ -0,0 +1,55 @@
+//go:build unix
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_execer_Exec(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		cmd     string
+		args    []string
+		want    *Result
+		wantErr error
+	}{
+		{
+			name:    "ok",
+			timeout: time.Second,
+			cmd:     "echo",
+			args:    []string{"ok"},
+			want:    &Result{Bytes: []byte("ok\n")},
+		},
+		{
+			name:    "err deadline with the children holding the output",
+			timeout: 100 * time.Millisecond,
+			cmd:     "sh",
+			args:    []string{"-c", "sleep 10 & sleep 10"},
+			wantErr: context.DeadlineExceeded,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(context.Background(), tt.timeout)
+			defer cancel()
+
+			start := time.Now()
+			got, err := NewExecer().Exec(ctx, tt.cmd, tt.args...)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+			require.Less(t, time.Since(start), 2*time.Second)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !unix
+
+package cmd
+
+import "os/exec"
+
+// killProcessGroup does nothing since the process groups are supported on Unix only,
+// so the context cancellation kills the command process only.
+func killProcessGroup(_ *exec.Cmd) {}
@@ -0,0 +1,38 @@
+package fs
+
+import (
+	"os"
+	"strings"
+)
+
+// Paths holds the root paths of the pseudo filesystems the metrics are read from.
+type Paths struct {
+	// Proc is the path the procfs is mounted at.
+	Proc string
+	// Sys is the path the sysfs is mounted at.
+	Sys string
+	// Run is the path of the runtime state directory like /run with the utmp file.
+	Run string
+	// Root is the path the root filesystem is mounted at with the configuration files like /etc/os-release.
+	Root string
+}
+
+// DefaultPaths returns the paths the pseudo filesystems are mounted at on the host.
+func DefaultPaths() Paths {
+	return Paths{
+		Proc: "/proc",
+		Sys:  "/sys",
+		Run:  "/run",
+		Root: "/",
+	}
+}
+
+// ReadLines reads the file and returns its content as a slice of strings.
+func ReadLines(path string) ([]string, error) {
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Split(strings.TrimRight(string(bb), "\n"), "\n"), nil
+}
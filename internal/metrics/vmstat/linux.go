@@ -0,0 +1,73 @@
+package vmstat
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/sampler"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// counters holds the values of the virtual memory counters by their names.
+type counters map[string]uint64
+
+// parseForLinux parses the paging and swapping activity for Linux.
+func (p *parser) parseForLinux(_ context.Context) (models.VMStatStats, error) {
+	lines, err := fs.ReadLines(filepath.Join(p.paths.Proc, fileVMStat))
+	if err != nil {
+		return models.VMStatStats{}, err
+	}
+
+	cur, err := parseCounters(lines)
+	if err != nil {
+		return models.VMStatStats{}, err
+	}
+
+	prev, seconds, err := p.sampler.Next(cur)
+	if err != nil {
+		return models.VMStatStats{}, err
+	}
+
+	return calcStats(prev, cur, seconds), nil
+}
+
+// parseCounters parses the content of /proc/vmstat with the counter name and value on every line.
+func parseCounters(lines []string) (counters, error) {
+	res := make(counters, len(lines))
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, metrics.ErrInvalidOutput
+		}
+
+		n, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", fields[0], err)
+		}
+		res[fields[0]] = n
+	}
+
+	return res, nil
+}
+
+// calcStats calculates the statistics by two snapshots of the counters.
+// The pgpgin and pgpgout counters are in kilobytes regardless of the page size.
+func calcStats(prev, cur counters, seconds float64) models.VMStatStats {
+	rate := func(name string) float64 {
+		return sampler.Rate(prev[name], cur[name], sampler.NativeBits, seconds)
+	}
+
+	return models.VMStatStats{
+		PageFaults:  rate("pgfault"),
+		MajorFaults: rate("pgmajfault"),
+		SwapIns:     rate("pswpin"),
+		SwapOuts:    rate("pswpout"),
+		PagedInKb:   rate("pgpgin"),
+		PagedOutKb:  rate("pgpgout"),
+	}
+}
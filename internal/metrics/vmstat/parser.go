@@ -0,0 +1,50 @@
+package vmstat
+
+import (
+	"context"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/sampler"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+var (
+	// fileVMStat is the procfs file with the virtual memory counters.
+	fileVMStat = "vmstat"
+)
+
+// parser - struct to hold the parser dependencies.
+type parser struct {
+	execer  cmd.Execer
+	paths   fs.Paths
+	sampler *sampler.Sampler[counters]
+}
+
+// NewParser returns a new parser to parse the virtual memory statistics.
+//
+//nolint:revive
+func NewParser(execer cmd.Execer, paths fs.Paths) *parser {
+	return &parser{
+		execer:  execer,
+		paths:   paths,
+		sampler: sampler.NewSampler[counters](),
+	}
+}
+
+// Parse parses the paging and swapping activity of the system.
+// The rates are calculated since the previous call, so the first one returns metrics.ErrWarmingUp.
+func (p *parser) Parse(ctx context.Context) (models.VMStatStats, error) {
+	if p.execer.OS() == os.Linux {
+		return p.parseForLinux(ctx)
+	}
+
+	return models.VMStatStats{}, metrics.ErrUnsupportedOS
+}
+
+// Reset forgets the previous counters, so the rates are calculated since the next call.
+func (p *parser) Reset() {
+	p.sampler.Reset()
+}
@@ -0,0 +1,169 @@
+package vmstat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/sampler"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+func Test_parser_Parse(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		execerMockFunc func(t *testing.T) cmd.Execer
+		paths          fs.Paths
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		warm    bool
+		fields  fields
+		args    args
+		want    models.VMStatStats
+		wantErr error
+	}{
+		{
+			name: "ok linux",
+			warm: true,
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Twice()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.VMStatStats{},
+		},
+		{
+			name: "err warming up",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: metrics.ErrWarmingUp,
+		},
+		{
+			name: "err darwin unsupported",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Darwin).
+						Once()
+
+					return execer
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: metrics.ErrUnsupportedOS,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &parser{
+				execer:  tt.fields.execerMockFunc(t),
+				paths:   tt.fields.paths,
+				sampler: sampler.NewSampler[counters](),
+			}
+			if tt.warm {
+				_, err := p.Parse(tt.args.ctx)
+				require.ErrorIs(t, err, metrics.ErrWarmingUp)
+			}
+			got, err := p.Parse(tt.args.ctx)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseCounters(t *testing.T) {
+	t.Parallel()
+
+	lines, err := fs.ReadLines("testdata/vmstat")
+	require.NoError(t, err)
+
+	got, err := parseCounters(lines)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1827364512), got["pgfault"])
+	require.Equal(t, uint64(48213), got["pgmajfault"])
+	require.Len(t, got, 11)
+
+	_, err = parseCounters([]string{"pgfault"})
+	require.ErrorIs(t, err, metrics.ErrInvalidOutput)
+	_, err = parseCounters([]string{"pgfault x"})
+	require.Error(t, err)
+}
+
+func Test_calcStats(t *testing.T) {
+	t.Parallel()
+
+	prev := counters{
+		"pgfault":    1000,
+		"pgmajfault": 10,
+		"pswpin":     100,
+		"pswpout":    200,
+		"pgpgin":     4000,
+		"pgpgout":    8000,
+	}
+	cur := counters{
+		"pgfault":    3000,
+		"pgmajfault": 14,
+		"pswpin":     100,
+		"pswpout":    260,
+		"pgpgin":     4400,
+		"pgpgout":    9000,
+	}
+
+	require.Equal(t, models.VMStatStats{
+		PageFaults:  1000,
+		MajorFaults: 2,
+		SwapOuts:    30,
+		PagedInKb:   200,
+		PagedOutKb:  500,
+	}, calcStats(prev, cur, 2))
+}
@@ -0,0 +1,94 @@
+package zfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/sampler"
+	fsUtils "github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// kstats holds the values of the ARC kstats by their names.
+type kstats map[string]uint64
+
+// parseForLinux parses the ZFS ARC statistics for Linux.
+func (p *parser) parseForLinux(_ context.Context) (models.ZFSArcStats, error) {
+	cur, err := p.readKstats()
+	if err != nil {
+		return models.ZFSArcStats{}, err
+	}
+
+	prev, seconds, err := p.sampler.Next(cur)
+	if err != nil {
+		return models.ZFSArcStats{}, err
+	}
+
+	return calcStats(prev, cur, seconds), nil
+}
+
+// readKstats reads the ARC kstats.
+func (p *parser) readKstats() (kstats, error) {
+	lines, err := fsUtils.ReadLines(filepath.Join(p.paths.Proc, fileARCStats))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, metrics.ErrNotAvailable
+		}
+		return nil, err
+	}
+
+	return parseKstats(lines)
+}
+
+// parseKstats parses the content of the kstat file.
+// The first line is the kstat header and the second one names the columns "name type data".
+func parseKstats(lines []string) (kstats, error) {
+	if len(lines) < 2 {
+		return nil, metrics.ErrInvalidOutput
+	}
+
+	res := make(kstats, len(lines)-2)
+	for _, line := range lines[2:] {
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil, metrics.ErrInvalidOutput
+		}
+
+		n, err := strconv.ParseUint(fields[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", fields[0], err)
+		}
+		res[fields[0]] = n
+	}
+
+	return res, nil
+}
+
+// calcStats calculates the statistics by two snapshots of the kstats.
+func calcStats(prev, cur kstats, seconds float64) models.ZFSArcStats {
+	res := models.ZFSArcStats{
+		SizeBytes:   cur["size"],
+		TargetBytes: cur["c"],
+		MinBytes:    cur["c_min"],
+		MaxBytes:    cur["c_max"],
+	}
+
+	// The counters are reset when the module is reloaded
+	hits := sampler.Delta(prev["hits"], cur["hits"], 64)
+	misses := sampler.Delta(prev["misses"], cur["misses"], 64)
+	if hits+misses > 0 {
+		res.HitPercent = float64(hits) / float64(hits+misses) * 100
+	}
+	if seconds > 0 {
+		res.Hits = float64(hits) / seconds
+		res.Misses = float64(misses) / seconds
+	}
+
+	return res
+}
@@ -0,0 +1,51 @@
+package zfs
+
+import (
+	"context"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/sampler"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+var (
+	// fileARCStats is the procfs file with the ZFS ARC kstats.
+	fileARCStats = "spl/kstat/zfs/arcstats"
+)
+
+// parser - struct to hold the parser dependencies.
+type parser struct {
+	execer  cmd.Execer
+	paths   fs.Paths
+	sampler *sampler.Sampler[kstats]
+}
+
+// NewParser returns a new parser to parse the ZFS ARC statistics.
+//
+//nolint:revive
+func NewParser(execer cmd.Execer, paths fs.Paths) *parser {
+	return &parser{
+		execer:  execer,
+		paths:   paths,
+		sampler: sampler.NewSampler[kstats](),
+	}
+}
+
+// Parse parses the ZFS ARC statistics of the system.
+// Returns metrics.ErrNotAvailable if the zfs module is not loaded.
+// The rates are calculated since the previous call, so the first one returns metrics.ErrWarmingUp.
+func (p *parser) Parse(ctx context.Context) (models.ZFSArcStats, error) {
+	if p.execer.OS() == os.Linux {
+		return p.parseForLinux(ctx)
+	}
+
+	return models.ZFSArcStats{}, metrics.ErrUnsupportedOS
+}
+
+// Reset forgets the previous counters, so the rates are calculated since the next call.
+func (p *parser) Reset() {
+	p.sampler.Reset()
+}
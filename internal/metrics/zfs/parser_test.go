@@ -0,0 +1,175 @@
+package zfs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/metrics"
+	"github.com/sitnikovik/sysmon/internal/metrics/sampler"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/cmd"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/fs"
+	"github.com/sitnikovik/sysmon/internal/metrics/utils/os"
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+func Test_parser_Parse(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		execerMockFunc func(t *testing.T) cmd.Execer
+		paths          fs.Paths
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		warm    bool
+		fields  fields
+		args    args
+		want    models.ZFSArcStats
+		wantErr error
+	}{
+		{
+			name: "ok linux",
+			warm: true,
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Twice()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: models.ZFSArcStats{
+				SizeBytes:   8589934592,
+				TargetBytes: 9663676416,
+				MinBytes:    1073741824,
+				MaxBytes:    16777216000,
+			},
+		},
+		{
+			name: "err zfs module not loaded",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Linux).
+						Once()
+
+					return execer
+				},
+				paths: fs.Paths{Proc: "testdata/notexists"},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: metrics.ErrNotAvailable,
+		},
+		{
+			name: "err darwin unsupported",
+			fields: fields{
+				execerMockFunc: func(t *testing.T) cmd.Execer {
+					t.Helper()
+
+					execer := cmd.NewMockExecer(t)
+
+					execer.EXPECT().
+						OS().
+						Return(os.Darwin).
+						Once()
+
+					return execer
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: metrics.ErrUnsupportedOS,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &parser{
+				execer:  tt.fields.execerMockFunc(t),
+				paths:   tt.fields.paths,
+				sampler: sampler.NewSampler[kstats](),
+			}
+			if tt.warm {
+				_, err := p.Parse(tt.args.ctx)
+				require.ErrorIs(t, err, metrics.ErrWarmingUp)
+			}
+			got, err := p.Parse(tt.args.ctx)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseKstats(t *testing.T) {
+	t.Parallel()
+
+	lines, err := fs.ReadLines("testdata/spl/kstat/zfs/arcstats")
+	require.NoError(t, err)
+
+	got, err := parseKstats(lines)
+	require.NoError(t, err)
+	require.Equal(t, uint64(182937461), got["hits"])
+	require.Equal(t, uint64(1734912), got["misses"])
+	require.Len(t, got, 9)
+
+	_, err = parseKstats([]string{"13 1 0x01"})
+	require.Error(t, err)
+	_, err = parseKstats([]string{"13 1 0x01", "name type data", "hits 4 x"})
+	require.Error(t, err)
+}
+
+func Test_calcStats(t *testing.T) {
+	t.Parallel()
+
+	prev := kstats{
+		"hits":   1000,
+		"misses": 100,
+	}
+	cur := kstats{
+		"hits":   1900,
+		"misses": 200,
+		"size":   4096,
+		"c":      8192,
+		"c_min":  1024,
+		"c_max":  16384,
+	}
+
+	require.Equal(t, models.ZFSArcStats{
+		SizeBytes:   4096,
+		TargetBytes: 8192,
+		MinBytes:    1024,
+		MaxBytes:    16384,
+		Hits:        450,
+		Misses:      50,
+		HitPercent:  90,
+	}, calcStats(prev, cur, 2))
+
+	// The counters are reset
+	require.Equal(t, models.ZFSArcStats{}, calcStats(cur, kstats{}, 2))
+}
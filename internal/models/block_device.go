@@ -0,0 +1,45 @@
+package models
+
+// BlockDevice represents the block device with its partitions and mount points.
+type BlockDevice struct {
+	// Name shows the kernel name of the device like sda or nvme0n1.
+	Name string `json:"name"`
+	// Model shows the model of the device.
+	Model string `json:"model"`
+	// Serial shows the serial number of the device.
+	Serial string `json:"serial"`
+	// SizeBytes shows the size of the device in bytes.
+	SizeBytes uint64 `json:"sizeBytes"`
+	// Rotational shows whether the device is the rotational disk, otherwise it is SSD or virtual.
+	Rotational bool `json:"rotational"`
+	// Removable shows whether the device is removable.
+	Removable bool `json:"removable"`
+	// Scheduler shows the selected I/O scheduler of the device.
+	Scheduler string `json:"scheduler"`
+	// DMName shows the device mapper name of the device like vg0-root.
+	DMName string `json:"dmName"`
+	// Partitions shows the partitions of the device.
+	Partitions []Partition `json:"partitions"`
+	// MountPoints shows the mount points of the whole device.
+	MountPoints []MountPoint `json:"mountPoints"`
+}
+
+// Partition represents the partition of the block device.
+type Partition struct {
+	// Name shows the kernel name of the partition like sda1.
+	Name string `json:"name"`
+	// SizeBytes shows the size of the partition in bytes.
+	SizeBytes uint64 `json:"sizeBytes"`
+	// StartBytes shows the offset of the partition from the start of the device in bytes.
+	StartBytes uint64 `json:"startBytes"`
+	// MountPoints shows the mount points of the partition.
+	MountPoints []MountPoint `json:"mountPoints"`
+}
+
+// MountPoint represents the place the filesystem is mounted at.
+type MountPoint struct {
+	// Path shows the path the filesystem is mounted at.
+	Path string `json:"path"`
+	// FSType shows the type of the filesystem.
+	FSType string `json:"fsType"`
+}
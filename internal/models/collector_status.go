@@ -0,0 +1,125 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils"
+)
+
+// DurationBuckets is the upper bounds of the buckets of the collection duration histogram.
+var DurationBuckets = []time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// CollectorStatus represents the health of the metrics collector.
+type CollectorStatus struct {
+	// Name is the name of the collector.
+	Name string `json:"name"`
+	// Collections is the number of the collections run.
+	Collections uint64 `json:"collections"`
+	// Failures is the number of the collections failed.
+	Failures uint64 `json:"failures"`
+	// ConsecutiveFailures is the number of the collections failed since the last successful one.
+	ConsecutiveFailures uint64 `json:"consecutiveFailures"`
+	// LastSuccess is the time of the last successful collection, zero if there was none.
+	LastSuccess time.Time `json:"lastSuccess"`
+	// LastError is the error of the last failed collection.
+	LastError string `json:"lastError"`
+	// LastErrorTime is the time of the last failed collection, zero if there was none.
+	LastErrorTime time.Time `json:"lastErrorTime"`
+	// LastDuration is the duration of the last collection.
+	LastDuration time.Duration `json:"lastDuration"`
+	// Duration is the histogram of the collections durations.
+	Duration DurationHistogram `json:"duration"`
+	// LastSamples is the number of the samples produced by the last successful collection.
+	LastSamples int `json:"lastSamples"`
+	// Samples is the number of the samples produced by all the collections.
+	Samples uint64 `json:"samples"`
+	// Stale is whether the last collection failed, so the metric is the one of the last successful collection.
+	Stale bool `json:"stale"`
+}
+
+// Failing reports whether the last collection of the collector failed.
+func (s CollectorStatus) Failing() bool {
+	return s.ConsecutiveFailures > 0
+}
+
+// DurationHistogram represents the distribution of the durations over DurationBuckets.
+type DurationHistogram struct {
+	// Counts is the number of the durations within every bucket, the last one is for the longer ones.
+	Counts []uint64 `json:"counts"`
+	// Sum is the sum of the durations.
+	Sum time.Duration `json:"sum"`
+	// Count is the number of the durations.
+	Count uint64 `json:"count"`
+}
+
+// Observe adds the duration to the histogram.
+func (h *DurationHistogram) Observe(d time.Duration) {
+	if len(h.Counts) == 0 {
+		h.Counts = make([]uint64, len(DurationBuckets)+1)
+	}
+
+	i := 0
+	for i < len(DurationBuckets) && d > DurationBuckets[i] {
+		i++
+	}
+	h.Counts[i]++
+	h.Sum += d
+	h.Count++
+}
+
+// Mean returns the mean duration.
+func (h DurationHistogram) Mean() time.Duration {
+	if h.Count == 0 {
+		return 0
+	}
+
+	return h.Sum / time.Duration(h.Count)
+}
+
+// CollectorsStatus represents the health of all the metrics collectors.
+type CollectorsStatus []CollectorStatus
+
+// String returns a string representation of the CollectorsStatus as the footer of the metrics.
+func (s CollectorsStatus) String() string {
+	var healthy, stale int
+	var failing []string
+	for _, c := range s {
+		if c.Stale {
+			stale++
+		}
+		if !c.Failing() {
+			healthy++
+			continue
+		}
+
+		since := "never succeeded"
+		if !c.LastSuccess.IsZero() {
+			since = "last success at " + c.LastSuccess.Format(time.TimeOnly)
+		}
+		failing = append(failing, fmt.Sprintf(
+			"  %s: %s failures in a row, %s: %s",
+			utils.BoldText(c.Name), utils.BeatifyNumber(c.ConsecutiveFailures), since, c.LastError,
+		))
+	}
+
+	res := utils.GrayText(fmt.Sprintf(
+		"Collectors: %d healthy, %d failing, %d stale", healthy, len(failing), stale,
+	))
+	if len(failing) > 0 {
+		res += "\n" + strings.Join(failing, "\n")
+	}
+
+	return res
+}
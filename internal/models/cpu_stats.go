@@ -23,3 +23,17 @@ func (c CPUStats) String() string {
 
 	return utils.BoldText(header) + utils.GrayText(values)
 }
+
+// Store stores the CPU statistics to the metrics of the system.
+func (c CPUStats) Store(metrics *Metrics) {
+	metrics.CPUStats = c
+}
+
+// Samples returns the CPU usage as the generic samples.
+func (c CPUStats) Samples() []Sample {
+	return []Sample{
+		gauge("cpu_usage_percent", UnitPercent, c.User, "mode", "user"),
+		gauge("cpu_usage_percent", UnitPercent, c.System, "mode", "system"),
+		gauge("cpu_usage_percent", UnitPercent, c.Idle, "mode", "idle"),
+	}
+}
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils"
+)
+
+// fmtCustomMetric is the format for the custom metrics.
+const fmtCustomMetric = "%-32s %-16s %-16s"
+
+// CustomStats represents the metrics of the custom collector parsed from the output of its command.
+type CustomStats struct {
+	// Collector shows the name of the custom collector.
+	Collector string `json:"collector"`
+	// Metrics shows the metrics parsed from the output of the command.
+	Metrics []CustomMetric `json:"metrics"`
+}
+
+// CustomMetric represents the metric parsed from the output of the custom collector command.
+type CustomMetric struct {
+	// Name shows the name of the metric like queue_depth.
+	Name string `json:"name"`
+	// Unit shows the unit of the value like seconds.
+	Unit string `json:"unit"`
+	// Type shows the type of the value: gauge, counter or rate.
+	Type SampleType `json:"type"`
+	// Value shows the value of the metric.
+	Value float64 `json:"value"`
+}
+
+// String returns a string representation of the CustomStats.
+func (s CustomStats) String() string {
+	header := utils.BoldText(fmt.Sprintf(fmtCustomMetric, "Metric", "Value", "Unit"))
+	rows := make([]string, 0, len(s.Metrics))
+	for _, m := range s.Metrics {
+		rows = append(rows, fmt.Sprintf(fmtCustomMetric, m.Name, strconv.FormatFloat(m.Value, 'f', -1, 64), m.Unit))
+	}
+
+	return header + "\n" + utils.GrayText(strings.Join(rows, "\n"))
+}
+
+// Store stores the custom collector metrics to the metrics of the system next to the other custom collectors.
+func (s CustomStats) Store(metrics *Metrics) {
+	metrics.CustomStats = append(metrics.CustomStats, s)
+}
+
+// Samples returns the custom collector metrics as the generic samples labelled with the collector name.
+func (s CustomStats) Samples() []Sample {
+	res := make([]Sample, 0, len(s.Metrics))
+	for _, m := range s.Metrics {
+		res = append(res, newSample(m.Type, m.Name, m.Unit, m.Value, "collector", s.Collector))
+	}
+
+	return res
+}
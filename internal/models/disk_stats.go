@@ -27,6 +27,8 @@ type DiskStats struct {
 	UsedInodes uint64 `json:"usedInodes"`
 	// UsedInodesPercent shows the used inodes in percentage.
 	UsedInodesPercent float64 `json:"usedInodesPercent"`
+	// Mount shows the mount point of the filesystem the space and inodes are of.
+	Mount string `json:"mount"`
 }
 
 // String returns a string representation of the DiskStats.
@@ -51,3 +53,22 @@ func (d DiskStats) String() string {
 
 	return header + values
 }
+
+// Store stores the disk statistics to the metrics of the system.
+func (d DiskStats) Store(metrics *Metrics) {
+	metrics.DiskStats = d
+}
+
+// Samples returns the disk usage as the generic samples.
+func (d DiskStats) Samples() []Sample {
+	return []Sample{
+		rate("disk_reads_per_second", UnitPerSecond, d.Reads),
+		rate("disk_writes_per_second", UnitPerSecond, d.Writes),
+		rate("disk_io_bytes_per_second", UnitBytesPerSecond, d.ReadWriteKb*1024),
+		gauge("disk_total_bytes", UnitBytes, mbToBytes(d.TotalMb), "mount", d.Mount),
+		gauge("disk_used_bytes", UnitBytes, mbToBytes(d.UsedMb), "mount", d.Mount),
+		gauge("disk_used_percent", UnitPercent, d.UsedPercent, "mount", d.Mount),
+		gauge("disk_used_inodes", UnitCount, float64(d.UsedInodes), "mount", d.Mount),
+		gauge("disk_used_inodes_percent", UnitPercent, d.UsedInodesPercent, "mount", d.Mount),
+	}
+}
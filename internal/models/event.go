@@ -0,0 +1,87 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils"
+)
+
+const (
+	// EventOOMKill is the kind of the event of the process killed by the OOM killer.
+	EventOOMKill = "oom_kill"
+	// EventHungTask is the kind of the event of the task blocked for too long.
+	EventHungTask = "hung_task"
+	// EventFSError is the kind of the event of the filesystem or block device error.
+	EventFSError = "fs_error"
+	// EventLinkFlap is the kind of the event of the network link going down or up.
+	EventLinkFlap = "link_flap"
+	// EventProcessStart is the kind of the event of the process started.
+	EventProcessStart = "process_start"
+	// EventProcessExit is the kind of the event of the process exited.
+	EventProcessExit = "process_exit"
+	// EventWatcherError is the kind of the event of the events watcher failed.
+	EventWatcherError = "watcher_error"
+)
+
+const (
+	// SeverityCritical is the severity of the events requiring immediate attention.
+	SeverityCritical = "critical"
+	// SeverityError is the severity of the error events.
+	SeverityError = "error"
+	// SeverityWarning is the severity of the events that may indicate a problem.
+	SeverityWarning = "warning"
+	// SeverityInfo is the severity of the informational events.
+	SeverityInfo = "info"
+)
+
+// Event represents the discrete system event like the OOM kill missed by the metrics snapshots.
+type Event struct {
+	// Time shows the time the event happened at.
+	Time time.Time `json:"time"`
+	// Kind shows the kind of the event like oom_kill.
+	Kind string `json:"kind"`
+	// Severity shows the severity of the event: critical, error, warning or info.
+	Severity string `json:"severity"`
+	// Message shows the kernel message of the event.
+	Message string `json:"message"`
+	// Process shows the process the event is about like the OOM killer victim, empty if unknown.
+	Process EventProcess `json:"process"`
+	// Device shows the device the event is about like the network interface or the disk, empty if unknown.
+	Device string `json:"device"`
+}
+
+// EventProcess represents the process the event is about.
+type EventProcess struct {
+	// PID shows the PID of the process.
+	PID int `json:"pid"`
+	// Name shows the name of the process.
+	Name string `json:"name"`
+	// PPID shows the PID of the parent process, 0 if unknown.
+	PPID int `json:"ppid"`
+	// Command shows the command line of the process, empty if unknown.
+	Command string `json:"command"`
+	// User shows the name of the user owning the process, empty if unknown.
+	User string `json:"user"`
+	// LifetimeSec shows the time the process lived for in seconds for the exited process.
+	LifetimeSec float64 `json:"lifetimeSec"`
+}
+
+// Lifecycle reports whether the event is of the process starting or exiting.
+func (e Event) Lifecycle() bool {
+	return e.Kind == EventProcessStart || e.Kind == EventProcessExit
+}
+
+// String returns a string representation of the Event.
+func (e Event) String() string {
+	subject := e.Device
+	if e.Process.PID != 0 {
+		subject = fmt.Sprintf("%s (%d)", e.Process.Name, e.Process.PID)
+	}
+
+	return fmt.Sprintf("%s %s %s",
+		utils.GrayText(e.Time.Local().Format(time.DateTime)),
+		utils.BoldText(fmt.Sprintf("%-8s %-14s %-24s", e.Severity, e.Kind, subject)),
+		utils.GrayText(e.Message),
+	)
+}
@@ -0,0 +1,115 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils"
+)
+
+// fmtIRQStats is the format for the interrupts statistics.
+const fmtIRQStats = "%-10s %-30s %-14s %-12s %-10s"
+
+// InterruptsStats represents the distribution of the interrupts among the CPUs.
+type InterruptsStats struct {
+	// CPUs shows the number of CPUs the interrupts are distributed among.
+	CPUs int `json:"cpus"`
+	// TopIRQs shows the busiest numbered device interrupts sorted by the rate.
+	TopIRQs []IRQStats `json:"topIrqs"`
+	// LocalIRQs shows the local interrupts of the CPUs like the timer and the IPIs,
+	// they are not counted in the distribution of the device interrupts.
+	LocalIRQs []IRQStats `json:"localIrqs"`
+	// SoftIRQs shows the software interrupts.
+	SoftIRQs []IRQStats `json:"softIrqs"`
+	// PerCPU shows the number of device interrupts per second handled by each CPU.
+	PerCPU []float64 `json:"perCpu"`
+	// BusiestCPU shows the index of the CPU handling the most of device interrupts.
+	BusiestCPU int `json:"busiestCpu"`
+	// Imbalance shows the ratio of the busiest CPU rate to the mean one.
+	// It is 1 when the interrupts are spread evenly and equals to the number of CPUs
+	// when all of them land on the single CPU.
+	Imbalance float64 `json:"imbalance"`
+}
+
+// IRQStats represents the rates of the single interrupt.
+type IRQStats struct {
+	// IRQ shows the interrupt number or name.
+	IRQ string `json:"irq"`
+	// Device shows the name of the device or the description of the interrupt.
+	Device string `json:"device"`
+	// PerCPU shows the number of interrupts per second handled by each CPU.
+	PerCPU []float64 `json:"perCpu"`
+	// Total shows the number of interrupts per second handled by all CPUs.
+	Total float64 `json:"total"`
+	// BusiestCPU shows the index of the CPU handling the most of the interrupts.
+	BusiestCPU int `json:"busiestCpu"`
+	// Imbalance shows the ratio of the busiest CPU rate to the mean one.
+	Imbalance float64 `json:"imbalance"`
+}
+
+// String returns a string representation of the InterruptsStats.
+func (s InterruptsStats) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(utils.BoldText(fmt.Sprintf("%-10s %-14s %-10s\n", "CPUs", "Busiest CPU", "Imbalance")))
+	sb.WriteString(utils.GrayText(fmt.Sprintf("%-10d %-14s %-10.2f",
+		s.CPUs, fmt.Sprintf("CPU%d", s.BusiestCPU), s.Imbalance,
+	)))
+	sb.WriteString("\n\n")
+	sb.WriteString(irqsString(s.TopIRQs))
+	sb.WriteString("\n\n")
+	sb.WriteString(irqsString(s.LocalIRQs))
+	sb.WriteString("\n\n")
+	sb.WriteString(irqsString(s.SoftIRQs))
+
+	return sb.String()
+}
+
+// irqsString returns a string representation of the interrupts list.
+func irqsString(irqs []IRQStats) string {
+	header := utils.BoldText(fmt.Sprintf(fmtIRQStats, "IRQ", "Device", "Total/s", "Busiest CPU", "Imbalance"))
+
+	rows := make([]string, 0, len(irqs))
+	for _, irq := range irqs {
+		device := irq.Device
+		if len(device) > 30 {
+			device = device[:27] + "..."
+		}
+		rows = append(rows, fmt.Sprintf(fmtIRQStats,
+			irq.IRQ,
+			device,
+			utils.BeatifyNumber(irq.Total),
+			fmt.Sprintf("CPU%d", irq.BusiestCPU),
+			fmt.Sprintf("%.2f", irq.Imbalance),
+		))
+	}
+
+	return header + "\n" + utils.GrayText(strings.Join(rows, "\n"))
+}
+
+// Store stores the interrupts distribution statistics to the metrics of the system.
+func (s InterruptsStats) Store(metrics *Metrics) {
+	metrics.InterruptsStats = s
+}
+
+// Samples returns the interrupts distribution as the generic samples.
+func (s InterruptsStats) Samples() []Sample {
+	res := []Sample{
+		gauge("interrupts_imbalance", UnitRatio, s.Imbalance),
+	}
+	for cpu, r := range s.PerCPU {
+		res = append(res, rate("interrupts_cpu_per_second", UnitPerSecond, r, "cpu", strconv.Itoa(cpu)))
+	}
+	for _, irq := range s.TopIRQs {
+		res = append(res, rate("interrupts_irq_per_second", UnitPerSecond, irq.Total, "irq", irq.IRQ, "device", irq.Device))
+	}
+	for _, irq := range s.LocalIRQs {
+		res = append(res, rate("interrupts_local_per_second", UnitPerSecond, irq.Total, "irq", irq.IRQ))
+	}
+	for _, irq := range s.SoftIRQs {
+		res = append(res, rate("interrupts_softirq_per_second", UnitPerSecond, irq.Total, "softirq", irq.IRQ))
+	}
+
+	return res
+}
@@ -0,0 +1,98 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils"
+)
+
+// fmtLimitsStats is the format for the kernel limits statistics.
+const fmtLimitsStats = "%-20s %-16s %-16s %-10s"
+
+// LimitsStats represents the system-wide usage of the kernel tables against their limits.
+type LimitsStats struct {
+	// FileHandles shows the allocated file handles against fs.file-max.
+	FileHandles LimitUsage `json:"fileHandles"`
+	// PIDs shows the used PIDs by processes and threads against kernel.pid_max.
+	PIDs LimitUsage `json:"pids"`
+	// Conntrack shows the connection tracking entries against net.netfilter.nf_conntrack_max.
+	Conntrack LimitUsage `json:"conntrack"`
+	// InotifyWatches shows the inotify watches of the user closest to fs.inotify.max_user_watches.
+	InotifyWatches LimitUsage `json:"inotifyWatches"`
+	// InotifyInstances shows the inotify instances of the user closest to fs.inotify.max_user_instances.
+	InotifyInstances LimitUsage `json:"inotifyInstances"`
+}
+
+// LimitUsage represents the usage of the kernel limit.
+type LimitUsage struct {
+	// Used shows the number of the used entries.
+	Used uint64 `json:"used"`
+	// Max shows the maximum number of the entries.
+	Max uint64 `json:"max"`
+	// UsedPercent shows the utilisation of the limit in percentage.
+	UsedPercent float64 `json:"usedPercent"`
+	// Available shows whether the limit exists on the system,
+	// e.g. the conntrack table exists only when the nf_conntrack module is loaded.
+	Available bool `json:"available"`
+}
+
+// String returns a string representation of the LimitsStats.
+func (l LimitsStats) String() string {
+	header := utils.BoldText(fmt.Sprintf(fmtLimitsStats, "Resource", "Used", "Max", "Used %"))
+
+	rows := []string{
+		l.FileHandles.row("File handles"),
+		l.PIDs.row("PIDs"),
+		l.Conntrack.row("Conntrack entries"),
+		l.InotifyWatches.row("Inotify watches"),
+		l.InotifyInstances.row("Inotify instances"),
+	}
+
+	return header + "\n" + utils.GrayText(strings.Join(rows, "\n"))
+}
+
+// row returns a table row of the limit usage.
+func (u LimitUsage) row(name string) string {
+	if !u.Available {
+		return fmt.Sprintf(fmtLimitsStats, name, "-", "-", "-")
+	}
+
+	return fmt.Sprintf(fmtLimitsStats,
+		name,
+		utils.BeatifyNumber(u.Used),
+		utils.BeatifyNumber(u.Max),
+		fmt.Sprintf("%.2f%%", u.UsedPercent),
+	)
+}
+
+// Store stores the kernel limits usage to the metrics of the system.
+func (l LimitsStats) Store(metrics *Metrics) {
+	metrics.LimitsStats = l
+}
+
+// Samples returns the kernel limits usage as the generic samples.
+func (l LimitsStats) Samples() []Sample {
+	var res []Sample
+	for _, limit := range []struct {
+		name  string
+		usage LimitUsage
+	}{
+		{"file_handles", l.FileHandles},
+		{"pids", l.PIDs},
+		{"conntrack", l.Conntrack},
+		{"inotify_watches", l.InotifyWatches},
+		{"inotify_instances", l.InotifyInstances},
+	} {
+		if !limit.usage.Available {
+			continue
+		}
+		res = append(res,
+			gauge("limits_used", UnitCount, float64(limit.usage.Used), "limit", limit.name),
+			gauge("limits_max", UnitCount, float64(limit.usage.Max), "limit", limit.name),
+			gauge("limits_used_percent", UnitPercent, limit.usage.UsedPercent, "limit", limit.name),
+		)
+	}
+
+	return res
+}
@@ -23,3 +23,17 @@ func (l LoadAverageStats) String() string {
 
 	return utils.BoldText(headers) + utils.GrayText(values)
 }
+
+// Store stores the load average statistics to the metrics of the system.
+func (l LoadAverageStats) Store(metrics *Metrics) {
+	metrics.LoadAverageStats = l
+}
+
+// Samples returns the load average as the generic samples.
+func (l LoadAverageStats) Samples() []Sample {
+	return []Sample{
+		gauge("loadavg", UnitCount, l.OneMin, "period", "1m"),
+		gauge("loadavg", UnitCount, l.FiveMin, "period", "5m"),
+		gauge("loadavg", UnitCount, l.FifteenMin, "period", "15m"),
+	}
+}
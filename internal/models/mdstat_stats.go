@@ -0,0 +1,131 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils"
+)
+
+const (
+	// MDMemberActive is the state of the member in sync with the array.
+	MDMemberActive = "active"
+	// MDMemberFaulty is the state of the failed member.
+	MDMemberFaulty = "faulty"
+	// MDMemberSpare is the state of the spare member or the one being rebuilt.
+	MDMemberSpare = "spare"
+	// MDMemberWriteMostly is the state of the member the reads are avoided from.
+	MDMemberWriteMostly = "write-mostly"
+	// MDMemberReplacement is the state of the member replacing another one.
+	MDMemberReplacement = "replacement"
+)
+
+// fmtMDStatStats is the format for the software RAID arrays status.
+const fmtMDStatStats = "%-8s %-10s %-24s %-10s %-10s %s"
+
+// MDStatStats represents the software RAID arrays status.
+type MDStatStats struct {
+	// Arrays shows the md arrays.
+	Arrays []MDArray `json:"arrays"`
+}
+
+// MDArray represents the status of the md array.
+type MDArray struct {
+	// Name shows the name of the array like md0.
+	Name string `json:"name"`
+	// State shows the state of the array like active, inactive or active (auto-read-only).
+	State string `json:"state"`
+	// Level shows the RAID level of the array like raid1.
+	Level string `json:"level"`
+	// Devices shows the number of the devices the array consists of.
+	Devices int `json:"devices"`
+	// ActiveDevices shows the number of the devices in sync.
+	ActiveDevices int `json:"activeDevices"`
+	// Degraded shows whether some of the devices are missing or failed.
+	Degraded bool `json:"degraded"`
+	// Members shows the member devices of the array.
+	Members []MDMember `json:"members"`
+	// Sync shows the resync, recovery, reshape or check in progress.
+	Sync MDSync `json:"sync"`
+}
+
+// MDMember represents the member device of the md array.
+type MDMember struct {
+	// Name shows the kernel name of the device like sda1.
+	Name string `json:"name"`
+	// Slot shows the role number of the device in the array.
+	Slot int `json:"slot"`
+	// State shows the state of the device: active, faulty, spare, write-mostly or replacement.
+	State string `json:"state"`
+}
+
+// MDSync represents the sync action of the md array.
+type MDSync struct {
+	// Action shows the sync action like resync, recovery, reshape or check, empty if the array is idle.
+	Action string `json:"action"`
+	// Pending shows whether the action is delayed or pending.
+	Pending bool `json:"pending"`
+	// Percent shows the progress of the action in percentage.
+	Percent float64 `json:"percent"`
+	// FinishMin shows the estimated time to finish the action in minutes.
+	FinishMin float64 `json:"finishMin"`
+	// SpeedKBs shows the speed of the action in Kb per second.
+	SpeedKBs uint64 `json:"speedKbs"`
+}
+
+// String returns a string representation of the MDStatStats.
+func (s MDStatStats) String() string {
+	header := utils.BoldText(fmt.Sprintf(fmtMDStatStats, "Array", "Level", "State", "Devices", "Degraded", "Sync"))
+
+	rows := make([]string, 0, len(s.Arrays))
+	for _, a := range s.Arrays {
+		degraded := "no"
+		if a.Degraded {
+			degraded = "YES"
+		}
+
+		sync := "-"
+		switch {
+		case a.Sync.Pending:
+			sync = a.Sync.Action + " pending"
+		case a.Sync.Action != "":
+			sync = fmt.Sprintf("%s %.1f%% (%.0f min left)", a.Sync.Action, a.Sync.Percent, a.Sync.FinishMin)
+		}
+
+		rows = append(rows, fmt.Sprintf(fmtMDStatStats,
+			a.Name, a.Level, a.State, fmt.Sprintf("%d/%d", a.ActiveDevices, a.Devices), degraded, sync,
+		))
+	}
+
+	return header + "\n" + utils.GrayText(strings.Join(rows, "\n"))
+}
+
+// Store stores the software RAID arrays status to the metrics of the system.
+func (s MDStatStats) Store(metrics *Metrics) {
+	metrics.MDStatStats = s
+}
+
+// Samples returns the software RAID arrays status as the generic samples.
+func (s MDStatStats) Samples() []Sample {
+	var res []Sample
+	for _, a := range s.Arrays {
+		res = append(res,
+			gauge("mdstat_devices", UnitCount, float64(a.Devices), "array", a.Name, "level", a.Level),
+			gauge("mdstat_active_devices", UnitCount, float64(a.ActiveDevices), "array", a.Name, "level", a.Level),
+			gauge("mdstat_degraded", UnitBool, boolValue(a.Degraded), "array", a.Name, "level", a.Level),
+		)
+		for _, m := range a.Members {
+			res = append(res, gauge("mdstat_member_faulty", UnitBool, boolValue(m.State == MDMemberFaulty),
+				"array", a.Name, "member", m.Name))
+		}
+		if a.Sync.Action != "" {
+			res = append(res,
+				gauge("mdstat_sync_percent", UnitPercent, a.Sync.Percent, "array", a.Name, "action", a.Sync.Action),
+				gauge("mdstat_sync_bytes_per_second", UnitBytesPerSecond, float64(a.Sync.SpeedKBs)*1024,
+					"array", a.Name, "action", a.Sync.Action),
+			)
+		}
+	}
+
+	return res
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/sitnikovik/sysmon/internal/metrics/utils"
 )
@@ -9,6 +10,15 @@ import (
 // memoryStatsFmt is the format for the memory statistics string.
 const memoryStatsFmt = "%-12s %-12s %-10s %-10s %-10s %-12s %-12s %-12s"
 
+const (
+	// numaNodeFmt is the format for the NUMA nodes memory string.
+	numaNodeFmt = "%-8s %-12s %-12s %-12s %-12s %-12s %-12s"
+	// hugePagesFmt is the format for the hugepage pools string.
+	hugePagesFmt = "%-12s %-10s %-10s %-10s %-10s"
+	// slabCacheFmt is the format for the slab caches string.
+	slabCacheFmt = "%-24s %-12s %-12s %-12s"
+)
+
 // MemoryStats defines the memory statistics.
 type MemoryStats struct {
 	// TotalMb shows the total memory in MB
@@ -35,6 +45,67 @@ type MemoryStats struct {
 	// This is used to speed up disk operations by storing data in memory.
 	// Cached data is usually used for application data and can be freed up if necessary.
 	CachedMb uint64 `json:"cachedMb"`
+	// SlabMb shows how much memory in MB is used by the kernel slab allocator for its own data structures.
+	SlabMb uint64 `json:"slabMb"`
+	// SlabReclaimableMb shows how much of the slab memory in MB can be reclaimed like the dentries and inodes caches.
+	SlabReclaimableMb uint64 `json:"slabReclaimableMb"`
+	// HugetlbMb shows how much memory in MB is reserved by the hugepage pools of all sizes.
+	// This memory is not available for the regular allocations even if the hugepages are free.
+	HugetlbMb uint64 `json:"hugetlbMb"`
+	// NUMANodes is the memory of every NUMA node.
+	NUMANodes []NUMANodeMemory `json:"numaNodes"`
+	// HugePages is the hugepage pools of every page size.
+	HugePages []HugePagePool `json:"hugePages"`
+	// TopSlabCaches is the largest slab caches by size. It is empty if /proc/slabinfo is not readable.
+	TopSlabCaches []SlabCache `json:"topSlabCaches"`
+}
+
+// NUMANodeMemory defines the memory of a NUMA node.
+type NUMANodeMemory struct {
+	// Node is the number of the NUMA node.
+	Node int `json:"node"`
+	// TotalMb is the total memory of the node in MB.
+	TotalMb uint64 `json:"totalMb"`
+	// FreeMb is the free memory of the node in MB.
+	FreeMb uint64 `json:"freeMb"`
+	// UsedMb is the used memory of the node in MB.
+	UsedMb uint64 `json:"usedMb"`
+	// FilePagesMb is the page cache of the node in MB.
+	FilePagesMb uint64 `json:"filePagesMb"`
+	// AnonPagesMb is the anonymous memory of the processes of the node in MB.
+	AnonPagesMb uint64 `json:"anonPagesMb"`
+	// SlabMb is the slab memory of the node in MB.
+	SlabMb uint64 `json:"slabMb"`
+	// HugePages is the number of the default size hugepages of the node.
+	HugePages uint64 `json:"hugePages"`
+	// HugePagesFree is the number of the free default size hugepages of the node.
+	HugePagesFree uint64 `json:"hugePagesFree"`
+}
+
+// HugePagePool defines the hugepage pool of a page size.
+type HugePagePool struct {
+	// SizeKb is the size of the hugepage in KB.
+	SizeKb uint64 `json:"sizeKb"`
+	// Total is the number of the hugepages in the pool.
+	Total uint64 `json:"total"`
+	// Free is the number of the hugepages not allocated yet.
+	Free uint64 `json:"free"`
+	// Reserved is the number of the hugepages committed to be allocated but not allocated yet.
+	Reserved uint64 `json:"reserved"`
+	// Surplus is the number of the hugepages allocated above the pool size by overcommit.
+	Surplus uint64 `json:"surplus"`
+}
+
+// SlabCache defines the kernel slab cache.
+type SlabCache struct {
+	// Name is the name of the cache like dentry or kmalloc-64.
+	Name string `json:"name"`
+	// ActiveObjects is the number of the objects in use.
+	ActiveObjects uint64 `json:"activeObjects"`
+	// Objects is the number of the objects allocated.
+	Objects uint64 `json:"objects"`
+	// SizeKb is the memory of the cache slabs in KB.
+	SizeKb uint64 `json:"sizeKb"`
 }
 
 // String returns a string representation of the MemoryStats.
@@ -57,5 +128,115 @@ func (m MemoryStats) String() string {
 		utils.BeatifyNumber(m.WiredMb)+" MB",
 	)
 
-	return utils.BoldText(headers) + utils.GrayText(values)
+	res := utils.BoldText(headers) + utils.GrayText(values)
+
+	if m.SlabMb > 0 || m.HugetlbMb > 0 {
+		res += "\n" + utils.GrayText(fmt.Sprintf(
+			"Slab: %s MB (reclaimable %s MB), Hugetlb: %s MB",
+			utils.BeatifyNumber(m.SlabMb),
+			utils.BeatifyNumber(m.SlabReclaimableMb),
+			utils.BeatifyNumber(m.HugetlbMb),
+		))
+	}
+
+	if len(m.NUMANodes) > 1 {
+		res += "\n\n" + utils.BoldText(fmt.Sprintf(numaNodeFmt+"\n", "Node", "Total", "Used", "Free", "File", "Anon", "Slab"))
+		for _, node := range m.NUMANodes {
+			res += utils.GrayText(fmt.Sprintf(
+				numaNodeFmt+"\n",
+				fmt.Sprintf("node%d", node.Node),
+				utils.BeatifyNumber(node.TotalMb)+" MB",
+				utils.BeatifyNumber(node.UsedMb)+" MB",
+				utils.BeatifyNumber(node.FreeMb)+" MB",
+				utils.BeatifyNumber(node.FilePagesMb)+" MB",
+				utils.BeatifyNumber(node.AnonPagesMb)+" MB",
+				utils.BeatifyNumber(node.SlabMb)+" MB",
+			))
+		}
+	}
+
+	var pools []HugePagePool
+	for _, pool := range m.HugePages {
+		if pool.Total > 0 || pool.Surplus > 0 {
+			pools = append(pools, pool)
+		}
+	}
+	if len(pools) > 0 {
+		res += "\n\n" + utils.BoldText(fmt.Sprintf(hugePagesFmt+"\n", "Hugepages", "Total", "Free", "Reserved", "Surplus"))
+		for _, pool := range pools {
+			res += utils.GrayText(fmt.Sprintf(
+				hugePagesFmt+"\n",
+				utils.BeatifyNumber(pool.SizeKb)+" KB",
+				utils.BeatifyNumber(pool.Total),
+				utils.BeatifyNumber(pool.Free),
+				utils.BeatifyNumber(pool.Reserved),
+				utils.BeatifyNumber(pool.Surplus),
+			))
+		}
+	}
+
+	if len(m.TopSlabCaches) > 0 {
+		res += "\n\n" + utils.BoldText(fmt.Sprintf(slabCacheFmt+"\n", "Slab cache", "Size", "Active", "Objects"))
+		for _, cache := range m.TopSlabCaches {
+			res += utils.GrayText(fmt.Sprintf(
+				slabCacheFmt+"\n",
+				cache.Name,
+				utils.BeatifyNumber(cache.SizeKb)+" KB",
+				utils.BeatifyNumber(cache.ActiveObjects),
+				utils.BeatifyNumber(cache.Objects),
+			))
+		}
+	}
+
+	return res
+}
+
+// Store stores the memory statistics to the metrics of the system.
+func (m MemoryStats) Store(metrics *Metrics) {
+	metrics.MemoryStats = m
+}
+
+// Samples returns the memory usage as the generic samples.
+func (m MemoryStats) Samples() []Sample {
+	res := []Sample{
+		gauge("memory_total_bytes", UnitBytes, mbToBytes(m.TotalMb)),
+		gauge("memory_available_bytes", UnitBytes, mbToBytes(m.AvailableMb)),
+		gauge("memory_used_bytes", UnitBytes, mbToBytes(m.UsedMb)),
+		gauge("memory_free_bytes", UnitBytes, mbToBytes(m.FreeMb)),
+		gauge("memory_active_bytes", UnitBytes, mbToBytes(m.ActiveMb)),
+		gauge("memory_inactive_bytes", UnitBytes, mbToBytes(m.InactiveMb)),
+		gauge("memory_wired_bytes", UnitBytes, mbToBytes(m.WiredMb)),
+		gauge("memory_cached_bytes", UnitBytes, mbToBytes(m.CachedMb)),
+		gauge("memory_slab_bytes", UnitBytes, mbToBytes(m.SlabMb)),
+		gauge("memory_slab_reclaimable_bytes", UnitBytes, mbToBytes(m.SlabReclaimableMb)),
+		gauge("memory_hugetlb_bytes", UnitBytes, mbToBytes(m.HugetlbMb)),
+	}
+	for _, n := range m.NUMANodes {
+		node := strconv.Itoa(n.Node)
+		res = append(res,
+			gauge("memory_numa_total_bytes", UnitBytes, mbToBytes(n.TotalMb), "node", node),
+			gauge("memory_numa_free_bytes", UnitBytes, mbToBytes(n.FreeMb), "node", node),
+			gauge("memory_numa_used_bytes", UnitBytes, mbToBytes(n.UsedMb), "node", node),
+			gauge("memory_numa_file_pages_bytes", UnitBytes, mbToBytes(n.FilePagesMb), "node", node),
+			gauge("memory_numa_anon_pages_bytes", UnitBytes, mbToBytes(n.AnonPagesMb), "node", node),
+			gauge("memory_numa_slab_bytes", UnitBytes, mbToBytes(n.SlabMb), "node", node),
+		)
+	}
+	for _, p := range m.HugePages {
+		size := strconv.FormatUint(p.SizeKb, 10)
+		res = append(res,
+			gauge("memory_hugepages_total", UnitCount, float64(p.Total), "size_kb", size),
+			gauge("memory_hugepages_free", UnitCount, float64(p.Free), "size_kb", size),
+			gauge("memory_hugepages_reserved", UnitCount, float64(p.Reserved), "size_kb", size),
+			gauge("memory_hugepages_surplus", UnitCount, float64(p.Surplus), "size_kb", size),
+		)
+	}
+	for _, c := range m.TopSlabCaches {
+		res = append(res,
+			gauge("memory_slab_cache_bytes", UnitBytes, float64(c.SizeKb)*1024, "cache", c.Name),
+			gauge("memory_slab_cache_active_objects", UnitCount, float64(c.ActiveObjects), "cache", c.Name),
+		)
+	}
+
+	return res
 }
@@ -1,5 +1,27 @@
 package models
 
+import (
+	"time"
+)
+
+// Stats defines the statistics collected by a metric collector.
+// The statistics are printed and served as the generic samples, so the new metric needs no other changes.
+type Stats interface {
+	// String returns the string representation of the statistics to print
+	String() string
+	// Samples returns the statistics as the generic samples with no timestamp
+	Samples() []Sample
+}
+
+// StoredStats defines the statistics also stored to their own field of Metrics
+// to be returned by GetStats. The field, the response message and its conversion are added by hand.
+type StoredStats interface {
+	Stats
+	// Store stores the statistics to the metrics of the system
+	Store(metrics *Metrics)
+}
+
+// Metrics defines the statistics of all the metrics of the system.
 type Metrics struct {
 	// CPUStats is the CPU statistics
 	CPUStats CPUStats `json:"cpuStats"`
@@ -9,4 +31,45 @@ type Metrics struct {
 	MemoryStats MemoryStats `json:"memoryStats"`
 	// LoadAverageStats is the load average statistics
 	LoadAverageStats LoadAverageStats `json:"loadAvgStats"`
+	// InterruptsStats is the interrupts distribution statistics
+	InterruptsStats InterruptsStats `json:"interruptsStats"`
+	// NetProtoStats is the network protocols statistics
+	NetProtoStats NetProtoStats `json:"netProtoStats"`
+	// LimitsStats is the kernel tables usage against the limits
+	LimitsStats LimitsStats `json:"limitsStats"`
+	// ProcessLimitsStats is the processes usage against their resource limits
+	ProcessLimitsStats ProcessLimitsStats `json:"processLimitsStats"`
+	// ThermalStats is the CPU frequency, throttling and temperatures statistics
+	ThermalStats ThermalStats `json:"thermalStats"`
+	// MDStatStats is the software RAID arrays status
+	MDStatStats MDStatStats `json:"mdStatStats"`
+	// ZFSArcStats is the ZFS ARC statistics
+	ZFSArcStats ZFSArcStats `json:"zfsArcStats"`
+	// TimeSyncStats is the system clock synchronisation status
+	TimeSyncStats TimeSyncStats `json:"timeSyncStats"`
+	// SessionsStats is the active login sessions
+	SessionsStats SessionsStats `json:"sessionsStats"`
+	// ProcStateStats is the processes and threads states summary
+	ProcStateStats ProcStateStats `json:"procStateStats"`
+	// SockStatStats is the sockets usage and network memory statistics
+	SockStatStats SockStatStats `json:"sockStatStats"`
+	// NetDevStats is the traffic of the network interfaces
+	NetDevStats NetDevStats `json:"netDevStats"`
+	// VMStatStats is the paging and swapping activity of the virtual memory
+	VMStatStats VMStatStats `json:"vmStatStats"`
+	// CustomStats is the metrics of the custom collectors parsed from the output of their commands
+	CustomStats []CustomStats `json:"customStats"`
+	// PluginStats is the samples collected by the external collector plugins
+	PluginStats []PluginStats `json:"pluginStats"`
+	// Collectors is the health of the metrics collectors
+	Collectors CollectorsStatus `json:"collectors"`
+	// CollectedAt is the time every metric was collected at by the collector names,
+	// the metrics of the collectors with the longer intervals may be collected before the tick
+	CollectedAt map[string]time.Time `json:"collectedAt"`
+	// Tick is the run of the metrics collection the metrics are collected on
+	Tick Tick `json:"tick"`
+	// Scheduler is the statistics of the ticks of the metrics collection
+	Scheduler SchedulerStats `json:"scheduler"`
+	// Samples is the statistics of all the collected metrics as the generic samples
+	Samples []Sample `json:"samples"`
 }
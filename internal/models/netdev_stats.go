@@ -0,0 +1,86 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils"
+)
+
+// fmtNetDev is the format for the network interfaces statistics.
+const fmtNetDev = "%-16s %-14s %-14s %-12s %-12s %-10s %-10s %-10s %-10s"
+
+// NetDevStats represents the traffic of the network interfaces.
+type NetDevStats struct {
+	// Interfaces shows the traffic of every network interface.
+	Interfaces []NetInterfaceStats `json:"interfaces"`
+}
+
+// NetInterfaceStats represents the traffic of the network interface.
+type NetInterfaceStats struct {
+	// Name shows the name of the interface like eth0.
+	Name string `json:"name"`
+	// RxBytes shows the number of bytes received per second.
+	RxBytes float64 `json:"rxBytes"`
+	// TxBytes shows the number of bytes transmitted per second.
+	TxBytes float64 `json:"txBytes"`
+	// RxPackets shows the number of packets received per second.
+	RxPackets float64 `json:"rxPackets"`
+	// TxPackets shows the number of packets transmitted per second.
+	TxPackets float64 `json:"txPackets"`
+	// RxErrors shows the number of receive errors per second.
+	RxErrors float64 `json:"rxErrors"`
+	// TxErrors shows the number of transmit errors per second.
+	TxErrors float64 `json:"txErrors"`
+	// RxDropped shows the number of received packets dropped per second.
+	RxDropped float64 `json:"rxDropped"`
+	// TxDropped shows the number of packets dropped on transmit per second.
+	TxDropped float64 `json:"txDropped"`
+}
+
+// String returns a string representation of the NetDevStats.
+func (n NetDevStats) String() string {
+	header := utils.BoldText(fmt.Sprintf(fmtNetDev,
+		"Interface", "Rx KB/s", "Tx KB/s", "Rx pkts/s", "Tx pkts/s", "Rx errs/s", "Tx errs/s", "Rx drop/s", "Tx drop/s",
+	))
+	rows := make([]string, 0, len(n.Interfaces))
+	for _, iface := range n.Interfaces {
+		rows = append(rows, fmt.Sprintf(fmtNetDev,
+			iface.Name,
+			fmt.Sprintf("%.2f", iface.RxBytes/1024),
+			fmt.Sprintf("%.2f", iface.TxBytes/1024),
+			fmt.Sprintf("%.2f", iface.RxPackets),
+			fmt.Sprintf("%.2f", iface.TxPackets),
+			fmt.Sprintf("%.2f", iface.RxErrors),
+			fmt.Sprintf("%.2f", iface.TxErrors),
+			fmt.Sprintf("%.2f", iface.RxDropped),
+			fmt.Sprintf("%.2f", iface.TxDropped),
+		))
+	}
+
+	return header + "\n" + utils.GrayText(strings.Join(rows, "\n"))
+}
+
+// Store stores the network interfaces statistics to the metrics of the system.
+func (n NetDevStats) Store(metrics *Metrics) {
+	metrics.NetDevStats = n
+}
+
+// Samples returns the network interfaces statistics as the generic samples.
+func (n NetDevStats) Samples() []Sample {
+	res := make([]Sample, 0, len(n.Interfaces)*8)
+	for _, iface := range n.Interfaces {
+		res = append(res,
+			rate("netdev_receive_bytes_per_second", UnitBytesPerSecond, iface.RxBytes, "interface", iface.Name),
+			rate("netdev_transmit_bytes_per_second", UnitBytesPerSecond, iface.TxBytes, "interface", iface.Name),
+			rate("netdev_receive_packets_per_second", UnitPerSecond, iface.RxPackets, "interface", iface.Name),
+			rate("netdev_transmit_packets_per_second", UnitPerSecond, iface.TxPackets, "interface", iface.Name),
+			rate("netdev_receive_errors_per_second", UnitPerSecond, iface.RxErrors, "interface", iface.Name),
+			rate("netdev_transmit_errors_per_second", UnitPerSecond, iface.TxErrors, "interface", iface.Name),
+			rate("netdev_receive_dropped_per_second", UnitPerSecond, iface.RxDropped, "interface", iface.Name),
+			rate("netdev_transmit_dropped_per_second", UnitPerSecond, iface.TxDropped, "interface", iface.Name),
+		)
+	}
+
+	return res
+}
@@ -0,0 +1,169 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils"
+)
+
+// fmtNetProtoStats is the format for the network protocols statistics.
+const fmtNetProtoStats = "%-14s %-14s %-14s %-14s %-14s %-14s %-14s"
+
+// NetProtoStats represents the network protocols statistics.
+type NetProtoStats struct {
+	// IP shows the IP statistics.
+	IP IPStats `json:"ip"`
+	// TCP shows the TCP statistics.
+	TCP TCPStats `json:"tcp"`
+	// UDP shows the UDP statistics.
+	UDP UDPStats `json:"udp"`
+	// ICMP shows the ICMP statistics.
+	ICMP ICMPStats `json:"icmp"`
+}
+
+// IPStats represents the IP statistics.
+type IPStats struct {
+	// InReceives shows the number of datagrams received per second.
+	InReceives float64 `json:"inReceives"`
+	// OutRequests shows the number of datagrams sent per second.
+	OutRequests float64 `json:"outRequests"`
+	// InDiscards shows the number of received datagrams discarded per second, e.g. for lack of buffer space.
+	InDiscards float64 `json:"inDiscards"`
+	// OutDiscards shows the number of datagrams to send discarded per second.
+	OutDiscards float64 `json:"outDiscards"`
+	// InHdrErrors shows the number of received datagrams discarded due to errors in their headers per second.
+	InHdrErrors float64 `json:"inHdrErrors"`
+}
+
+// TCPStats represents the TCP statistics.
+type TCPStats struct {
+	// CurrEstab shows the number of connections in the ESTABLISHED or CLOSE-WAIT state.
+	CurrEstab uint64 `json:"currEstab"`
+	// ActiveOpens shows the number of connections opened by the host per second.
+	ActiveOpens float64 `json:"activeOpens"`
+	// PassiveOpens shows the number of connections accepted by the host per second.
+	PassiveOpens float64 `json:"passiveOpens"`
+	// AttemptFails shows the number of failed connection attempts per second.
+	AttemptFails float64 `json:"attemptFails"`
+	// OutSegs shows the number of segments sent per second.
+	OutSegs float64 `json:"outSegs"`
+	// RetransSegs shows the number of segments retransmitted per second.
+	RetransSegs float64 `json:"retransSegs"`
+	// RetransPercent shows the percentage of the retransmitted segments among the sent ones.
+	RetransPercent float64 `json:"retransPercent"`
+	// ResetsSent shows the number of segments with the RST flag sent per second.
+	ResetsSent float64 `json:"resetsSent"`
+	// EstabResets shows the number of established connections reset per second
+	// by the host or by the peer, the RST segments received are not counted separately.
+	EstabResets float64 `json:"estabResets"`
+	// InErrs shows the number of segments received in error per second.
+	InErrs float64 `json:"inErrs"`
+	// ListenOverflows shows the number of times per second the accept queue of a listening socket overflowed.
+	ListenOverflows float64 `json:"listenOverflows"`
+	// ListenDrops shows the number of SYNs to listening sockets dropped per second.
+	ListenDrops float64 `json:"listenDrops"`
+}
+
+// UDPStats represents the UDP statistics.
+type UDPStats struct {
+	// InDatagrams shows the number of datagrams delivered to the sockets per second.
+	InDatagrams float64 `json:"inDatagrams"`
+	// OutDatagrams shows the number of datagrams sent per second.
+	OutDatagrams float64 `json:"outDatagrams"`
+	// NoPorts shows the number of datagrams received for the ports with no listener per second.
+	NoPorts float64 `json:"noPorts"`
+	// InErrors shows the number of datagrams that could not be delivered per second.
+	InErrors float64 `json:"inErrors"`
+	// RcvbufErrors shows the number of datagrams dropped due to the full receive buffer per second.
+	RcvbufErrors float64 `json:"rcvbufErrors"`
+	// SndbufErrors shows the number of datagrams dropped due to the full send buffer per second.
+	SndbufErrors float64 `json:"sndbufErrors"`
+}
+
+// ICMPStats represents the ICMP statistics.
+type ICMPStats struct {
+	// InMsgs shows the number of messages received per second.
+	InMsgs float64 `json:"inMsgs"`
+	// OutMsgs shows the number of messages sent per second.
+	OutMsgs float64 `json:"outMsgs"`
+	// InErrors shows the number of received messages with errors per second.
+	InErrors float64 `json:"inErrors"`
+	// OutErrors shows the number of messages not sent due to errors per second.
+	OutErrors float64 `json:"outErrors"`
+	// InDestUnreachs shows the number of "destination unreachable" messages received per second.
+	InDestUnreachs float64 `json:"inDestUnreachs"`
+}
+
+// String returns a string representation of the NetProtoStats.
+func (n NetProtoStats) String() string {
+	rate := func(v float64) string {
+		return utils.BeatifyNumber(v) + "/s"
+	}
+
+	tcpHeader := utils.BoldText(fmt.Sprintf(fmtNetProtoStats+"\n",
+		"TCP Estab", "Active Opens", "Passive Opens", "Retrans", "Retrans %", "Resets Sent", "Estab Resets",
+	))
+	tcpValues := utils.GrayText(fmt.Sprintf(fmtNetProtoStats+"\n",
+		utils.BeatifyNumber(n.TCP.CurrEstab),
+		rate(n.TCP.ActiveOpens),
+		rate(n.TCP.PassiveOpens),
+		rate(n.TCP.RetransSegs),
+		fmt.Sprintf("%.2f%%", n.TCP.RetransPercent),
+		rate(n.TCP.ResetsSent),
+		rate(n.TCP.EstabResets),
+	))
+
+	errHeader := utils.BoldText(fmt.Sprintf(fmtNetProtoStats+"\n",
+		"Listen Ovfl", "Listen Drops", "UDP RcvBuf Err", "UDP No Ports", "ICMP In Err", "ICMP Out Err", "IP In Discards",
+	))
+	errValues := utils.GrayText(fmt.Sprintf(fmtNetProtoStats,
+		rate(n.TCP.ListenOverflows),
+		rate(n.TCP.ListenDrops),
+		rate(n.UDP.RcvbufErrors),
+		rate(n.UDP.NoPorts),
+		rate(n.ICMP.InErrors),
+		rate(n.ICMP.OutErrors),
+		rate(n.IP.InDiscards),
+	))
+
+	return tcpHeader + tcpValues + errHeader + errValues
+}
+
+// Store stores the network protocols statistics to the metrics of the system.
+func (n NetProtoStats) Store(metrics *Metrics) {
+	metrics.NetProtoStats = n
+}
+
+// Samples returns the network protocols statistics as the generic samples.
+func (n NetProtoStats) Samples() []Sample {
+	return []Sample{
+		rate("netproto_ip_in_receives_per_second", UnitPerSecond, n.IP.InReceives),
+		rate("netproto_ip_out_requests_per_second", UnitPerSecond, n.IP.OutRequests),
+		rate("netproto_ip_in_discards_per_second", UnitPerSecond, n.IP.InDiscards),
+		rate("netproto_ip_out_discards_per_second", UnitPerSecond, n.IP.OutDiscards),
+		rate("netproto_ip_in_hdr_errors_per_second", UnitPerSecond, n.IP.InHdrErrors),
+		gauge("netproto_tcp_curr_estab", UnitCount, float64(n.TCP.CurrEstab)),
+		rate("netproto_tcp_active_opens_per_second", UnitPerSecond, n.TCP.ActiveOpens),
+		rate("netproto_tcp_passive_opens_per_second", UnitPerSecond, n.TCP.PassiveOpens),
+		rate("netproto_tcp_attempt_fails_per_second", UnitPerSecond, n.TCP.AttemptFails),
+		rate("netproto_tcp_out_segs_per_second", UnitPerSecond, n.TCP.OutSegs),
+		rate("netproto_tcp_retrans_segs_per_second", UnitPerSecond, n.TCP.RetransSegs),
+		gauge("netproto_tcp_retrans_percent", UnitPercent, n.TCP.RetransPercent),
+		rate("netproto_tcp_resets_sent_per_second", UnitPerSecond, n.TCP.ResetsSent),
+		rate("netproto_tcp_estab_resets_per_second", UnitPerSecond, n.TCP.EstabResets),
+		rate("netproto_tcp_in_errs_per_second", UnitPerSecond, n.TCP.InErrs),
+		rate("netproto_tcp_listen_overflows_per_second", UnitPerSecond, n.TCP.ListenOverflows),
+		rate("netproto_tcp_listen_drops_per_second", UnitPerSecond, n.TCP.ListenDrops),
+		rate("netproto_udp_in_datagrams_per_second", UnitPerSecond, n.UDP.InDatagrams),
+		rate("netproto_udp_out_datagrams_per_second", UnitPerSecond, n.UDP.OutDatagrams),
+		rate("netproto_udp_no_ports_per_second", UnitPerSecond, n.UDP.NoPorts),
+		rate("netproto_udp_in_errors_per_second", UnitPerSecond, n.UDP.InErrors),
+		rate("netproto_udp_rcvbuf_errors_per_second", UnitPerSecond, n.UDP.RcvbufErrors),
+		rate("netproto_udp_sndbuf_errors_per_second", UnitPerSecond, n.UDP.SndbufErrors),
+		rate("netproto_icmp_in_msgs_per_second", UnitPerSecond, n.ICMP.InMsgs),
+		rate("netproto_icmp_out_msgs_per_second", UnitPerSecond, n.ICMP.OutMsgs),
+		rate("netproto_icmp_in_errors_per_second", UnitPerSecond, n.ICMP.InErrors),
+		rate("netproto_icmp_out_errors_per_second", UnitPerSecond, n.ICMP.OutErrors),
+		rate("netproto_icmp_in_dest_unreachs_per_second", UnitPerSecond, n.ICMP.InDestUnreachs),
+	}
+}
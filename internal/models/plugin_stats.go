@@ -0,0 +1,79 @@
+package models
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils"
+)
+
+// fmtPluginSample is the format for the samples of the plugins.
+const fmtPluginSample = "%-48s %-16s %-16s"
+
+// PluginStats represents the samples collected by the external collector plugin.
+type PluginStats struct {
+	// Plugin shows the name of the plugin.
+	Plugin string `json:"plugin"`
+	// Collected shows the samples collected by the plugin.
+	Collected []Sample `json:"collected"`
+}
+
+// String returns a string representation of the PluginStats.
+func (s PluginStats) String() string {
+	header := utils.BoldText(fmt.Sprintf(fmtPluginSample, "Sample", "Value", "Unit"))
+	if len(s.Collected) == 0 {
+		return header + "\n" + utils.GrayText("No samples")
+	}
+
+	rows := make([]string, 0, len(s.Collected))
+	for _, sample := range s.Collected {
+		rows = append(rows, fmt.Sprintf(fmtPluginSample,
+			sampleTitle(sample), strconv.FormatFloat(sample.Value, 'f', -1, 64), sample.Unit,
+		))
+	}
+
+	return header + "\n" + utils.GrayText(strings.Join(rows, "\n"))
+}
+
+// Store stores the plugin samples to the metrics of the system next to the other plugins.
+func (s PluginStats) Store(metrics *Metrics) {
+	metrics.PluginStats = append(metrics.PluginStats, s)
+}
+
+// Samples returns the samples of the plugin labelled with the plugin name.
+func (s PluginStats) Samples() []Sample {
+	res := make([]Sample, 0, len(s.Collected))
+	for _, sample := range s.Collected {
+		labels := make(map[string]string, len(sample.Labels)+1)
+		maps.Copy(labels, sample.Labels)
+		labels["plugin"] = s.Plugin
+		sample.Labels = labels
+
+		res = append(res, sample)
+	}
+
+	return res
+}
+
+// sampleTitle returns the name of the sample with the labels like disk_used_bytes{mount="/data"}.
+func sampleTitle(s Sample) string {
+	if len(s.Labels) == 0 {
+		return s.Name
+	}
+
+	keys := make([]string, 0, len(s.Labels))
+	for k := range s.Labels {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	labels := make([]string, 0, len(keys))
+	for _, k := range keys {
+		labels = append(labels, fmt.Sprintf("%s=%q", k, s.Labels[k]))
+	}
+
+	return s.Name + "{" + strings.Join(labels, ",") + "}"
+}
@@ -0,0 +1,109 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils"
+)
+
+// fmtProcessLimitsStats is the format for the processes limits statistics.
+const fmtProcessLimitsStats = "%-8s %-16s %-24s %-24s %-24s"
+
+// ProcessLimitsStats represents the processes usage against their resource limits.
+type ProcessLimitsStats struct {
+	// Processes shows the watched processes and the ones closest to their limits.
+	Processes []ProcessLimits `json:"processes"`
+}
+
+// ProcessLimits represents the usage of the single process against its soft limits.
+type ProcessLimits struct {
+	// PID shows the process ID.
+	PID int `json:"pid"`
+	// Name shows the process name.
+	Name string `json:"name"`
+	// Watched shows whether the process is watched by the configuration.
+	Watched bool `json:"watched"`
+	// OpenFiles shows the open file descriptors against the open files limit.
+	OpenFiles LimitUsage `json:"openFiles"`
+	// Processes shows the threads of the process user against the processes limit,
+	// since the limit applies to all the processes and threads of the user.
+	Processes LimitUsage `json:"processes"`
+	// LockedMemoryKb shows the locked memory in KB against the locked memory limit.
+	LockedMemoryKb LimitUsage `json:"lockedMemoryKb"`
+	// MaxUsedPercent shows the utilisation of the limit the process is closest to.
+	MaxUsedPercent float64 `json:"maxUsedPercent"`
+}
+
+// String returns a string representation of the ProcessLimitsStats.
+func (s ProcessLimitsStats) String() string {
+	header := utils.BoldText(fmt.Sprintf(fmtProcessLimitsStats,
+		"PID", "Name", "Open Files", "User Processes", "Locked Memory",
+	))
+
+	rows := make([]string, 0, len(s.Processes))
+	for _, p := range s.Processes {
+		name := p.Name
+		if p.Watched {
+			name += "*"
+		}
+		rows = append(rows, fmt.Sprintf(fmtProcessLimitsStats,
+			fmt.Sprint(p.PID),
+			name,
+			p.OpenFiles.usage(""),
+			p.Processes.usage(""),
+			p.LockedMemoryKb.usage(" KB"),
+		))
+	}
+
+	return header + "\n" + utils.GrayText(strings.Join(rows, "\n"))
+}
+
+// usage returns a string representation of the limit usage like "10/1_024 (0.98%)".
+func (u LimitUsage) usage(unit string) string {
+	if !u.Available {
+		return utils.BeatifyNumber(u.Used) + unit + "/unlimited"
+	}
+
+	return fmt.Sprintf("%s/%s%s (%.2f%%)",
+		utils.BeatifyNumber(u.Used), utils.BeatifyNumber(u.Max), unit, u.UsedPercent,
+	)
+}
+
+// Store stores the processes limits usage to the metrics of the system.
+func (s ProcessLimitsStats) Store(metrics *Metrics) {
+	metrics.ProcessLimitsStats = s
+}
+
+// Samples returns the processes limits usage as the generic samples.
+func (s ProcessLimitsStats) Samples() []Sample {
+	var res []Sample
+	for _, p := range s.Processes {
+		pid := strconv.Itoa(p.PID)
+		for _, limit := range []struct {
+			name  string
+			unit  string
+			scale float64
+			usage LimitUsage
+		}{
+			{"open_files", UnitCount, 1, p.OpenFiles},
+			{"processes", UnitCount, 1, p.Processes},
+			{"locked_memory", UnitBytes, 1024, p.LockedMemoryKb},
+		} {
+			if !limit.usage.Available {
+				continue
+			}
+			res = append(res,
+				gauge("proclimits_used", limit.unit, float64(limit.usage.Used)*limit.scale,
+					"pid", pid, "name", p.Name, "limit", limit.name),
+				gauge("proclimits_max", limit.unit, float64(limit.usage.Max)*limit.scale,
+					"pid", pid, "name", p.Name, "limit", limit.name),
+				gauge("proclimits_used_percent", UnitPercent, limit.usage.UsedPercent,
+					"pid", pid, "name", p.Name, "limit", limit.name),
+			)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,164 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils"
+)
+
+// fmtStateCounts is the format for the counts of the processes and threads by their states.
+const fmtStateCounts = "%-10s %-10s %-10s %-10s %-10s %-10s %-10s %-10s"
+
+// ProcStateStats represents the summary of the processes and threads states.
+type ProcStateStats struct {
+	// Processes shows the number of the processes by their states.
+	Processes StateCounts `json:"processes"`
+	// Threads shows the number of the threads by their states.
+	Threads StateCounts `json:"threads"`
+	// TotalProcesses shows the total number of the processes.
+	TotalProcesses int `json:"totalProcesses"`
+	// TotalThreads shows the total number of the threads of all the processes.
+	TotalThreads int `json:"totalThreads"`
+	// ZombieParents shows the processes not reaping their zombie children.
+	ZombieParents []ZombieParent `json:"zombieParents"`
+	// Blocked shows the threads staying in the uninterruptible sleep for long.
+	Blocked []BlockedTask `json:"blocked"`
+}
+
+// StateCounts represents the number of the tasks by their states.
+type StateCounts struct {
+	// Running shows the number of the tasks running or runnable (R).
+	Running int `json:"running"`
+	// Sleeping shows the number of the tasks in the interruptible sleep (S).
+	Sleeping int `json:"sleeping"`
+	// DiskSleep shows the number of the tasks in the uninterruptible sleep, usually waiting for I/O (D).
+	DiskSleep int `json:"diskSleep"`
+	// Zombie shows the number of the terminated tasks not reaped by their parents (Z).
+	Zombie int `json:"zombie"`
+	// Stopped shows the number of the tasks stopped by a signal or a debugger (T, t).
+	Stopped int `json:"stopped"`
+	// Idle shows the number of the idle kernel threads (I).
+	Idle int `json:"idle"`
+}
+
+// ZombieParent represents the process with the zombie children.
+type ZombieParent struct {
+	// PID shows the PID of the parent process.
+	PID int `json:"pid"`
+	// Name shows the name of the parent process.
+	Name string `json:"name"`
+	// Zombies shows the number of the zombie children.
+	Zombies int `json:"zombies"`
+}
+
+// BlockedTask represents the thread staying in the uninterruptible sleep.
+type BlockedTask struct {
+	// TID shows the ID of the thread.
+	TID int `json:"tid"`
+	// Name shows the name of the thread.
+	Name string `json:"name"`
+	// BlockedSec shows the time the thread is seen in the uninterruptible sleep for in seconds.
+	BlockedSec float64 `json:"blockedSec"`
+}
+
+// Add counts the task in the provided state of the proc stat file.
+func (c *StateCounts) Add(state byte) {
+	switch state {
+	case 'R':
+		c.Running++
+	case 'S':
+		c.Sleeping++
+	case 'D':
+		c.DiskSleep++
+	case 'Z':
+		c.Zombie++
+	case 'T', 't':
+		c.Stopped++
+	case 'I':
+		c.Idle++
+	}
+}
+
+// String returns a string representation of the ProcStateStats.
+func (s ProcStateStats) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(utils.BoldText(fmt.Sprintf(fmtStateCounts,
+		"", "Total", "Running", "Sleeping", "Blocked", "Zombie", "Stopped", "Idle",
+	)))
+	sb.WriteString("\n" + utils.GrayText(s.Processes.row("Processes", s.TotalProcesses)))
+	sb.WriteString("\n" + utils.GrayText(s.Threads.row("Threads", s.TotalThreads)))
+
+	if len(s.ZombieParents) > 0 {
+		parents := make([]string, 0, len(s.ZombieParents))
+		for _, p := range s.ZombieParents {
+			parents = append(parents, fmt.Sprintf("%s (%d): %d", p.Name, p.PID, p.Zombies))
+		}
+		sb.WriteString("\n\n" + utils.BoldText("Zombie parents: "))
+		sb.WriteString(utils.GrayText(strings.Join(parents, ", ")))
+	}
+
+	if len(s.Blocked) > 0 {
+		tasks := make([]string, 0, len(s.Blocked))
+		for _, t := range s.Blocked {
+			tasks = append(tasks, fmt.Sprintf("%s (%d): %.0fs", t.Name, t.TID, t.BlockedSec))
+		}
+		sb.WriteString("\n\n" + utils.BoldText("Blocked for long: "))
+		sb.WriteString(utils.GrayText(strings.Join(tasks, ", ")))
+	}
+
+	return sb.String()
+}
+
+// row returns a table row of the state counts.
+func (c StateCounts) row(name string, total int) string {
+	return fmt.Sprintf(fmtStateCounts,
+		name,
+		utils.BeatifyNumber(total),
+		utils.BeatifyNumber(c.Running),
+		utils.BeatifyNumber(c.Sleeping),
+		utils.BeatifyNumber(c.DiskSleep),
+		utils.BeatifyNumber(c.Zombie),
+		utils.BeatifyNumber(c.Stopped),
+		utils.BeatifyNumber(c.Idle),
+	)
+}
+
+// Store stores the processes states summary to the metrics of the system.
+func (s ProcStateStats) Store(metrics *Metrics) {
+	metrics.ProcStateStats = s
+}
+
+// Samples returns the processes states summary as the generic samples.
+func (s ProcStateStats) Samples() []Sample {
+	res := []Sample{
+		gauge("procstate_processes_total", UnitCount, float64(s.TotalProcesses)),
+		gauge("procstate_threads_total", UnitCount, float64(s.TotalThreads)),
+	}
+	res = append(res, s.Processes.samples("procstate_processes")...)
+	res = append(res, s.Threads.samples("procstate_threads")...)
+	for _, p := range s.ZombieParents {
+		res = append(res, gauge("procstate_zombie_children", UnitCount, float64(p.Zombies),
+			"pid", strconv.Itoa(p.PID), "name", p.Name))
+	}
+	for _, t := range s.Blocked {
+		res = append(res, gauge("procstate_blocked_seconds", UnitSeconds, t.BlockedSec,
+			"tid", strconv.Itoa(t.TID), "name", t.Name))
+	}
+
+	return res
+}
+
+// samples returns the samples of the number of the tasks by their states.
+func (c StateCounts) samples(name string) []Sample {
+	return []Sample{
+		gauge(name, UnitCount, float64(c.Running), "state", "running"),
+		gauge(name, UnitCount, float64(c.Sleeping), "state", "sleeping"),
+		gauge(name, UnitCount, float64(c.DiskSleep), "state", "disk_sleep"),
+		gauge(name, UnitCount, float64(c.Zombie), "state", "zombie"),
+		gauge(name, UnitCount, float64(c.Stopped), "state", "stopped"),
+		gauge(name, UnitCount, float64(c.Idle), "state", "idle"),
+	}
+}
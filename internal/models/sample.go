@@ -0,0 +1,104 @@
+package models
+
+import "time"
+
+// SampleType is the type of the sample value.
+type SampleType string
+
+const (
+	// SampleTypeGauge is the type of the value that can go up and down like the memory used.
+	SampleTypeGauge SampleType = "gauge"
+	// SampleTypeCounter is the type of the value that only grows until reset like the number of drops.
+	SampleTypeCounter SampleType = "counter"
+	// SampleTypeRate is the type of the value of a counter change per second.
+	SampleTypeRate SampleType = "rate"
+)
+
+const (
+	// UnitCount is the unit of the number of things.
+	UnitCount = "count"
+	// UnitPercent is the unit of the percentage.
+	UnitPercent = "percent"
+	// UnitRatio is the unit of the ratio of two values.
+	UnitRatio = "ratio"
+	// UnitBytes is the unit of the size in bytes.
+	UnitBytes = "bytes"
+	// UnitBytesPerSecond is the unit of the throughput in bytes.
+	UnitBytesPerSecond = "bytes_per_second"
+	// UnitPerSecond is the unit of the number of events per second.
+	UnitPerSecond = "per_second"
+	// UnitSeconds is the unit of the duration in seconds.
+	UnitSeconds = "seconds"
+	// UnitHertz is the unit of the frequency.
+	UnitHertz = "hertz"
+	// UnitCelsius is the unit of the temperature in degrees Celsius.
+	UnitCelsius = "celsius"
+	// UnitPPM is the unit of the parts per million.
+	UnitPPM = "ppm"
+	// UnitBool is the unit of the flag: 1 is true and 0 is false.
+	UnitBool = "bool"
+)
+
+// Sample defines the single named value of a metric with the labels
+// like disk_used_bytes{mount="/data"}.
+type Sample struct {
+	// Name is the name of the sample like memory_used_bytes.
+	Name string `json:"name"`
+	// Labels is the labels telling apart the samples of the same name like the CPU or the device.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Unit is the unit of the value like bytes.
+	Unit string `json:"unit"`
+	// Type is the type of the value: gauge, counter or rate.
+	Type SampleType `json:"type"`
+	// Value is the value of the sample.
+	Value float64 `json:"value"`
+	// Timestamp is the time the sample was collected at.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// gauge returns the gauge sample with the labels provided as the name and value pairs.
+func gauge(name, unit string, value float64, labels ...string) Sample {
+	return newSample(SampleTypeGauge, name, unit, value, labels...)
+}
+
+// counter returns the counter sample with the labels provided as the name and value pairs.
+func counter(name, unit string, value float64, labels ...string) Sample {
+	return newSample(SampleTypeCounter, name, unit, value, labels...)
+}
+
+// rate returns the rate sample with the labels provided as the name and value pairs.
+func rate(name, unit string, value float64, labels ...string) Sample {
+	return newSample(SampleTypeRate, name, unit, value, labels...)
+}
+
+// newSample returns the sample with the labels provided as the name and value pairs.
+func newSample(typ SampleType, name, unit string, value float64, labels ...string) Sample {
+	s := Sample{
+		Name:  name,
+		Unit:  unit,
+		Type:  typ,
+		Value: value,
+	}
+	if len(labels) > 1 {
+		s.Labels = make(map[string]string, len(labels)/2)
+		for i := 0; i+1 < len(labels); i += 2 {
+			s.Labels[labels[i]] = labels[i+1]
+		}
+	}
+
+	return s
+}
+
+// boolValue converts the flag to the sample value.
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+// mbToBytes converts the size in MB to bytes.
+func mbToBytes(mb uint64) float64 {
+	return float64(mb) * 1024 * 1024
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils"
+)
+
+// Tick represents the single run of the metrics collection.
+type Tick struct {
+	// Seq is the sequence number of the tick. The gaps show the ticks skipped or coalesced.
+	Seq uint64 `json:"seq"`
+	// Time is the wall-clock aligned time the tick is scheduled at.
+	Time time.Time `json:"time"`
+	// Started is the time the tick actually started at.
+	Started time.Time `json:"started"`
+	// Coalesced is the number of the ticks merged into this one since the previous tick overran.
+	Coalesced uint64 `json:"coalesced"`
+}
+
+// Jitter returns the delay of the tick start from the time it is scheduled at.
+func (t Tick) Jitter() time.Duration {
+	return t.Started.Sub(t.Time)
+}
+
+// SchedulerStats represents the statistics of the ticks of the metrics collection.
+type SchedulerStats struct {
+	// Policy is the policy of the ticks fired while the previous one is still running: skip, queue or coalesce.
+	Policy string `json:"policy"`
+	// Scheduled is the number of the ticks scheduled.
+	Scheduled uint64 `json:"scheduled"`
+	// Run is the number of the ticks run.
+	Run uint64 `json:"run"`
+	// Skipped is the number of the ticks dropped since the previous one overran or the queue was full.
+	Skipped uint64 `json:"skipped"`
+	// Coalesced is the number of the ticks merged into the next ones since the previous one overran.
+	Coalesced uint64 `json:"coalesced"`
+	// Overruns is the number of the ticks lasted longer than the interval.
+	Overruns uint64 `json:"overruns"`
+	// LastJitter is the delay of the last tick start from the time it was scheduled at.
+	LastJitter time.Duration `json:"lastJitter"`
+	// MeanJitter is the mean delay of the ticks start.
+	MeanJitter time.Duration `json:"meanJitter"`
+	// MaxJitter is the maximum delay of the ticks start.
+	MaxJitter time.Duration `json:"maxJitter"`
+}
+
+// String returns a string representation of the SchedulerStats.
+func (s SchedulerStats) String() string {
+	return utils.GrayText(fmt.Sprintf(
+		"Ticks: %s run, %s skipped, %s coalesced, %s overran (%s); jitter: last %s, mean %s, max %s",
+		utils.BeatifyNumber(s.Run),
+		utils.BeatifyNumber(s.Skipped),
+		utils.BeatifyNumber(s.Coalesced),
+		utils.BeatifyNumber(s.Overruns),
+		s.Policy,
+		s.LastJitter.Round(time.Microsecond),
+		s.MeanJitter.Round(time.Microsecond),
+		s.MaxJitter.Round(time.Microsecond),
+	))
+}
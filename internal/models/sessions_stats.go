@@ -0,0 +1,81 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils"
+)
+
+// fmtSessions is the format for the login sessions.
+const fmtSessions = "%-16s %-10s %-24s %-20s"
+
+// SessionsStats represents the active login sessions of the system.
+type SessionsStats struct {
+	// Sessions shows the active login sessions.
+	Sessions []Session `json:"sessions"`
+	// PerUser shows the number of the sessions by the user names.
+	PerUser map[string]int `json:"perUser"`
+}
+
+// Session represents the login session of the user.
+type Session struct {
+	// User shows the name of the logged-in user.
+	User string `json:"user"`
+	// TTY shows the terminal of the session like pts/0.
+	TTY string `json:"tty"`
+	// Host shows the remote host the user logged in from, empty for the local sessions.
+	Host string `json:"host"`
+	// PID shows the PID of the login process.
+	PID int `json:"pid"`
+	// LoginTime shows the time the user logged in at.
+	LoginTime time.Time `json:"loginTime"`
+}
+
+// String returns a string representation of the SessionsStats.
+func (s SessionsStats) String() string {
+	var sb strings.Builder
+
+	users := make([]string, 0, len(s.PerUser))
+	for user, n := range s.PerUser {
+		users = append(users, fmt.Sprintf("%s: %d", user, n))
+	}
+	sort.Strings(users)
+	sb.WriteString(utils.BoldText("Sessions per user: "))
+	sb.WriteString(utils.GrayText(strings.Join(users, ", ")))
+	sb.WriteString("\n\n")
+
+	sb.WriteString(utils.BoldText(fmt.Sprintf(fmtSessions, "User", "TTY", "From", "Login time")))
+	rows := make([]string, 0, len(s.Sessions))
+	for _, session := range s.Sessions {
+		host := session.Host
+		if host == "" {
+			host = "-"
+		}
+		rows = append(rows, fmt.Sprintf(fmtSessions,
+			session.User, session.TTY, host, session.LoginTime.Local().Format(time.DateTime),
+		))
+	}
+	sb.WriteString("\n" + utils.GrayText(strings.Join(rows, "\n")))
+
+	return sb.String()
+}
+
+// Store stores the login sessions to the metrics of the system.
+func (s SessionsStats) Store(metrics *Metrics) {
+	metrics.SessionsStats = s
+}
+
+// Samples returns the login sessions as the generic samples.
+func (s SessionsStats) Samples() []Sample {
+	res := []Sample{
+		gauge("sessions_total", UnitCount, float64(len(s.Sessions))),
+	}
+	for user, n := range s.PerUser {
+		res = append(res, gauge("sessions_per_user", UnitCount, float64(n), "user", user))
+	}
+
+	return res
+}
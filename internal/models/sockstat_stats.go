@@ -0,0 +1,157 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils"
+)
+
+// fmtSockStatStats is the format for the sockets usage statistics.
+const fmtSockStatStats = "%-10s %-10s %-10s %-10s %-10s %-14s %-10s %-10s"
+
+// SockStatStats represents the sockets usage and the network memory statistics.
+type SockStatStats struct {
+	// SocketsUsed shows the number of the sockets of all the protocols in use.
+	SocketsUsed uint64 `json:"socketsUsed"`
+	// TCP shows the TCP sockets usage.
+	TCP SockStatTCP `json:"tcp"`
+	// UDP shows the UDP sockets usage.
+	UDP SockStatUDP `json:"udp"`
+	// RawInUse shows the number of the IPv4 and IPv6 raw sockets in use.
+	RawInUse uint64 `json:"rawInUse"`
+	// UDPDrops shows the UDP sockets with the most datagrams dropped.
+	UDPDrops []UDPSocketDrops `json:"udpDrops"`
+}
+
+// SockStatTCP represents the TCP sockets usage.
+type SockStatTCP struct {
+	// InUse shows the number of the IPv4 TCP sockets in use.
+	InUse uint64 `json:"inUse"`
+	// InUse6 shows the number of the IPv6 TCP sockets in use.
+	InUse6 uint64 `json:"inUse6"`
+	// Orphan shows the number of the TCP sockets not attached to any file descriptor.
+	Orphan uint64 `json:"orphan"`
+	// TimeWait shows the number of the TCP sockets in the TIME_WAIT state.
+	TimeWait uint64 `json:"timeWait"`
+	// Alloc shows the number of the allocated TCP sockets.
+	Alloc uint64 `json:"alloc"`
+	// Memory shows the memory used by the TCP sockets against tcp_mem.
+	Memory SockMemory `json:"memory"`
+}
+
+// SockStatUDP represents the UDP sockets usage.
+type SockStatUDP struct {
+	// InUse shows the number of the IPv4 UDP sockets in use.
+	InUse uint64 `json:"inUse"`
+	// InUse6 shows the number of the IPv6 UDP sockets in use.
+	InUse6 uint64 `json:"inUse6"`
+	// Memory shows the memory used by the UDP sockets against udp_mem.
+	Memory SockMemory `json:"memory"`
+}
+
+// SockMemory represents the memory used by the protocol sockets against its limits.
+type SockMemory struct {
+	// UsedPages shows the memory used in pages.
+	UsedPages uint64 `json:"usedPages"`
+	// UsedBytes shows the memory used in bytes.
+	UsedBytes uint64 `json:"usedBytes"`
+	// PressurePages shows the threshold the kernel starts moderating the memory allocation above in pages.
+	PressurePages uint64 `json:"pressurePages"`
+	// MaxPages shows the maximum memory in pages.
+	MaxPages uint64 `json:"maxPages"`
+	// UsedPercent shows the used memory against the maximum in percentage.
+	UsedPercent float64 `json:"usedPercent"`
+	// UnderPressure shows whether the memory used is above the pressure threshold.
+	UnderPressure bool `json:"underPressure"`
+}
+
+// UDPSocketDrops represents the UDP socket with the datagrams dropped.
+type UDPSocketDrops struct {
+	// LocalAddress shows the local address of the socket like 127.0.0.1:53.
+	LocalAddress string `json:"localAddress"`
+	// Inode shows the inode of the socket to find the process owning it.
+	Inode uint64 `json:"inode"`
+	// Drops shows the number of the datagrams dropped since the socket was created.
+	Drops uint64 `json:"drops"`
+	// RxQueueBytes shows the size of the receive queue in bytes.
+	RxQueueBytes uint64 `json:"rxQueueBytes"`
+}
+
+// String returns a string representation of the SockStatStats.
+func (s SockStatStats) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(utils.BoldText(fmt.Sprintf(fmtSockStatStats,
+		"Sockets", "TCP", "TCP6", "Orphan", "TIME_WAIT", "TCP mem", "UDP", "UDP mem",
+	)))
+	sb.WriteString("\n" + utils.GrayText(fmt.Sprintf(fmtSockStatStats,
+		utils.BeatifyNumber(s.SocketsUsed),
+		utils.BeatifyNumber(s.TCP.InUse),
+		utils.BeatifyNumber(s.TCP.InUse6),
+		utils.BeatifyNumber(s.TCP.Orphan),
+		utils.BeatifyNumber(s.TCP.TimeWait),
+		s.TCP.Memory.usage(),
+		utils.BeatifyNumber(s.UDP.InUse+s.UDP.InUse6),
+		s.UDP.Memory.usage(),
+	)))
+
+	if len(s.UDPDrops) > 0 {
+		drops := make([]string, 0, len(s.UDPDrops))
+		for _, d := range s.UDPDrops {
+			drops = append(drops, fmt.Sprintf("%s: %s", d.LocalAddress, utils.BeatifyNumber(d.Drops)))
+		}
+		sb.WriteString("\n\n" + utils.BoldText("UDP drops: "))
+		sb.WriteString(utils.GrayText(strings.Join(drops, ", ")))
+	}
+
+	return sb.String()
+}
+
+// usage returns the memory used in percentage with the pressure mark.
+func (m SockMemory) usage() string {
+	s := fmt.Sprintf("%.2f%%", m.UsedPercent)
+	if m.UnderPressure {
+		s += " (!)"
+	}
+
+	return s
+}
+
+// Store stores the sockets usage statistics to the metrics of the system.
+func (s SockStatStats) Store(metrics *Metrics) {
+	metrics.SockStatStats = s
+}
+
+// Samples returns the sockets usage statistics as the generic samples.
+func (s SockStatStats) Samples() []Sample {
+	res := []Sample{
+		gauge("sockstat_sockets_used", UnitCount, float64(s.SocketsUsed)),
+		gauge("sockstat_tcp_inuse", UnitCount, float64(s.TCP.InUse), "family", "ipv4"),
+		gauge("sockstat_tcp_inuse", UnitCount, float64(s.TCP.InUse6), "family", "ipv6"),
+		gauge("sockstat_tcp_orphan", UnitCount, float64(s.TCP.Orphan)),
+		gauge("sockstat_tcp_time_wait", UnitCount, float64(s.TCP.TimeWait)),
+		gauge("sockstat_tcp_alloc", UnitCount, float64(s.TCP.Alloc)),
+		gauge("sockstat_udp_inuse", UnitCount, float64(s.UDP.InUse), "family", "ipv4"),
+		gauge("sockstat_udp_inuse", UnitCount, float64(s.UDP.InUse6), "family", "ipv6"),
+		gauge("sockstat_raw_inuse", UnitCount, float64(s.RawInUse)),
+	}
+	res = append(res, s.TCP.Memory.samples("tcp")...)
+	res = append(res, s.UDP.Memory.samples("udp")...)
+	for _, d := range s.UDPDrops {
+		res = append(res, counter("sockstat_udp_drops", UnitCount, float64(d.Drops),
+			"address", d.LocalAddress, "inode", strconv.FormatUint(d.Inode, 10)))
+	}
+
+	return res
+}
+
+// samples returns the samples of the memory used by the protocol sockets.
+func (m SockMemory) samples(proto string) []Sample {
+	return []Sample{
+		gauge("sockstat_memory_used_bytes", UnitBytes, float64(m.UsedBytes), "proto", proto),
+		gauge("sockstat_memory_used_percent", UnitPercent, m.UsedPercent, "proto", proto),
+		gauge("sockstat_memory_under_pressure", UnitBool, boolValue(m.UnderPressure), "proto", proto),
+	}
+}
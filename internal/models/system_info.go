@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// SystemInfo represents the hardware and OS inventory of the system.
+type SystemInfo struct {
+	// Hostname shows the name of the host.
+	Hostname string `json:"hostname"`
+	// OS shows the operating system name.
+	OS string `json:"os"`
+	// Arch shows the architecture of the system.
+	Arch string `json:"arch"`
+	// KernelRelease shows the release of the kernel.
+	KernelRelease string `json:"kernelRelease"`
+	// Distro shows the name and the version of the distribution.
+	Distro string `json:"distro"`
+	// CPUModel shows the model name of the CPU.
+	CPUModel string `json:"cpuModel"`
+	// CPUSockets shows the number of the physical CPU packages.
+	CPUSockets int `json:"cpuSockets"`
+	// CPUCores shows the number of the physical cores of all the CPU packages.
+	CPUCores int `json:"cpuCores"`
+	// CPUThreads shows the number of the logical CPUs.
+	CPUThreads int `json:"cpuThreads"`
+	// TotalMemoryMb shows the total memory in MB.
+	TotalMemoryMb uint64 `json:"totalMemoryMb"`
+	// BootTime shows the time the system was booted at.
+	BootTime time.Time `json:"bootTime"`
+	// Uptime shows the time passed since the boot.
+	Uptime time.Duration `json:"uptime"`
+	// Vendor shows the vendor of the system from DMI.
+	Vendor string `json:"vendor"`
+	// Product shows the product name of the system from DMI.
+	Product string `json:"product"`
+	// MachineID shows the unique ID of the system installation.
+	MachineID string `json:"machineId"`
+}
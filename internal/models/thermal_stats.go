@@ -0,0 +1,123 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils"
+)
+
+const (
+	// SensorSourceHwmon is the source of the sensors of the hardware monitoring chips.
+	SensorSourceHwmon = "hwmon"
+	// SensorSourceThermal is the source of the sensors of the thermal zones.
+	SensorSourceThermal = "thermal"
+)
+
+// fmtSensors is the format for the temperature sensors.
+const fmtSensors = "%-10s %-20s %-24s %-10s %-10s"
+
+// ThermalStats represents the CPU frequency, throttling and temperatures of the system.
+type ThermalStats struct {
+	// CPUs shows the frequency and throttling of every CPU.
+	CPUs []CPUFreq `json:"cpus"`
+	// Sensors shows the temperature sensors.
+	Sensors []Sensor `json:"sensors"`
+}
+
+// CPUFreq represents the frequency and throttling of the single CPU.
+type CPUFreq struct {
+	// CPU shows the index of the CPU.
+	CPU int `json:"cpu"`
+	// CurMHz shows the current frequency in MHz.
+	CurMHz float64 `json:"curMhz"`
+	// MinMHz shows the minimum frequency supported by the hardware in MHz.
+	MinMHz float64 `json:"minMhz"`
+	// MaxMHz shows the maximum frequency supported by the hardware in MHz.
+	MaxMHz float64 `json:"maxMhz"`
+	// CoreThrottles shows the number of times the core was throttled due to the high temperature.
+	CoreThrottles uint64 `json:"coreThrottles"`
+	// PackageThrottles shows the number of times the package of the core was throttled due to the high temperature.
+	PackageThrottles uint64 `json:"packageThrottles"`
+}
+
+// Sensor represents the temperature sensor.
+type Sensor struct {
+	// Source shows where the sensor is read from: hwmon or thermal.
+	Source string `json:"source"`
+	// Chip shows the name of the hwmon chip or the type of the thermal zone.
+	Chip string `json:"chip"`
+	// Label shows the label of the sensor.
+	Label string `json:"label"`
+	// TempC shows the temperature in degrees Celsius.
+	TempC float64 `json:"tempC"`
+	// CritC shows the critical temperature in degrees Celsius, 0 if unknown.
+	CritC float64 `json:"critC"`
+}
+
+// String returns a string representation of the ThermalStats.
+func (t ThermalStats) String() string {
+	var sb strings.Builder
+
+	var cur, minCur, maxCur float64
+	var throttles uint64
+	for i, cpu := range t.CPUs {
+		cur += cpu.CurMHz
+		if i == 0 || cpu.CurMHz < minCur {
+			minCur = cpu.CurMHz
+		}
+		maxCur = max(maxCur, cpu.CurMHz)
+		throttles += cpu.CoreThrottles + cpu.PackageThrottles
+	}
+	if len(t.CPUs) > 0 {
+		cur /= float64(len(t.CPUs))
+	}
+
+	sb.WriteString(utils.BoldText(fmt.Sprintf("%-14s %-14s %-14s %-14s\n", "Avg MHz", "Min MHz", "Max MHz", "Throttles")))
+	sb.WriteString(utils.GrayText(fmt.Sprintf("%-14.0f %-14.0f %-14.0f %-14s",
+		cur, minCur, maxCur, utils.BeatifyNumber(throttles),
+	)))
+	sb.WriteString("\n\n")
+
+	sb.WriteString(utils.BoldText(fmt.Sprintf(fmtSensors, "Source", "Chip", "Label", "Temp", "Critical")))
+	rows := make([]string, 0, len(t.Sensors))
+	for _, s := range t.Sensors {
+		crit := "-"
+		if s.CritC > 0 {
+			crit = fmt.Sprintf("%.1f°C", s.CritC)
+		}
+		rows = append(rows, fmt.Sprintf(fmtSensors, s.Source, s.Chip, s.Label, fmt.Sprintf("%.1f°C", s.TempC), crit))
+	}
+	sb.WriteString("\n" + utils.GrayText(strings.Join(rows, "\n")))
+
+	return sb.String()
+}
+
+// Store stores the CPU frequency and temperatures statistics to the metrics of the system.
+func (t ThermalStats) Store(metrics *Metrics) {
+	metrics.ThermalStats = t
+}
+
+// Samples returns the CPU frequency and temperatures as the generic samples.
+func (t ThermalStats) Samples() []Sample {
+	var res []Sample
+	for _, c := range t.CPUs {
+		cpu := strconv.Itoa(c.CPU)
+		res = append(res,
+			gauge("thermal_cpu_frequency_hertz", UnitHertz, c.CurMHz*1e6, "cpu", cpu),
+			counter("thermal_cpu_core_throttles", UnitCount, float64(c.CoreThrottles), "cpu", cpu),
+			counter("thermal_cpu_package_throttles", UnitCount, float64(c.PackageThrottles), "cpu", cpu),
+		)
+	}
+	for _, s := range t.Sensors {
+		res = append(res, gauge("thermal_temperature_celsius", UnitCelsius, s.TempC,
+			"source", s.Source, "chip", s.Chip, "sensor", s.Label))
+		if s.CritC > 0 {
+			res = append(res, gauge("thermal_critical_celsius", UnitCelsius, s.CritC,
+				"source", s.Source, "chip", s.Chip, "sensor", s.Label))
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils"
+)
+
+// fmtTimeSyncStats is the format for the time synchronisation statistics.
+const fmtTimeSyncStats = "%-14s %-24s %-14s %-14s %-14s %-14s %-14s"
+
+// TimeSyncStats represents the synchronisation status of the system clock.
+type TimeSyncStats struct {
+	// Synchronised shows whether the clock is synchronised by NTP or PTP daemon.
+	Synchronised bool `json:"synchronised"`
+	// State shows the clock state like ok or insert leap second.
+	State string `json:"state"`
+	// OffsetMs shows the offset of the clock from the reference time in milliseconds.
+	OffsetMs float64 `json:"offsetMs"`
+	// MaxErrorMs shows the maximum error of the clock in milliseconds.
+	MaxErrorMs float64 `json:"maxErrorMs"`
+	// EstErrorMs shows the estimated error of the clock in milliseconds.
+	EstErrorMs float64 `json:"estErrorMs"`
+	// FreqPPM shows the frequency adjustment of the clock in parts per million.
+	FreqPPM float64 `json:"freqPpm"`
+	// TAIOffsetSec shows the offset of the TAI from UTC in seconds, 0 if not set by the daemon.
+	TAIOffsetSec int `json:"taiOffsetSec"`
+}
+
+// String returns a string representation of the TimeSyncStats.
+func (s TimeSyncStats) String() string {
+	synced := "no"
+	if s.Synchronised {
+		synced = "yes"
+	}
+
+	header := utils.BoldText(fmt.Sprintf(fmtTimeSyncStats,
+		"Synchronised", "State", "Offset ms", "Max err ms", "Est err ms", "Freq ppm", "TAI offset",
+	))
+	row := utils.GrayText(fmt.Sprintf(fmtTimeSyncStats,
+		synced,
+		s.State,
+		fmt.Sprintf("%.3f", s.OffsetMs),
+		fmt.Sprintf("%.3f", s.MaxErrorMs),
+		fmt.Sprintf("%.3f", s.EstErrorMs),
+		fmt.Sprintf("%.3f", s.FreqPPM),
+		fmt.Sprintf("%d s", s.TAIOffsetSec),
+	))
+
+	return header + "\n" + row
+}
+
+// Store stores the clock synchronisation status to the metrics of the system.
+func (s TimeSyncStats) Store(metrics *Metrics) {
+	metrics.TimeSyncStats = s
+}
+
+// Samples returns the clock synchronisation status as the generic samples.
+func (s TimeSyncStats) Samples() []Sample {
+	return []Sample{
+		gauge("timesync_synchronised", UnitBool, boolValue(s.Synchronised)),
+		gauge("timesync_offset_seconds", UnitSeconds, s.OffsetMs/1000),
+		gauge("timesync_max_error_seconds", UnitSeconds, s.MaxErrorMs/1000),
+		gauge("timesync_est_error_seconds", UnitSeconds, s.EstErrorMs/1000),
+		gauge("timesync_frequency_ppm", UnitPPM, s.FreqPPM),
+		gauge("timesync_tai_offset_seconds", UnitSeconds, float64(s.TAIOffsetSec)),
+	}
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils"
+)
+
+// fmtVMStat is the format for the virtual memory statistics.
+const fmtVMStat = "%-14s %-14s %-14s %-14s %-14s %-14s"
+
+// VMStatStats represents the paging and swapping activity of the virtual memory.
+type VMStatStats struct {
+	// PageFaults shows the number of page faults per second.
+	PageFaults float64 `json:"pageFaults"`
+	// MajorFaults shows the number of major page faults requiring the disk IO per second.
+	MajorFaults float64 `json:"majorFaults"`
+	// SwapIns shows the number of pages swapped in per second.
+	SwapIns float64 `json:"swapIns"`
+	// SwapOuts shows the number of pages swapped out per second.
+	SwapOuts float64 `json:"swapOuts"`
+	// PagedInKb shows the number of kilobytes paged in from the disk per second.
+	PagedInKb float64 `json:"pagedInKb"`
+	// PagedOutKb shows the number of kilobytes paged out to the disk per second.
+	PagedOutKb float64 `json:"pagedOutKb"`
+}
+
+// String returns a string representation of the VMStatStats.
+func (v VMStatStats) String() string {
+	header := utils.BoldText(fmt.Sprintf(fmtVMStat,
+		"Faults/s", "Major/s", "Swap in/s", "Swap out/s", "Page in KB/s", "Page out KB/s",
+	))
+	row := utils.GrayText(fmt.Sprintf(fmtVMStat,
+		fmt.Sprintf("%.2f", v.PageFaults),
+		fmt.Sprintf("%.2f", v.MajorFaults),
+		fmt.Sprintf("%.2f", v.SwapIns),
+		fmt.Sprintf("%.2f", v.SwapOuts),
+		fmt.Sprintf("%.2f", v.PagedInKb),
+		fmt.Sprintf("%.2f", v.PagedOutKb),
+	))
+
+	return header + "\n" + row
+}
+
+// Store stores the virtual memory statistics to the metrics of the system.
+func (v VMStatStats) Store(metrics *Metrics) {
+	metrics.VMStatStats = v
+}
+
+// Samples returns the virtual memory statistics as the generic samples.
+func (v VMStatStats) Samples() []Sample {
+	return []Sample{
+		rate("vmstat_page_faults_per_second", UnitPerSecond, v.PageFaults),
+		rate("vmstat_major_faults_per_second", UnitPerSecond, v.MajorFaults),
+		rate("vmstat_swap_ins_per_second", UnitPerSecond, v.SwapIns),
+		rate("vmstat_swap_outs_per_second", UnitPerSecond, v.SwapOuts),
+		rate("vmstat_paged_in_bytes_per_second", UnitBytesPerSecond, v.PagedInKb*1024),
+		rate("vmstat_paged_out_bytes_per_second", UnitBytesPerSecond, v.PagedOutKb*1024),
+	}
+}
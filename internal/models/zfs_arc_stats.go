@@ -0,0 +1,61 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/sitnikovik/sysmon/internal/metrics/utils"
+)
+
+// fmtZFSArcStats is the format for the ZFS ARC statistics.
+const fmtZFSArcStats = "%-14s %-14s %-14s %-14s %-14s %-14s"
+
+// ZFSArcStats represents the ZFS adaptive replacement cache statistics.
+type ZFSArcStats struct {
+	// SizeBytes shows the current size of the ARC in bytes.
+	SizeBytes uint64 `json:"sizeBytes"`
+	// TargetBytes shows the size the ARC is adapting to in bytes.
+	TargetBytes uint64 `json:"targetBytes"`
+	// MinBytes shows the minimum size of the ARC in bytes.
+	MinBytes uint64 `json:"minBytes"`
+	// MaxBytes shows the maximum size of the ARC in bytes.
+	MaxBytes uint64 `json:"maxBytes"`
+	// Hits shows the number of the ARC hits per second.
+	Hits float64 `json:"hits"`
+	// Misses shows the number of the ARC misses per second.
+	Misses float64 `json:"misses"`
+	// HitPercent shows the percentage of the hits among all the ARC lookups.
+	HitPercent float64 `json:"hitPercent"`
+}
+
+// String returns a string representation of the ZFSArcStats.
+func (s ZFSArcStats) String() string {
+	header := utils.BoldText(fmt.Sprintf(fmtZFSArcStats, "Size Mb", "Target Mb", "Max Mb", "Hits/s", "Misses/s", "Hit %"))
+	row := utils.GrayText(fmt.Sprintf(fmtZFSArcStats,
+		utils.BeatifyNumber(s.SizeBytes/1024/1024),
+		utils.BeatifyNumber(s.TargetBytes/1024/1024),
+		utils.BeatifyNumber(s.MaxBytes/1024/1024),
+		fmt.Sprintf("%.2f", s.Hits),
+		fmt.Sprintf("%.2f", s.Misses),
+		fmt.Sprintf("%.2f", s.HitPercent),
+	))
+
+	return header + "\n" + row
+}
+
+// Store stores the ZFS ARC statistics to the metrics of the system.
+func (s ZFSArcStats) Store(metrics *Metrics) {
+	metrics.ZFSArcStats = s
+}
+
+// Samples returns the ZFS ARC statistics as the generic samples.
+func (s ZFSArcStats) Samples() []Sample {
+	return []Sample{
+		gauge("zfs_arc_size_bytes", UnitBytes, float64(s.SizeBytes)),
+		gauge("zfs_arc_target_bytes", UnitBytes, float64(s.TargetBytes)),
+		gauge("zfs_arc_min_bytes", UnitBytes, float64(s.MinBytes)),
+		gauge("zfs_arc_max_bytes", UnitBytes, float64(s.MaxBytes)),
+		rate("zfs_arc_hits_per_second", UnitPerSecond, s.Hits),
+		rate("zfs_arc_misses_per_second", UnitPerSecond, s.Misses),
+		gauge("zfs_arc_hit_percent", UnitPercent, s.HitPercent),
+	}
+}
@@ -0,0 +1,191 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// Policy is the policy of the ticks fired while the previous one is still running.
+type Policy string
+
+const (
+	// PolicySkip drops the ticks fired while the previous one is running.
+	PolicySkip Policy = "skip"
+	// PolicyQueue runs the ticks fired while the previous one is running one after another.
+	// The ticks beyond maxQueued are dropped not to fall behind forever.
+	PolicyQueue Policy = "queue"
+	// PolicyCoalesce merges the ticks fired while the previous one is running into the latest one
+	// run right after the previous one finishes.
+	PolicyCoalesce Policy = "coalesce"
+)
+
+// maxQueued is the maximum number of the ticks waiting for the previous one to finish with PolicyQueue.
+const maxQueued = 16
+
+// Func is the function run on every tick.
+type Func func(ctx context.Context, tick models.Tick)
+
+// scheduler runs the function on the wall-clock aligned ticks one at a time.
+type scheduler struct {
+	interval time.Duration
+	policy   Policy
+
+	mu          sync.Mutex
+	stats       models.SchedulerStats
+	totalJitter time.Duration
+}
+
+// NewScheduler returns a new instance of the scheduler firing every interval
+// with the policy of the ticks fired while the previous one is still running.
+//
+//nolint:revive
+func NewScheduler(interval time.Duration, policy Policy) (*scheduler, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("invalid interval: %s", interval)
+	}
+	if !policy.Valid() {
+		return nil, fmt.Errorf("invalid policy: %s", policy)
+	}
+
+	return &scheduler{
+		interval: interval,
+		policy:   policy,
+		stats:    models.SchedulerStats{Policy: string(policy)},
+	}, nil
+}
+
+// Valid reports whether the policy is known.
+func (p Policy) Valid() bool {
+	switch p {
+	case PolicySkip, PolicyQueue, PolicyCoalesce:
+		return true
+	default:
+		return false
+	}
+}
+
+// Run runs the function on every tick until the context is done.
+// The ticks are aligned to the wall clock, e.g. at :00, :05, :10 with the 5 seconds interval,
+// and the function is never run concurrently.
+func (s *scheduler) Run(ctx context.Context, fn Func) {
+	var ticks chan models.Tick
+	switch s.policy {
+	case PolicySkip:
+		// Unbuffered, so the tick is sent only if the worker is waiting for it
+		ticks = make(chan models.Tick)
+	case PolicyQueue:
+		ticks = make(chan models.Tick, maxQueued)
+	case PolicyCoalesce:
+		ticks = make(chan models.Tick, 1)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.work(ctx, ticks, fn)
+	}()
+	defer wg.Wait()
+	defer close(ticks)
+
+	var seq uint64
+	next := time.Now().Truncate(s.interval).Add(s.interval)
+	timer := time.NewTimer(time.Until(next))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		seq++
+		s.schedule(ticks, models.Tick{Seq: seq, Time: next})
+
+		// The ticks missed while the process was not running, e.g. the system was suspended, are skipped
+		next = next.Add(s.interval)
+		for now := time.Now(); !next.After(now); next = next.Add(s.interval) {
+			seq++
+			s.count(func(stats *models.SchedulerStats) {
+				stats.Scheduled++
+				stats.Skipped++
+			})
+		}
+		timer.Reset(time.Until(next))
+	}
+}
+
+// Stats returns the statistics of the ticks.
+func (s *scheduler) Stats() models.SchedulerStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stats
+}
+
+// schedule passes the tick to the worker according to the policy.
+func (s *scheduler) schedule(ticks chan models.Tick, tick models.Tick) {
+	s.count(func(stats *models.SchedulerStats) {
+		stats.Scheduled++
+	})
+
+	if s.policy == PolicyCoalesce {
+		// Replace the tick still waiting for the worker with the new one
+		select {
+		case pending := <-ticks:
+			tick.Coalesced = pending.Coalesced + 1
+			s.count(func(stats *models.SchedulerStats) {
+				stats.Coalesced++
+			})
+		default:
+		}
+	}
+
+	select {
+	case ticks <- tick:
+	default:
+		s.count(func(stats *models.SchedulerStats) {
+			stats.Skipped++
+		})
+	}
+}
+
+// work runs the function on the ticks one at a time until the ticks channel is closed.
+func (s *scheduler) work(ctx context.Context, ticks <-chan models.Tick, fn Func) {
+	for tick := range ticks {
+		if ctx.Err() != nil {
+			return
+		}
+
+		tick.Started = time.Now()
+		jitter := tick.Jitter()
+		s.count(func(stats *models.SchedulerStats) {
+			stats.Run++
+			stats.LastJitter = jitter
+			stats.MaxJitter = max(stats.MaxJitter, jitter)
+			s.totalJitter += jitter
+			stats.MeanJitter = s.totalJitter / time.Duration(stats.Run)
+		})
+
+		fn(ctx, tick)
+
+		if time.Since(tick.Started) > s.interval {
+			s.count(func(stats *models.SchedulerStats) {
+				stats.Overruns++
+			})
+		}
+	}
+}
+
+// count updates the statistics under the lock.
+func (s *scheduler) count(update func(stats *models.SchedulerStats)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	update(&s.stats)
+}
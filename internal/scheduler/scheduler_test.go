@@ -0,0 +1,120 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+func TestNewScheduler(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewScheduler(0, PolicySkip)
+	require.Error(t, err)
+
+	_, err = NewScheduler(time.Second, "drop")
+	require.Error(t, err)
+
+	s, err := NewScheduler(time.Second, PolicyCoalesce)
+	require.NoError(t, err)
+	require.Equal(t, "coalesce", s.Stats().Policy)
+}
+
+func Test_scheduler_Run(t *testing.T) {
+	t.Parallel()
+
+	const interval = 50 * time.Millisecond
+
+	tests := []struct {
+		name   string
+		policy Policy
+		check  func(t *testing.T, ticks []models.Tick, stats models.SchedulerStats)
+	}{
+		{
+			name:   "skip",
+			policy: PolicySkip,
+			check: func(t *testing.T, ticks []models.Tick, stats models.SchedulerStats) {
+				t.Helper()
+
+				require.Positive(t, stats.Skipped)
+				require.Zero(t, stats.Coalesced)
+				// The skipped ticks leave the gaps in the sequence numbers
+				require.Greater(t, ticks[1].Seq, ticks[0].Seq+1)
+			},
+		},
+		{
+			name:   "queue",
+			policy: PolicyQueue,
+			check: func(t *testing.T, ticks []models.Tick, stats models.SchedulerStats) {
+				t.Helper()
+
+				require.Zero(t, stats.Skipped)
+				require.Zero(t, stats.Coalesced)
+				for i := 1; i < len(ticks); i++ {
+					require.Equal(t, ticks[i-1].Seq+1, ticks[i].Seq)
+				}
+			},
+		},
+		{
+			name:   "coalesce",
+			policy: PolicyCoalesce,
+			check: func(t *testing.T, ticks []models.Tick, stats models.SchedulerStats) {
+				t.Helper()
+
+				require.Positive(t, stats.Coalesced)
+				require.Positive(t, ticks[1].Coalesced)
+				require.Equal(t, ticks[0].Seq+ticks[1].Coalesced+1, ticks[1].Seq)
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := NewScheduler(interval, tt.policy)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 12*interval)
+			defer cancel()
+
+			var (
+				mu      sync.Mutex
+				ticks   []models.Tick
+				running atomic.Int32
+			)
+			s.Run(ctx, func(_ context.Context, tick models.Tick) {
+				require.Equal(t, int32(1), running.Add(1), "ticks overlap")
+				defer running.Add(-1)
+
+				mu.Lock()
+				ticks = append(ticks, tick)
+				first := len(ticks) == 1
+				mu.Unlock()
+
+				// The first tick overruns for a few intervals
+				if first {
+					time.Sleep(3*interval + interval/2)
+				}
+			})
+
+			require.GreaterOrEqual(t, len(ticks), 2)
+			for _, tick := range ticks {
+				// Aligned to the wall clock
+				require.Equal(t, tick.Time, tick.Time.Truncate(interval))
+				require.GreaterOrEqual(t, tick.Jitter(), time.Duration(0))
+			}
+
+			stats := s.Stats()
+			require.Equal(t, uint64(len(ticks)), stats.Run)
+			require.Positive(t, stats.Overruns)
+			tt.check(t, ticks, stats)
+		})
+	}
+}
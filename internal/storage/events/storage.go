@@ -0,0 +1,126 @@
+package events
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+// subscriberBuffer is the number of the events buffered for the subscriber,
+// the events are dropped for the subscriber not keeping up with them.
+const subscriberBuffer = 64
+
+// storage stores the recent events in memory and notifies the subscribers about the new ones.
+type storage struct {
+	mu sync.RWMutex
+	// capacity is the number of the recent events of every kind kept.
+	capacity int
+	// rings is the ring buffers of the recent events by their kinds,
+	// so the frequent events like the processes starting do not evict the rare ones like the OOM kills.
+	rings map[string]*ring
+	// seq is the sequence number of the next event to list the events in the order they were added.
+	seq uint64
+	// subscribers are the channels to send the new events to.
+	subscribers map[chan models.Event]struct{}
+}
+
+// ring is the ring buffer of the recent events of the single kind.
+type ring struct {
+	// events is the recent events with their sequence numbers.
+	events []entry
+	// next is the index to write the next event to.
+	next int
+	// full shows whether the ring buffer is full and the oldest events are overwritten.
+	full bool
+}
+
+// entry is the event with the sequence number it was added with.
+type entry struct {
+	seq   uint64
+	event models.Event
+}
+
+// NewStorage returns a new instance of the events storage keeping the provided number of the recent events
+// of every kind.
+//
+//nolint:revive
+func NewStorage(capacity int) *storage {
+	return &storage{
+		capacity:    capacity,
+		rings:       make(map[string]*ring),
+		subscribers: make(map[chan models.Event]struct{}),
+	}
+}
+
+// Add stores the event and sends it to the subscribers.
+func (s *storage) Add(_ context.Context, e models.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.capacity > 0 {
+		r, ok := s.rings[e.Kind]
+		if !ok {
+			r = &ring{events: make([]entry, s.capacity)}
+			s.rings[e.Kind] = r
+		}
+		r.events[r.next] = entry{seq: s.seq, event: e}
+		r.next = (r.next + 1) % len(r.events)
+		r.full = r.full || r.next == 0
+		s.seq++
+	}
+
+	for ch := range s.subscribers {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
+
+// List returns the recent events from the oldest to the newest.
+func (s *storage) List(_ context.Context) []models.Event {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var entries []entry
+	for _, r := range s.rings {
+		if r.full {
+			entries = append(entries, r.events...)
+			continue
+		}
+		entries = append(entries, r.events[:r.next]...)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].seq < entries[j].seq
+	})
+
+	res := make([]models.Event, 0, len(entries))
+	for _, en := range entries {
+		res = append(res, en.event)
+	}
+
+	return res
+}
+
+// Subscribe returns the channel receiving the new events until the context is done.
+func (s *storage) Subscribe(ctx context.Context) <-chan models.Event {
+	ch := make(chan models.Event, subscriberBuffer)
+
+	s.mu.Lock()
+	s.subscribers[ch] = struct{}{}
+	s.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		s.mu.Lock()
+		delete(s.subscribers, ch)
+		s.mu.Unlock()
+
+		close(ch)
+	}()
+
+	return ch
+}
@@ -0,0 +1,86 @@
+package events
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sitnikovik/sysmon/internal/models"
+)
+
+func Test_storage_List(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		capacity int
+		added    []string
+		want     []models.Event
+	}{
+		{
+			name:     "not full",
+			capacity: 3,
+			added:    []string{"a", "b"},
+			want:     []models.Event{{Message: "a"}, {Message: "b"}},
+		},
+		{
+			name:     "oldest overwritten",
+			capacity: 3,
+			added:    []string{"a", "b", "c", "d", "e"},
+			want:     []models.Event{{Message: "c"}, {Message: "d"}, {Message: "e"}},
+		},
+		{
+			name:     "oldest overwritten by kind",
+			capacity: 2,
+			added:    []string{"oom_kill:a", "process_start:b", "process_start:c", "process_exit:d", "process_start:e"},
+			want: []models.Event{
+				{Kind: "oom_kill", Message: "a"},
+				{Kind: "process_start", Message: "c"},
+				{Kind: "process_exit", Message: "d"},
+				{Kind: "process_start", Message: "e"},
+			},
+		},
+		{
+			name:     "no history",
+			capacity: 0,
+			added:    []string{"a"},
+			want:     []models.Event{},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := NewStorage(tt.capacity)
+			for _, added := range tt.added {
+				kind, msg, ok := strings.Cut(added, ":")
+				if !ok {
+					kind, msg = "", added
+				}
+				s.Add(context.Background(), models.Event{Kind: kind, Message: msg})
+			}
+
+			require.Equal(t, tt.want, s.List(context.Background()))
+		})
+	}
+}
+
+func Test_storage_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	s := NewStorage(10)
+	s.Add(context.Background(), models.Event{Message: "before"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := s.Subscribe(ctx)
+	s.Add(context.Background(), models.Event{Message: "after"})
+	require.Equal(t, models.Event{Message: "after"}, <-events)
+
+	// The channel is closed once the subscriber is gone
+	cancel()
+	_, ok := <-events
+	require.False(t, ok)
+}
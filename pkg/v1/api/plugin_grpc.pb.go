@@ -0,0 +1,145 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: api/plugin.proto
+
+package sysmon_v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CollectorPluginClient is the client API for CollectorPlugin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CollectorPluginClient interface {
+	// Describe is called once the plugin is connected to check it is compatible
+	Describe(ctx context.Context, in *DescribeRequest, opts ...grpc.CallOption) (*DescribeResponse, error)
+	// Collect is called on every tick to collect the samples of the metrics
+	Collect(ctx context.Context, in *CollectRequest, opts ...grpc.CallOption) (*CollectResponse, error)
+}
+
+type collectorPluginClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCollectorPluginClient(cc grpc.ClientConnInterface) CollectorPluginClient {
+	return &collectorPluginClient{cc}
+}
+
+func (c *collectorPluginClient) Describe(ctx context.Context, in *DescribeRequest, opts ...grpc.CallOption) (*DescribeResponse, error) {
+	out := new(DescribeResponse)
+	err := c.cc.Invoke(ctx, "/monitor.CollectorPlugin/Describe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectorPluginClient) Collect(ctx context.Context, in *CollectRequest, opts ...grpc.CallOption) (*CollectResponse, error) {
+	out := new(CollectResponse)
+	err := c.cc.Invoke(ctx, "/monitor.CollectorPlugin/Collect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CollectorPluginServer is the server API for CollectorPlugin service.
+// All implementations must embed UnimplementedCollectorPluginServer
+// for forward compatibility
+type CollectorPluginServer interface {
+	// Describe is called once the plugin is connected to check it is compatible
+	Describe(context.Context, *DescribeRequest) (*DescribeResponse, error)
+	// Collect is called on every tick to collect the samples of the metrics
+	Collect(context.Context, *CollectRequest) (*CollectResponse, error)
+	mustEmbedUnimplementedCollectorPluginServer()
+}
+
+// UnimplementedCollectorPluginServer must be embedded to have forward compatible implementations.
+type UnimplementedCollectorPluginServer struct {
+}
+
+func (UnimplementedCollectorPluginServer) Describe(context.Context, *DescribeRequest) (*DescribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Describe not implemented")
+}
+func (UnimplementedCollectorPluginServer) Collect(context.Context, *CollectRequest) (*CollectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Collect not implemented")
+}
+func (UnimplementedCollectorPluginServer) mustEmbedUnimplementedCollectorPluginServer() {}
+
+// UnsafeCollectorPluginServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CollectorPluginServer will
+// result in compilation errors.
+type UnsafeCollectorPluginServer interface {
+	mustEmbedUnimplementedCollectorPluginServer()
+}
+
+func RegisterCollectorPluginServer(s grpc.ServiceRegistrar, srv CollectorPluginServer) {
+	s.RegisterService(&CollectorPlugin_ServiceDesc, srv)
+}
+
+func _CollectorPlugin_Describe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorPluginServer).Describe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/monitor.CollectorPlugin/Describe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorPluginServer).Describe(ctx, req.(*DescribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectorPlugin_Collect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectorPluginServer).Collect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/monitor.CollectorPlugin/Collect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectorPluginServer).Collect(ctx, req.(*CollectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CollectorPlugin_ServiceDesc is the grpc.ServiceDesc for CollectorPlugin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CollectorPlugin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "monitor.CollectorPlugin",
+	HandlerType: (*CollectorPluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Describe",
+			Handler:    _CollectorPlugin_Describe_Handler,
+		},
+		{
+			MethodName: "Collect",
+			Handler:    _CollectorPlugin_Collect_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/plugin.proto",
+}
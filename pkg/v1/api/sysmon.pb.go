@@ -71,6 +71,42 @@ type StatsResponse struct {
 	Memory *StatsResponse_Memory `protobuf:"bytes,3,opt,name=memory,proto3" json:"memory,omitempty"`
 	// Represents the system load average
 	LoadAverage *StatsResponse_LoadAverage `protobuf:"bytes,4,opt,name=loadAverage,proto3" json:"loadAverage,omitempty"`
+	// Represents the interrupts distribution among the CPUs
+	Interrupts *StatsResponse_Interrupts `protobuf:"bytes,5,opt,name=interrupts,proto3" json:"interrupts,omitempty"`
+	// Represents the network protocols statistics
+	NetProto *StatsResponse_NetProto `protobuf:"bytes,6,opt,name=netProto,proto3" json:"netProto,omitempty"`
+	// Represents the kernel tables usage against the limits
+	Limits *StatsResponse_Limits `protobuf:"bytes,7,opt,name=limits,proto3" json:"limits,omitempty"`
+	// Represents the CPU frequency, throttling and temperatures
+	Thermal *StatsResponse_Thermal `protobuf:"bytes,8,opt,name=thermal,proto3" json:"thermal,omitempty"`
+	// Represents the software RAID arrays status
+	MdStat *StatsResponse_MDStat `protobuf:"bytes,9,opt,name=mdStat,proto3" json:"mdStat,omitempty"`
+	// Represents the ZFS ARC statistics
+	ZfsArc *StatsResponse_ZFSArc `protobuf:"bytes,10,opt,name=zfsArc,proto3" json:"zfsArc,omitempty"`
+	// Represents the system clock synchronisation status
+	TimeSync *StatsResponse_TimeSync `protobuf:"bytes,11,opt,name=timeSync,proto3" json:"timeSync,omitempty"`
+	// Represents the active login sessions
+	Sessions *StatsResponse_Sessions `protobuf:"bytes,12,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	// Represents the processes and threads states summary
+	ProcState *StatsResponse_ProcState `protobuf:"bytes,13,opt,name=procState,proto3" json:"procState,omitempty"`
+	// Represents the sockets usage and network memory statistics
+	SockStat *StatsResponse_SockStat `protobuf:"bytes,14,opt,name=sockStat,proto3" json:"sockStat,omitempty"`
+	// Represents the run of the metrics collection the metrics are collected on
+	Tick *StatsResponse_Tick `protobuf:"bytes,15,opt,name=tick,proto3" json:"tick,omitempty"`
+	// Represents the statistics of the ticks of the metrics collection
+	Scheduler *StatsResponse_Scheduler `protobuf:"bytes,16,opt,name=scheduler,proto3" json:"scheduler,omitempty"`
+	// Represents the traffic of the network interfaces
+	NetDev *StatsResponse_NetDev `protobuf:"bytes,17,opt,name=netDev,proto3" json:"netDev,omitempty"`
+	// Represents the paging and swapping activity of the virtual memory
+	VmStat *StatsResponse_VMStat `protobuf:"bytes,18,opt,name=vmStat,proto3" json:"vmStat,omitempty"`
+	// Represents the metrics of the custom collectors parsed from the output of their commands
+	Custom []*StatsResponse_Custom `protobuf:"bytes,19,rep,name=custom,proto3" json:"custom,omitempty"`
+	// Names of the metrics failed to be collected on the last tick,
+	// their values are the ones of the last successful collection
+	Stale []string `protobuf:"bytes,20,rep,name=stale,proto3" json:"stale,omitempty"`
+	// Time every metric was collected at as Unix time in milliseconds by the collector names,
+	// the metrics of the collectors with the longer intervals may be collected before the tick
+	CollectedAtMs map[string]int64 `protobuf:"bytes,21,rep,name=collectedAtMs,proto3" json:"collectedAtMs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
 func (x *StatsResponse) Reset() {
@@ -133,227 +169,148 @@ func (x *StatsResponse) GetLoadAverage() *StatsResponse_LoadAverage {
 	return nil
 }
 
-// Represents the CPU statistics
-type StatsResponse_CPU struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Percentage of CPU time spent in user space
-	User float64 `protobuf:"fixed64,1,opt,name=user,proto3" json:"user,omitempty"`
-	// Percentage of CPU time spent in kernel space
-	System float64 `protobuf:"fixed64,2,opt,name=system,proto3" json:"system,omitempty"`
-	// Percentage of CPU time spent idle
-	Idle float64 `protobuf:"fixed64,3,opt,name=idle,proto3" json:"idle,omitempty"`
-}
-
-func (x *StatsResponse_CPU) Reset() {
-	*x = StatsResponse_CPU{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sysmon_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *StatsResponse) GetInterrupts() *StatsResponse_Interrupts {
+	if x != nil {
+		return x.Interrupts
 	}
+	return nil
 }
 
-func (x *StatsResponse_CPU) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*StatsResponse_CPU) ProtoMessage() {}
-
-func (x *StatsResponse_CPU) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sysmon_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *StatsResponse) GetNetProto() *StatsResponse_NetProto {
+	if x != nil {
+		return x.NetProto
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StatsResponse_CPU.ProtoReflect.Descriptor instead.
-func (*StatsResponse_CPU) Descriptor() ([]byte, []int) {
-	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 0}
+	return nil
 }
 
-func (x *StatsResponse_CPU) GetUser() float64 {
+func (x *StatsResponse) GetLimits() *StatsResponse_Limits {
 	if x != nil {
-		return x.User
+		return x.Limits
 	}
-	return 0
+	return nil
 }
 
-func (x *StatsResponse_CPU) GetSystem() float64 {
+func (x *StatsResponse) GetThermal() *StatsResponse_Thermal {
 	if x != nil {
-		return x.System
+		return x.Thermal
 	}
-	return 0
+	return nil
 }
 
-func (x *StatsResponse_CPU) GetIdle() float64 {
+func (x *StatsResponse) GetMdStat() *StatsResponse_MDStat {
 	if x != nil {
-		return x.Idle
+		return x.MdStat
 	}
-	return 0
-}
-
-// Represents the disk statistics
-type StatsResponse_Disk struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Number of reads per second
-	Reads float64 `protobuf:"fixed64,1,opt,name=reads,proto3" json:"reads,omitempty"`
-	// Number of writes per second
-	Writes float64 `protobuf:"fixed64,2,opt,name=writes,proto3" json:"writes,omitempty"`
-	// Number of kilobytes read+write per second
-	ReadWriteKB float64 `protobuf:"fixed64,3,opt,name=readWriteKB,proto3" json:"readWriteKB,omitempty"`
-	// Total disk space in Mb
-	TotalMb uint64 `protobuf:"varint,4,opt,name=totalMb,proto3" json:"totalMb,omitempty"`
-	// Used disk space in Mb
-	UsedMb uint64 `protobuf:"varint,5,opt,name=usedMb,proto3" json:"usedMb,omitempty"`
-	// Used disk space in percentage
-	UsedPercent float64 `protobuf:"fixed64,6,opt,name=usedPercent,proto3" json:"usedPercent,omitempty"`
-	// Number of used inodes
-	UsedInodes uint64 `protobuf:"varint,7,opt,name=usedInodes,proto3" json:"usedInodes,omitempty"`
-	// Used inodes in percentage
-	UsedInodesPercent float64 `protobuf:"fixed64,8,opt,name=usedInodesPercent,proto3" json:"usedInodesPercent,omitempty"`
+	return nil
 }
 
-func (x *StatsResponse_Disk) Reset() {
-	*x = StatsResponse_Disk{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sysmon_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *StatsResponse) GetZfsArc() *StatsResponse_ZFSArc {
+	if x != nil {
+		return x.ZfsArc
 	}
+	return nil
 }
 
-func (x *StatsResponse_Disk) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *StatsResponse) GetTimeSync() *StatsResponse_TimeSync {
+	if x != nil {
+		return x.TimeSync
+	}
+	return nil
 }
 
-func (*StatsResponse_Disk) ProtoMessage() {}
-
-func (x *StatsResponse_Disk) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sysmon_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *StatsResponse) GetSessions() *StatsResponse_Sessions {
+	if x != nil {
+		return x.Sessions
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use StatsResponse_Disk.ProtoReflect.Descriptor instead.
-func (*StatsResponse_Disk) Descriptor() ([]byte, []int) {
-	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 1}
+func (x *StatsResponse) GetProcState() *StatsResponse_ProcState {
+	if x != nil {
+		return x.ProcState
+	}
+	return nil
 }
 
-func (x *StatsResponse_Disk) GetReads() float64 {
+func (x *StatsResponse) GetSockStat() *StatsResponse_SockStat {
 	if x != nil {
-		return x.Reads
+		return x.SockStat
 	}
-	return 0
+	return nil
 }
 
-func (x *StatsResponse_Disk) GetWrites() float64 {
+func (x *StatsResponse) GetTick() *StatsResponse_Tick {
 	if x != nil {
-		return x.Writes
+		return x.Tick
 	}
-	return 0
+	return nil
 }
 
-func (x *StatsResponse_Disk) GetReadWriteKB() float64 {
+func (x *StatsResponse) GetScheduler() *StatsResponse_Scheduler {
 	if x != nil {
-		return x.ReadWriteKB
+		return x.Scheduler
 	}
-	return 0
+	return nil
 }
 
-func (x *StatsResponse_Disk) GetTotalMb() uint64 {
+func (x *StatsResponse) GetNetDev() *StatsResponse_NetDev {
 	if x != nil {
-		return x.TotalMb
+		return x.NetDev
 	}
-	return 0
+	return nil
 }
 
-func (x *StatsResponse_Disk) GetUsedMb() uint64 {
+func (x *StatsResponse) GetVmStat() *StatsResponse_VMStat {
 	if x != nil {
-		return x.UsedMb
+		return x.VmStat
 	}
-	return 0
+	return nil
 }
 
-func (x *StatsResponse_Disk) GetUsedPercent() float64 {
+func (x *StatsResponse) GetCustom() []*StatsResponse_Custom {
 	if x != nil {
-		return x.UsedPercent
+		return x.Custom
 	}
-	return 0
+	return nil
 }
 
-func (x *StatsResponse_Disk) GetUsedInodes() uint64 {
+func (x *StatsResponse) GetStale() []string {
 	if x != nil {
-		return x.UsedInodes
+		return x.Stale
 	}
-	return 0
+	return nil
 }
 
-func (x *StatsResponse_Disk) GetUsedInodesPercent() float64 {
+func (x *StatsResponse) GetCollectedAtMs() map[string]int64 {
 	if x != nil {
-		return x.UsedInodesPercent
+		return x.CollectedAtMs
 	}
-	return 0
+	return nil
 }
 
-type StatsResponse_Memory struct {
+type ProcessLimitsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Total memory in Mb
-	TotalMb uint64 `protobuf:"varint,1,opt,name=totalMb,proto3" json:"totalMb,omitempty"`
-	// Available memory in Mb shows how much memory in Mb is available for new processes.
-	AvailableMb uint64 `protobuf:"varint,2,opt,name=availableMb,proto3" json:"availableMb,omitempty"`
-	// Free memory in Mb shows how much memory in Mb is available for new processes.
-	// If this value is high, it means that the system has some spare memory,
-	// allowing more applications to run without having to free up memory.
-	FreeMb uint64 `protobuf:"varint,3,opt,name=freeMb,proto3" json:"freeMb,omitempty"`
-	// Active memory in Mb hows how much memory in Mb that are currently being actively used by processes.
-	// These pages contain data that is actively being read or written.
-	ActiveMb uint64 `protobuf:"varint,4,opt,name=activeMb,proto3" json:"activeMb,omitempty"`
-	// Inactive shows how much memory in Mb that were previously used but are not currently active.
-	// These pages may contain data that is not used, but can be restored to the active state if necessary.
-	InactiveMb uint64 `protobuf:"varint,5,opt,name=inactiveMb,proto3" json:"inactiveMb,omitempty"`
-	// Wired shows how much memory in Mb  that are hard-locked in RAM and cannot be paged out or released.
-	// These are usually mission-critical pages that are used by the operating system kernel or drivers,
-	// and they are necessary for the system to work.
-	WiredMb uint64 `protobuf:"varint,6,opt,name=WiredMb,proto3" json:"WiredMb,omitempty"`
 }
 
-func (x *StatsResponse_Memory) Reset() {
-	*x = StatsResponse_Memory{}
+func (x *ProcessLimitsRequest) Reset() {
+	*x = ProcessLimitsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sysmon_proto_msgTypes[4]
+		mi := &file_api_sysmon_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatsResponse_Memory) String() string {
+func (x *ProcessLimitsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsResponse_Memory) ProtoMessage() {}
+func (*ProcessLimitsRequest) ProtoMessage() {}
 
-func (x *StatsResponse_Memory) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sysmon_proto_msgTypes[4]
+func (x *ProcessLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,84 +321,82 @@ func (x *StatsResponse_Memory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsResponse_Memory.ProtoReflect.Descriptor instead.
-func (*StatsResponse_Memory) Descriptor() ([]byte, []int) {
-	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 2}
+// Deprecated: Use ProcessLimitsRequest.ProtoReflect.Descriptor instead.
+func (*ProcessLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *StatsResponse_Memory) GetTotalMb() uint64 {
-	if x != nil {
-		return x.TotalMb
-	}
-	return 0
+type ProcessLimitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Represents the watched processes and the ones closest to their limits
+	Processes []*ProcessLimitsResponse_Process `protobuf:"bytes,1,rep,name=processes,proto3" json:"processes,omitempty"`
 }
 
-func (x *StatsResponse_Memory) GetAvailableMb() uint64 {
-	if x != nil {
-		return x.AvailableMb
+func (x *ProcessLimitsResponse) Reset() {
+	*x = ProcessLimitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *StatsResponse_Memory) GetFreeMb() uint64 {
-	if x != nil {
-		return x.FreeMb
-	}
-	return 0
+func (x *ProcessLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StatsResponse_Memory) GetActiveMb() uint64 {
-	if x != nil {
-		return x.ActiveMb
+func (*ProcessLimitsResponse) ProtoMessage() {}
+
+func (x *ProcessLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *StatsResponse_Memory) GetInactiveMb() uint64 {
-	if x != nil {
-		return x.InactiveMb
-	}
-	return 0
+// Deprecated: Use ProcessLimitsResponse.ProtoReflect.Descriptor instead.
+func (*ProcessLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *StatsResponse_Memory) GetWiredMb() uint64 {
+func (x *ProcessLimitsResponse) GetProcesses() []*ProcessLimitsResponse_Process {
 	if x != nil {
-		return x.WiredMb
+		return x.Processes
 	}
-	return 0
+	return nil
 }
 
-// Represents the system load average
-type StatsResponse_LoadAverage struct {
+type SystemInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Average load for the last minute
-	OneMin float64 `protobuf:"fixed64,1,opt,name=oneMin,proto3" json:"oneMin,omitempty"`
-	// Average load for the last five minutes
-	FiveMin float64 `protobuf:"fixed64,2,opt,name=fiveMin,proto3" json:"fiveMin,omitempty"`
-	// Average load for the last fifteen minutes
-	FifteenMin float64 `protobuf:"fixed64,3,opt,name=fifteenMin,proto3" json:"fifteenMin,omitempty"`
 }
 
-func (x *StatsResponse_LoadAverage) Reset() {
-	*x = StatsResponse_LoadAverage{}
+func (x *SystemInfoRequest) Reset() {
+	*x = SystemInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_sysmon_proto_msgTypes[5]
+		mi := &file_api_sysmon_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatsResponse_LoadAverage) String() string {
+func (x *SystemInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsResponse_LoadAverage) ProtoMessage() {}
+func (*SystemInfoRequest) ProtoMessage() {}
 
-func (x *StatsResponse_LoadAverage) ProtoReflect() protoreflect.Message {
-	mi := &file_api_sysmon_proto_msgTypes[5]
+func (x *SystemInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,144 +407,6982 @@ func (x *StatsResponse_LoadAverage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsResponse_LoadAverage.ProtoReflect.Descriptor instead.
-func (*StatsResponse_LoadAverage) Descriptor() ([]byte, []int) {
-	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 3}
+// Deprecated: Use SystemInfoRequest.ProtoReflect.Descriptor instead.
+func (*SystemInfoRequest) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *StatsResponse_LoadAverage) GetOneMin() float64 {
-	if x != nil {
-		return x.OneMin
-	}
-	return 0
-}
+type SystemInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *StatsResponse_LoadAverage) GetFiveMin() float64 {
-	if x != nil {
-		return x.FiveMin
-	}
-	return 0
+	// Name of the host
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// Operating system name
+	Os string `protobuf:"bytes,2,opt,name=os,proto3" json:"os,omitempty"`
+	// Architecture of the system
+	Arch string `protobuf:"bytes,3,opt,name=arch,proto3" json:"arch,omitempty"`
+	// Release of the kernel
+	KernelRelease string `protobuf:"bytes,4,opt,name=kernelRelease,proto3" json:"kernelRelease,omitempty"`
+	// Name and version of the distribution
+	Distro string `protobuf:"bytes,5,opt,name=distro,proto3" json:"distro,omitempty"`
+	// Model name of the CPU
+	CpuModel string `protobuf:"bytes,6,opt,name=cpuModel,proto3" json:"cpuModel,omitempty"`
+	// Number of the physical CPU packages
+	CpuSockets int32 `protobuf:"varint,7,opt,name=cpuSockets,proto3" json:"cpuSockets,omitempty"`
+	// Number of the physical cores of all the CPU packages
+	CpuCores int32 `protobuf:"varint,8,opt,name=cpuCores,proto3" json:"cpuCores,omitempty"`
+	// Number of the logical CPUs
+	CpuThreads int32 `protobuf:"varint,9,opt,name=cpuThreads,proto3" json:"cpuThreads,omitempty"`
+	// Total memory in Mb
+	TotalMemoryMb uint64 `protobuf:"varint,10,opt,name=totalMemoryMb,proto3" json:"totalMemoryMb,omitempty"`
+	// Time the system was booted at as Unix time in seconds
+	BootTime int64 `protobuf:"varint,11,opt,name=bootTime,proto3" json:"bootTime,omitempty"`
+	// Time passed since the boot in seconds
+	UptimeSec int64 `protobuf:"varint,12,opt,name=uptimeSec,proto3" json:"uptimeSec,omitempty"`
+	// Vendor of the system from DMI
+	Vendor string `protobuf:"bytes,13,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	// Product name of the system from DMI
+	Product string `protobuf:"bytes,14,opt,name=product,proto3" json:"product,omitempty"`
+	// Unique ID of the system installation
+	MachineId string `protobuf:"bytes,15,opt,name=machineId,proto3" json:"machineId,omitempty"`
 }
 
-func (x *StatsResponse_LoadAverage) GetFifteenMin() float64 {
+func (x *SystemInfoResponse) Reset() {
+	*x = SystemInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemInfoResponse) ProtoMessage() {}
+
+func (x *SystemInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemInfoResponse.ProtoReflect.Descriptor instead.
+func (*SystemInfoResponse) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SystemInfoResponse) GetHostname() string {
 	if x != nil {
-		return x.FifteenMin
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *SystemInfoResponse) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *SystemInfoResponse) GetArch() string {
+	if x != nil {
+		return x.Arch
+	}
+	return ""
+}
+
+func (x *SystemInfoResponse) GetKernelRelease() string {
+	if x != nil {
+		return x.KernelRelease
+	}
+	return ""
+}
+
+func (x *SystemInfoResponse) GetDistro() string {
+	if x != nil {
+		return x.Distro
+	}
+	return ""
+}
+
+func (x *SystemInfoResponse) GetCpuModel() string {
+	if x != nil {
+		return x.CpuModel
+	}
+	return ""
+}
+
+func (x *SystemInfoResponse) GetCpuSockets() int32 {
+	if x != nil {
+		return x.CpuSockets
 	}
 	return 0
 }
 
-var File_api_sysmon_proto protoreflect.FileDescriptor
+func (x *SystemInfoResponse) GetCpuCores() int32 {
+	if x != nil {
+		return x.CpuCores
+	}
+	return 0
+}
 
-var file_api_sysmon_proto_rawDesc = []byte{
-	0x0a, 0x10, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x79, 0x73, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x22, 0x0e, 0x0a, 0x0c, 0x53,
-	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc3, 0x06, 0x0a, 0x0d,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
-	0x03, 0x63, 0x70, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x6f, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x43, 0x50, 0x55, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x2f, 0x0a, 0x04, 0x64,
-	0x69, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x6f, 0x6e, 0x69,
-	0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x12, 0x35, 0x0a, 0x06,
-	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d,
-	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x12, 0x44, 0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61,
-	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
-	0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x6c, 0x6f,
-	0x61, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x1a, 0x45, 0x0a, 0x03, 0x43, 0x50, 0x55,
-	0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04,
-	0x69, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x69, 0x64, 0x6c, 0x65,
-	0x1a, 0xf8, 0x01, 0x0a, 0x04, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x57,
-	0x72, 0x69, 0x74, 0x65, 0x4b, 0x42, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x65,
-	0x61, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4b, 0x42, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x4d, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x4d, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x64, 0x4d, 0x62, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x64, 0x4d, 0x62, 0x12, 0x20, 0x0a, 0x0b, 0x75,
-	0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0b, 0x75, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x75, 0x73, 0x65, 0x64, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2c, 0x0a,
-	0x11, 0x75, 0x73, 0x65, 0x64, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x75, 0x73, 0x65, 0x64, 0x49, 0x6e,
-	0x6f, 0x64, 0x65, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x1a, 0xb2, 0x01, 0x0a, 0x06,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d,
-	0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x62,
-	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x62, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x4d, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x65, 0x65, 0x4d, 0x62, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x66, 0x72, 0x65, 0x65, 0x4d, 0x62, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x4d, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x4d, 0x62, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x4d, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x6e, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x4d, 0x62, 0x12, 0x18, 0x0a, 0x07, 0x57, 0x69, 0x72, 0x65, 0x64, 0x4d,
-	0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x57, 0x69, 0x72, 0x65, 0x64, 0x4d, 0x62,
-	0x1a, 0x5f, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x65, 0x4d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x06, 0x6f, 0x6e, 0x65, 0x4d, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x76, 0x65, 0x4d,
-	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x66, 0x69, 0x76, 0x65, 0x4d, 0x69,
-	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x66, 0x74, 0x65, 0x65, 0x6e, 0x4d, 0x69, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x69, 0x66, 0x74, 0x65, 0x65, 0x6e, 0x4d, 0x69,
-	0x6e, 0x32, 0x4a, 0x0a, 0x0b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x12, 0x3b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x6d,
-	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2f, 0x5a,
-	0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x69, 0x74, 0x6e,
-	0x69, 0x6b, 0x6f, 0x76, 0x69, 0x6b, 0x2f, 0x73, 0x79, 0x73, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x79, 0x73, 0x6d, 0x6f, 0x6e, 0x5f, 0x76, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *SystemInfoResponse) GetCpuThreads() int32 {
+	if x != nil {
+		return x.CpuThreads
+	}
+	return 0
 }
 
-var (
-	file_api_sysmon_proto_rawDescOnce sync.Once
-	file_api_sysmon_proto_rawDescData = file_api_sysmon_proto_rawDesc
-)
+func (x *SystemInfoResponse) GetTotalMemoryMb() uint64 {
+	if x != nil {
+		return x.TotalMemoryMb
+	}
+	return 0
+}
 
-func file_api_sysmon_proto_rawDescGZIP() []byte {
-	file_api_sysmon_proto_rawDescOnce.Do(func() {
-		file_api_sysmon_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_sysmon_proto_rawDescData)
-	})
-	return file_api_sysmon_proto_rawDescData
+func (x *SystemInfoResponse) GetBootTime() int64 {
+	if x != nil {
+		return x.BootTime
+	}
+	return 0
 }
 
-var file_api_sysmon_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_api_sysmon_proto_goTypes = []interface{}{
-	(*StatsRequest)(nil),              // 0: monitor.StatsRequest
-	(*StatsResponse)(nil),             // 1: monitor.StatsResponse
-	(*StatsResponse_CPU)(nil),         // 2: monitor.StatsResponse.CPU
-	(*StatsResponse_Disk)(nil),        // 3: monitor.StatsResponse.Disk
-	(*StatsResponse_Memory)(nil),      // 4: monitor.StatsResponse.Memory
-	(*StatsResponse_LoadAverage)(nil), // 5: monitor.StatsResponse.LoadAverage
+func (x *SystemInfoResponse) GetUptimeSec() int64 {
+	if x != nil {
+		return x.UptimeSec
+	}
+	return 0
 }
-var file_api_sysmon_proto_depIdxs = []int32{
-	2, // 0: monitor.StatsResponse.cpu:type_name -> monitor.StatsResponse.CPU
-	3, // 1: monitor.StatsResponse.disk:type_name -> monitor.StatsResponse.Disk
-	4, // 2: monitor.StatsResponse.memory:type_name -> monitor.StatsResponse.Memory
-	5, // 3: monitor.StatsResponse.loadAverage:type_name -> monitor.StatsResponse.LoadAverage
-	0, // 4: monitor.SystemStats.GetStats:input_type -> monitor.StatsRequest
-	1, // 5: monitor.SystemStats.GetStats:output_type -> monitor.StatsResponse
-	5, // [5:6] is the sub-list for method output_type
-	4, // [4:5] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+
+func (x *SystemInfoResponse) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
 }
 
-func init() { file_api_sysmon_proto_init() }
-func file_api_sysmon_proto_init() {
-	if File_api_sysmon_proto != nil {
-		return
+func (x *SystemInfoResponse) GetProduct() string {
+	if x != nil {
+		return x.Product
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_sysmon_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsRequest); i {
+	return ""
+}
+
+func (x *SystemInfoResponse) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+type BlockDevicesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BlockDevicesRequest) Reset() {
+	*x = BlockDevicesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockDevicesRequest) ProtoMessage() {}
+
+func (x *BlockDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockDevicesRequest.ProtoReflect.Descriptor instead.
+func (*BlockDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{6}
+}
+
+type BlockDevicesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Block devices of the system
+	Devices []*BlockDevicesResponse_BlockDevice `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+}
+
+func (x *BlockDevicesResponse) Reset() {
+	*x = BlockDevicesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockDevicesResponse) ProtoMessage() {}
+
+func (x *BlockDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockDevicesResponse.ProtoReflect.Descriptor instead.
+func (*BlockDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BlockDevicesResponse) GetDevices() []*BlockDevicesResponse_BlockDevice {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type StreamEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether to send the recent events before the new ones
+	History bool `protobuf:"varint,1,opt,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *StreamEventsRequest) Reset() {
+	*x = StreamEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest) ProtoMessage() {}
+
+func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StreamEventsRequest) GetHistory() bool {
+	if x != nil {
+		return x.History
+	}
+	return false
+}
+
+// Represents the discrete system event like the OOM kill
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Time the event happened at as Unix time in milliseconds
+	TimeMs int64 `protobuf:"varint,1,opt,name=timeMs,proto3" json:"timeMs,omitempty"`
+	// Kind of the event: oom_kill, hung_task, fs_error, link_flap, process_start, process_exit or watcher_error
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Severity of the event: critical, error, warning or info
+	Severity string `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	// Kernel message of the event
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Process the event is about like the OOM killer victim
+	Process *Event_Process `protobuf:"bytes,5,opt,name=process,proto3" json:"process,omitempty"`
+	// Device the event is about like the network interface or the disk
+	Device string `protobuf:"bytes,6,opt,name=device,proto3" json:"device,omitempty"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Event) GetTimeMs() int64 {
+	if x != nil {
+		return x.TimeMs
+	}
+	return 0
+}
+
+func (x *Event) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Event) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Event) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Event) GetProcess() *Event_Process {
+	if x != nil {
+		return x.Process
+	}
+	return nil
+}
+
+func (x *Event) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+type ListCollectorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListCollectorsRequest) Reset() {
+	*x = ListCollectorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCollectorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectorsRequest) ProtoMessage() {}
+
+func (x *ListCollectorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectorsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectorsRequest) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{10}
+}
+
+type ListCollectorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Registered metrics collectors in the order they are collected
+	Collectors []*ListCollectorsResponse_Collector `protobuf:"bytes,1,rep,name=collectors,proto3" json:"collectors,omitempty"`
+}
+
+func (x *ListCollectorsResponse) Reset() {
+	*x = ListCollectorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCollectorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectorsResponse) ProtoMessage() {}
+
+func (x *ListCollectorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectorsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectorsResponse) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListCollectorsResponse) GetCollectors() []*ListCollectorsResponse_Collector {
+	if x != nil {
+		return x.Collectors
+	}
+	return nil
+}
+
+type SamplesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Prefixes of the names of the samples to return like memory_, all the samples if empty
+	Prefixes []string `protobuf:"bytes,1,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+}
+
+func (x *SamplesRequest) Reset() {
+	*x = SamplesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SamplesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SamplesRequest) ProtoMessage() {}
+
+func (x *SamplesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SamplesRequest.ProtoReflect.Descriptor instead.
+func (*SamplesRequest) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SamplesRequest) GetPrefixes() []string {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+// Represents the samples of all the metrics collected at once
+type SampleBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Wall-clock aligned time the collection is scheduled at as Unix time in milliseconds
+	TimeMs int64 `protobuf:"varint,1,opt,name=timeMs,proto3" json:"timeMs,omitempty"`
+	// Collected samples
+	Samples []*Sample `protobuf:"bytes,2,rep,name=samples,proto3" json:"samples,omitempty"`
+	// Sequence number of the tick the samples are collected on
+	Seq uint64 `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+}
+
+func (x *SampleBatch) Reset() {
+	*x = SampleBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SampleBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SampleBatch) ProtoMessage() {}
+
+func (x *SampleBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SampleBatch.ProtoReflect.Descriptor instead.
+func (*SampleBatch) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SampleBatch) GetTimeMs() int64 {
+	if x != nil {
+		return x.TimeMs
+	}
+	return 0
+}
+
+func (x *SampleBatch) GetSamples() []*Sample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+func (x *SampleBatch) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// Represents the single named value of a metric with the labels like disk_used_bytes{mount="/data"}
+type Sample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the sample like memory_used_bytes
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Labels telling apart the samples of the same name like the CPU or the device
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Unit of the value like bytes, percent or per_second
+	Unit string `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	// Type of the value: gauge, counter or rate
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// Value of the sample
+	Value float64 `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+	// Time the sample was collected at as Unix time in milliseconds
+	TimeMs int64 `protobuf:"varint,6,opt,name=timeMs,proto3" json:"timeMs,omitempty"`
+}
+
+func (x *Sample) Reset() {
+	*x = Sample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sample) ProtoMessage() {}
+
+func (x *Sample) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sample.ProtoReflect.Descriptor instead.
+func (*Sample) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Sample) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Sample) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Sample) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Sample) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Sample) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Sample) GetTimeMs() int64 {
+	if x != nil {
+		return x.TimeMs
+	}
+	return 0
+}
+
+type CollectorStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Names of the collectors to return the health of, all the collectors if empty
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *CollectorStatusRequest) Reset() {
+	*x = CollectorStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectorStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectorStatusRequest) ProtoMessage() {}
+
+func (x *CollectorStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectorStatusRequest.ProtoReflect.Descriptor instead.
+func (*CollectorStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CollectorStatusRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type CollectorStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Health of the collectors in the order they are collected
+	Collectors []*CollectorStatusResponse_Collector `protobuf:"bytes,1,rep,name=collectors,proto3" json:"collectors,omitempty"`
+}
+
+func (x *CollectorStatusResponse) Reset() {
+	*x = CollectorStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectorStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectorStatusResponse) ProtoMessage() {}
+
+func (x *CollectorStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectorStatusResponse.ProtoReflect.Descriptor instead.
+func (*CollectorStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CollectorStatusResponse) GetCollectors() []*CollectorStatusResponse_Collector {
+	if x != nil {
+		return x.Collectors
+	}
+	return nil
+}
+
+type SetCollectorEnabledRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the collector like cpu
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether to collect the metric
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *SetCollectorEnabledRequest) Reset() {
+	*x = SetCollectorEnabledRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCollectorEnabledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectorEnabledRequest) ProtoMessage() {}
+
+func (x *SetCollectorEnabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectorEnabledRequest.ProtoReflect.Descriptor instead.
+func (*SetCollectorEnabledRequest) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetCollectorEnabledRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetCollectorEnabledRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetCollectorEnabledResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the collector
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the metric is collected
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *SetCollectorEnabledResponse) Reset() {
+	*x = SetCollectorEnabledResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCollectorEnabledResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectorEnabledResponse) ProtoMessage() {}
+
+func (x *SetCollectorEnabledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectorEnabledResponse.ProtoReflect.Descriptor instead.
+func (*SetCollectorEnabledResponse) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetCollectorEnabledResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetCollectorEnabledResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// Represents the run of the metrics collection
+type StatsResponse_Tick struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sequence number of the tick, the gaps show the ticks skipped or coalesced
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	// Wall-clock aligned time the tick is scheduled at as Unix time in milliseconds
+	TimeMs int64 `protobuf:"varint,2,opt,name=timeMs,proto3" json:"timeMs,omitempty"`
+	// Delay of the tick start from the time it is scheduled at in milliseconds
+	JitterMs float64 `protobuf:"fixed64,3,opt,name=jitterMs,proto3" json:"jitterMs,omitempty"`
+	// Number of the ticks merged into this one since the previous tick overran
+	Coalesced uint64 `protobuf:"varint,4,opt,name=coalesced,proto3" json:"coalesced,omitempty"`
+}
+
+func (x *StatsResponse_Tick) Reset() {
+	*x = StatsResponse_Tick{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_Tick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_Tick) ProtoMessage() {}
+
+func (x *StatsResponse_Tick) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_Tick.ProtoReflect.Descriptor instead.
+func (*StatsResponse_Tick) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *StatsResponse_Tick) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *StatsResponse_Tick) GetTimeMs() int64 {
+	if x != nil {
+		return x.TimeMs
+	}
+	return 0
+}
+
+func (x *StatsResponse_Tick) GetJitterMs() float64 {
+	if x != nil {
+		return x.JitterMs
+	}
+	return 0
+}
+
+func (x *StatsResponse_Tick) GetCoalesced() uint64 {
+	if x != nil {
+		return x.Coalesced
+	}
+	return 0
+}
+
+// Represents the statistics of the ticks of the metrics collection
+type StatsResponse_Scheduler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Policy of the ticks fired while the previous one is still running: skip, queue or coalesce
+	Policy string `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	// Number of the ticks scheduled
+	Scheduled uint64 `protobuf:"varint,2,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	// Number of the ticks run
+	Run uint64 `protobuf:"varint,3,opt,name=run,proto3" json:"run,omitempty"`
+	// Number of the ticks dropped since the previous one overran or the queue was full
+	Skipped uint64 `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// Number of the ticks merged into the next ones since the previous one overran
+	Coalesced uint64 `protobuf:"varint,5,opt,name=coalesced,proto3" json:"coalesced,omitempty"`
+	// Number of the ticks lasted longer than the interval
+	Overruns uint64 `protobuf:"varint,6,opt,name=overruns,proto3" json:"overruns,omitempty"`
+	// Delay of the last tick start in milliseconds
+	LastJitterMs float64 `protobuf:"fixed64,7,opt,name=lastJitterMs,proto3" json:"lastJitterMs,omitempty"`
+	// Mean delay of the ticks start in milliseconds
+	MeanJitterMs float64 `protobuf:"fixed64,8,opt,name=meanJitterMs,proto3" json:"meanJitterMs,omitempty"`
+	// Maximum delay of the ticks start in milliseconds
+	MaxJitterMs float64 `protobuf:"fixed64,9,opt,name=maxJitterMs,proto3" json:"maxJitterMs,omitempty"`
+}
+
+func (x *StatsResponse_Scheduler) Reset() {
+	*x = StatsResponse_Scheduler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_Scheduler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_Scheduler) ProtoMessage() {}
+
+func (x *StatsResponse_Scheduler) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_Scheduler.ProtoReflect.Descriptor instead.
+func (*StatsResponse_Scheduler) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *StatsResponse_Scheduler) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *StatsResponse_Scheduler) GetScheduled() uint64 {
+	if x != nil {
+		return x.Scheduled
+	}
+	return 0
+}
+
+func (x *StatsResponse_Scheduler) GetRun() uint64 {
+	if x != nil {
+		return x.Run
+	}
+	return 0
+}
+
+func (x *StatsResponse_Scheduler) GetSkipped() uint64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *StatsResponse_Scheduler) GetCoalesced() uint64 {
+	if x != nil {
+		return x.Coalesced
+	}
+	return 0
+}
+
+func (x *StatsResponse_Scheduler) GetOverruns() uint64 {
+	if x != nil {
+		return x.Overruns
+	}
+	return 0
+}
+
+func (x *StatsResponse_Scheduler) GetLastJitterMs() float64 {
+	if x != nil {
+		return x.LastJitterMs
+	}
+	return 0
+}
+
+func (x *StatsResponse_Scheduler) GetMeanJitterMs() float64 {
+	if x != nil {
+		return x.MeanJitterMs
+	}
+	return 0
+}
+
+func (x *StatsResponse_Scheduler) GetMaxJitterMs() float64 {
+	if x != nil {
+		return x.MaxJitterMs
+	}
+	return 0
+}
+
+// Represents the CPU statistics
+type StatsResponse_CPU struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Percentage of CPU time spent in user space
+	User float64 `protobuf:"fixed64,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Percentage of CPU time spent in kernel space
+	System float64 `protobuf:"fixed64,2,opt,name=system,proto3" json:"system,omitempty"`
+	// Percentage of CPU time spent idle
+	Idle float64 `protobuf:"fixed64,3,opt,name=idle,proto3" json:"idle,omitempty"`
+}
+
+func (x *StatsResponse_CPU) Reset() {
+	*x = StatsResponse_CPU{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_CPU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_CPU) ProtoMessage() {}
+
+func (x *StatsResponse_CPU) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_CPU.ProtoReflect.Descriptor instead.
+func (*StatsResponse_CPU) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *StatsResponse_CPU) GetUser() float64 {
+	if x != nil {
+		return x.User
+	}
+	return 0
+}
+
+func (x *StatsResponse_CPU) GetSystem() float64 {
+	if x != nil {
+		return x.System
+	}
+	return 0
+}
+
+func (x *StatsResponse_CPU) GetIdle() float64 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+// Represents the disk statistics
+type StatsResponse_Disk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of reads per second
+	Reads float64 `protobuf:"fixed64,1,opt,name=reads,proto3" json:"reads,omitempty"`
+	// Number of writes per second
+	Writes float64 `protobuf:"fixed64,2,opt,name=writes,proto3" json:"writes,omitempty"`
+	// Number of kilobytes read+write per second
+	ReadWriteKB float64 `protobuf:"fixed64,3,opt,name=readWriteKB,proto3" json:"readWriteKB,omitempty"`
+	// Total disk space in Mb
+	TotalMb uint64 `protobuf:"varint,4,opt,name=totalMb,proto3" json:"totalMb,omitempty"`
+	// Used disk space in Mb
+	UsedMb uint64 `protobuf:"varint,5,opt,name=usedMb,proto3" json:"usedMb,omitempty"`
+	// Used disk space in percentage
+	UsedPercent float64 `protobuf:"fixed64,6,opt,name=usedPercent,proto3" json:"usedPercent,omitempty"`
+	// Number of used inodes
+	UsedInodes uint64 `protobuf:"varint,7,opt,name=usedInodes,proto3" json:"usedInodes,omitempty"`
+	// Used inodes in percentage
+	UsedInodesPercent float64 `protobuf:"fixed64,8,opt,name=usedInodesPercent,proto3" json:"usedInodesPercent,omitempty"`
+}
+
+func (x *StatsResponse_Disk) Reset() {
+	*x = StatsResponse_Disk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_Disk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_Disk) ProtoMessage() {}
+
+func (x *StatsResponse_Disk) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_Disk.ProtoReflect.Descriptor instead.
+func (*StatsResponse_Disk) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 4}
+}
+
+func (x *StatsResponse_Disk) GetReads() float64 {
+	if x != nil {
+		return x.Reads
+	}
+	return 0
+}
+
+func (x *StatsResponse_Disk) GetWrites() float64 {
+	if x != nil {
+		return x.Writes
+	}
+	return 0
+}
+
+func (x *StatsResponse_Disk) GetReadWriteKB() float64 {
+	if x != nil {
+		return x.ReadWriteKB
+	}
+	return 0
+}
+
+func (x *StatsResponse_Disk) GetTotalMb() uint64 {
+	if x != nil {
+		return x.TotalMb
+	}
+	return 0
+}
+
+func (x *StatsResponse_Disk) GetUsedMb() uint64 {
+	if x != nil {
+		return x.UsedMb
+	}
+	return 0
+}
+
+func (x *StatsResponse_Disk) GetUsedPercent() float64 {
+	if x != nil {
+		return x.UsedPercent
+	}
+	return 0
+}
+
+func (x *StatsResponse_Disk) GetUsedInodes() uint64 {
+	if x != nil {
+		return x.UsedInodes
+	}
+	return 0
+}
+
+func (x *StatsResponse_Disk) GetUsedInodesPercent() float64 {
+	if x != nil {
+		return x.UsedInodesPercent
+	}
+	return 0
+}
+
+type StatsResponse_Memory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Total memory in Mb
+	TotalMb uint64 `protobuf:"varint,1,opt,name=totalMb,proto3" json:"totalMb,omitempty"`
+	// Available memory in Mb shows how much memory in Mb is available for new processes.
+	AvailableMb uint64 `protobuf:"varint,2,opt,name=availableMb,proto3" json:"availableMb,omitempty"`
+	// Free memory in Mb shows how much memory in Mb is available for new processes.
+	// If this value is high, it means that the system has some spare memory,
+	// allowing more applications to run without having to free up memory.
+	FreeMb uint64 `protobuf:"varint,3,opt,name=freeMb,proto3" json:"freeMb,omitempty"`
+	// Active memory in Mb hows how much memory in Mb that are currently being actively used by processes.
+	// These pages contain data that is actively being read or written.
+	ActiveMb uint64 `protobuf:"varint,4,opt,name=activeMb,proto3" json:"activeMb,omitempty"`
+	// Inactive shows how much memory in Mb that were previously used but are not currently active.
+	// These pages may contain data that is not used, but can be restored to the active state if necessary.
+	InactiveMb uint64 `protobuf:"varint,5,opt,name=inactiveMb,proto3" json:"inactiveMb,omitempty"`
+	// Wired shows how much memory in Mb  that are hard-locked in RAM and cannot be paged out or released.
+	// These are usually mission-critical pages that are used by the operating system kernel or drivers,
+	// and they are necessary for the system to work.
+	WiredMb uint64 `protobuf:"varint,6,opt,name=WiredMb,proto3" json:"WiredMb,omitempty"`
+	// Memory used by the kernel slab allocator in Mb
+	SlabMb uint64 `protobuf:"varint,7,opt,name=slabMb,proto3" json:"slabMb,omitempty"`
+	// Slab memory in Mb that can be reclaimed like the dentries and inodes caches
+	SlabReclaimableMb uint64 `protobuf:"varint,8,opt,name=slabReclaimableMb,proto3" json:"slabReclaimableMb,omitempty"`
+	// Memory reserved by the hugepage pools of all sizes in Mb
+	HugetlbMb uint64 `protobuf:"varint,9,opt,name=hugetlbMb,proto3" json:"hugetlbMb,omitempty"`
+	// Memory of every NUMA node
+	NumaNodes []*StatsResponse_Memory_NUMANode `protobuf:"bytes,10,rep,name=numaNodes,proto3" json:"numaNodes,omitempty"`
+	// Hugepage pools of every page size
+	HugePages []*StatsResponse_Memory_HugePagePool `protobuf:"bytes,11,rep,name=hugePages,proto3" json:"hugePages,omitempty"`
+	// Largest slab caches by size, empty if /proc/slabinfo is not readable
+	TopSlabCaches []*StatsResponse_Memory_SlabCache `protobuf:"bytes,12,rep,name=topSlabCaches,proto3" json:"topSlabCaches,omitempty"`
+}
+
+func (x *StatsResponse_Memory) Reset() {
+	*x = StatsResponse_Memory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_Memory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_Memory) ProtoMessage() {}
+
+func (x *StatsResponse_Memory) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_Memory.ProtoReflect.Descriptor instead.
+func (*StatsResponse_Memory) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 5}
+}
+
+func (x *StatsResponse_Memory) GetTotalMb() uint64 {
+	if x != nil {
+		return x.TotalMb
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory) GetAvailableMb() uint64 {
+	if x != nil {
+		return x.AvailableMb
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory) GetFreeMb() uint64 {
+	if x != nil {
+		return x.FreeMb
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory) GetActiveMb() uint64 {
+	if x != nil {
+		return x.ActiveMb
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory) GetInactiveMb() uint64 {
+	if x != nil {
+		return x.InactiveMb
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory) GetWiredMb() uint64 {
+	if x != nil {
+		return x.WiredMb
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory) GetSlabMb() uint64 {
+	if x != nil {
+		return x.SlabMb
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory) GetSlabReclaimableMb() uint64 {
+	if x != nil {
+		return x.SlabReclaimableMb
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory) GetHugetlbMb() uint64 {
+	if x != nil {
+		return x.HugetlbMb
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory) GetNumaNodes() []*StatsResponse_Memory_NUMANode {
+	if x != nil {
+		return x.NumaNodes
+	}
+	return nil
+}
+
+func (x *StatsResponse_Memory) GetHugePages() []*StatsResponse_Memory_HugePagePool {
+	if x != nil {
+		return x.HugePages
+	}
+	return nil
+}
+
+func (x *StatsResponse_Memory) GetTopSlabCaches() []*StatsResponse_Memory_SlabCache {
+	if x != nil {
+		return x.TopSlabCaches
+	}
+	return nil
+}
+
+// Represents the system load average
+type StatsResponse_LoadAverage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Average load for the last minute
+	OneMin float64 `protobuf:"fixed64,1,opt,name=oneMin,proto3" json:"oneMin,omitempty"`
+	// Average load for the last five minutes
+	FiveMin float64 `protobuf:"fixed64,2,opt,name=fiveMin,proto3" json:"fiveMin,omitempty"`
+	// Average load for the last fifteen minutes
+	FifteenMin float64 `protobuf:"fixed64,3,opt,name=fifteenMin,proto3" json:"fifteenMin,omitempty"`
+}
+
+func (x *StatsResponse_LoadAverage) Reset() {
+	*x = StatsResponse_LoadAverage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_LoadAverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_LoadAverage) ProtoMessage() {}
+
+func (x *StatsResponse_LoadAverage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_LoadAverage.ProtoReflect.Descriptor instead.
+func (*StatsResponse_LoadAverage) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 6}
+}
+
+func (x *StatsResponse_LoadAverage) GetOneMin() float64 {
+	if x != nil {
+		return x.OneMin
+	}
+	return 0
+}
+
+func (x *StatsResponse_LoadAverage) GetFiveMin() float64 {
+	if x != nil {
+		return x.FiveMin
+	}
+	return 0
+}
+
+func (x *StatsResponse_LoadAverage) GetFifteenMin() float64 {
+	if x != nil {
+		return x.FifteenMin
+	}
+	return 0
+}
+
+// Represents the interrupts distribution among the CPUs
+type StatsResponse_Interrupts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of CPUs the interrupts are distributed among
+	Cpus int32 `protobuf:"varint,1,opt,name=cpus,proto3" json:"cpus,omitempty"`
+	// Busiest numbered device interrupts sorted by the rate
+	TopIrqs []*StatsResponse_IRQ `protobuf:"bytes,2,rep,name=topIrqs,proto3" json:"topIrqs,omitempty"`
+	// Software interrupts
+	SoftIrqs []*StatsResponse_IRQ `protobuf:"bytes,3,rep,name=softIrqs,proto3" json:"softIrqs,omitempty"`
+	// Number of device interrupts per second handled by each CPU
+	PerCpu []float64 `protobuf:"fixed64,4,rep,packed,name=perCpu,proto3" json:"perCpu,omitempty"`
+	// Index of the CPU handling the most of device interrupts
+	BusiestCpu int32 `protobuf:"varint,5,opt,name=busiestCpu,proto3" json:"busiestCpu,omitempty"`
+	// Ratio of the busiest CPU rate to the mean one
+	Imbalance float64 `protobuf:"fixed64,6,opt,name=imbalance,proto3" json:"imbalance,omitempty"`
+	// Local interrupts of the CPUs like the timer and the IPIs not counted in the distribution
+	LocalIrqs []*StatsResponse_IRQ `protobuf:"bytes,7,rep,name=localIrqs,proto3" json:"localIrqs,omitempty"`
+}
+
+func (x *StatsResponse_Interrupts) Reset() {
+	*x = StatsResponse_Interrupts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_Interrupts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_Interrupts) ProtoMessage() {}
+
+func (x *StatsResponse_Interrupts) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_Interrupts.ProtoReflect.Descriptor instead.
+func (*StatsResponse_Interrupts) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 7}
+}
+
+func (x *StatsResponse_Interrupts) GetCpus() int32 {
+	if x != nil {
+		return x.Cpus
+	}
+	return 0
+}
+
+func (x *StatsResponse_Interrupts) GetTopIrqs() []*StatsResponse_IRQ {
+	if x != nil {
+		return x.TopIrqs
+	}
+	return nil
+}
+
+func (x *StatsResponse_Interrupts) GetSoftIrqs() []*StatsResponse_IRQ {
+	if x != nil {
+		return x.SoftIrqs
+	}
+	return nil
+}
+
+func (x *StatsResponse_Interrupts) GetPerCpu() []float64 {
+	if x != nil {
+		return x.PerCpu
+	}
+	return nil
+}
+
+func (x *StatsResponse_Interrupts) GetBusiestCpu() int32 {
+	if x != nil {
+		return x.BusiestCpu
+	}
+	return 0
+}
+
+func (x *StatsResponse_Interrupts) GetImbalance() float64 {
+	if x != nil {
+		return x.Imbalance
+	}
+	return 0
+}
+
+func (x *StatsResponse_Interrupts) GetLocalIrqs() []*StatsResponse_IRQ {
+	if x != nil {
+		return x.LocalIrqs
+	}
+	return nil
+}
+
+// Represents the rates of the single interrupt
+type StatsResponse_IRQ struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Interrupt number or name
+	Irq string `protobuf:"bytes,1,opt,name=irq,proto3" json:"irq,omitempty"`
+	// Name of the device or the description of the interrupt
+	Device string `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	// Number of interrupts per second handled by each CPU
+	PerCpu []float64 `protobuf:"fixed64,3,rep,packed,name=perCpu,proto3" json:"perCpu,omitempty"`
+	// Number of interrupts per second handled by all CPUs
+	Total float64 `protobuf:"fixed64,4,opt,name=total,proto3" json:"total,omitempty"`
+	// Index of the CPU handling the most of the interrupts
+	BusiestCpu int32 `protobuf:"varint,5,opt,name=busiestCpu,proto3" json:"busiestCpu,omitempty"`
+	// Ratio of the busiest CPU rate to the mean one
+	Imbalance float64 `protobuf:"fixed64,6,opt,name=imbalance,proto3" json:"imbalance,omitempty"`
+}
+
+func (x *StatsResponse_IRQ) Reset() {
+	*x = StatsResponse_IRQ{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_IRQ) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_IRQ) ProtoMessage() {}
+
+func (x *StatsResponse_IRQ) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_IRQ.ProtoReflect.Descriptor instead.
+func (*StatsResponse_IRQ) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 8}
+}
+
+func (x *StatsResponse_IRQ) GetIrq() string {
+	if x != nil {
+		return x.Irq
+	}
+	return ""
+}
+
+func (x *StatsResponse_IRQ) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *StatsResponse_IRQ) GetPerCpu() []float64 {
+	if x != nil {
+		return x.PerCpu
+	}
+	return nil
+}
+
+func (x *StatsResponse_IRQ) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *StatsResponse_IRQ) GetBusiestCpu() int32 {
+	if x != nil {
+		return x.BusiestCpu
+	}
+	return 0
+}
+
+func (x *StatsResponse_IRQ) GetImbalance() float64 {
+	if x != nil {
+		return x.Imbalance
+	}
+	return 0
+}
+
+// Represents the network protocols statistics.
+// All the values except the number of established connections are rates per second
+type StatsResponse_NetProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip   *StatsResponse_NetProto_IP   `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Tcp  *StatsResponse_NetProto_TCP  `protobuf:"bytes,2,opt,name=tcp,proto3" json:"tcp,omitempty"`
+	Udp  *StatsResponse_NetProto_UDP  `protobuf:"bytes,3,opt,name=udp,proto3" json:"udp,omitempty"`
+	Icmp *StatsResponse_NetProto_ICMP `protobuf:"bytes,4,opt,name=icmp,proto3" json:"icmp,omitempty"`
+}
+
+func (x *StatsResponse_NetProto) Reset() {
+	*x = StatsResponse_NetProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_NetProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_NetProto) ProtoMessage() {}
+
+func (x *StatsResponse_NetProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_NetProto.ProtoReflect.Descriptor instead.
+func (*StatsResponse_NetProto) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 9}
+}
+
+func (x *StatsResponse_NetProto) GetIp() *StatsResponse_NetProto_IP {
+	if x != nil {
+		return x.Ip
+	}
+	return nil
+}
+
+func (x *StatsResponse_NetProto) GetTcp() *StatsResponse_NetProto_TCP {
+	if x != nil {
+		return x.Tcp
+	}
+	return nil
+}
+
+func (x *StatsResponse_NetProto) GetUdp() *StatsResponse_NetProto_UDP {
+	if x != nil {
+		return x.Udp
+	}
+	return nil
+}
+
+func (x *StatsResponse_NetProto) GetIcmp() *StatsResponse_NetProto_ICMP {
+	if x != nil {
+		return x.Icmp
+	}
+	return nil
+}
+
+// Represents the system-wide usage of the kernel tables against their limits
+type StatsResponse_Limits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Allocated file handles against fs.file-max
+	FileHandles *StatsResponse_LimitUsage `protobuf:"bytes,1,opt,name=fileHandles,proto3" json:"fileHandles,omitempty"`
+	// Used PIDs by processes and threads against kernel.pid_max
+	Pids *StatsResponse_LimitUsage `protobuf:"bytes,2,opt,name=pids,proto3" json:"pids,omitempty"`
+	// Connection tracking entries against net.netfilter.nf_conntrack_max
+	Conntrack *StatsResponse_LimitUsage `protobuf:"bytes,3,opt,name=conntrack,proto3" json:"conntrack,omitempty"`
+	// Inotify watches of the user closest to fs.inotify.max_user_watches
+	InotifyWatches *StatsResponse_LimitUsage `protobuf:"bytes,4,opt,name=inotifyWatches,proto3" json:"inotifyWatches,omitempty"`
+	// Inotify instances of the user closest to fs.inotify.max_user_instances
+	InotifyInstances *StatsResponse_LimitUsage `protobuf:"bytes,5,opt,name=inotifyInstances,proto3" json:"inotifyInstances,omitempty"`
+}
+
+func (x *StatsResponse_Limits) Reset() {
+	*x = StatsResponse_Limits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_Limits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_Limits) ProtoMessage() {}
+
+func (x *StatsResponse_Limits) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_Limits.ProtoReflect.Descriptor instead.
+func (*StatsResponse_Limits) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 10}
+}
+
+func (x *StatsResponse_Limits) GetFileHandles() *StatsResponse_LimitUsage {
+	if x != nil {
+		return x.FileHandles
+	}
+	return nil
+}
+
+func (x *StatsResponse_Limits) GetPids() *StatsResponse_LimitUsage {
+	if x != nil {
+		return x.Pids
+	}
+	return nil
+}
+
+func (x *StatsResponse_Limits) GetConntrack() *StatsResponse_LimitUsage {
+	if x != nil {
+		return x.Conntrack
+	}
+	return nil
+}
+
+func (x *StatsResponse_Limits) GetInotifyWatches() *StatsResponse_LimitUsage {
+	if x != nil {
+		return x.InotifyWatches
+	}
+	return nil
+}
+
+func (x *StatsResponse_Limits) GetInotifyInstances() *StatsResponse_LimitUsage {
+	if x != nil {
+		return x.InotifyInstances
+	}
+	return nil
+}
+
+// Represents the usage of the kernel limit
+type StatsResponse_LimitUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of the used entries
+	Used uint64 `protobuf:"varint,1,opt,name=used,proto3" json:"used,omitempty"`
+	// Maximum number of the entries
+	Max uint64 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	// Utilisation of the limit in percentage
+	UsedPercent float64 `protobuf:"fixed64,3,opt,name=usedPercent,proto3" json:"usedPercent,omitempty"`
+	// Whether the limit exists on the system
+	Available bool `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"`
+}
+
+func (x *StatsResponse_LimitUsage) Reset() {
+	*x = StatsResponse_LimitUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_LimitUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_LimitUsage) ProtoMessage() {}
+
+func (x *StatsResponse_LimitUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_LimitUsage.ProtoReflect.Descriptor instead.
+func (*StatsResponse_LimitUsage) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 11}
+}
+
+func (x *StatsResponse_LimitUsage) GetUsed() uint64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *StatsResponse_LimitUsage) GetMax() uint64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *StatsResponse_LimitUsage) GetUsedPercent() float64 {
+	if x != nil {
+		return x.UsedPercent
+	}
+	return 0
+}
+
+func (x *StatsResponse_LimitUsage) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+// Represents the CPU frequency, throttling and temperatures
+type StatsResponse_Thermal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Frequency and throttling of every CPU
+	Cpus []*StatsResponse_Thermal_CPUFreq `protobuf:"bytes,1,rep,name=cpus,proto3" json:"cpus,omitempty"`
+	// Temperature sensors
+	Sensors []*StatsResponse_Thermal_Sensor `protobuf:"bytes,2,rep,name=sensors,proto3" json:"sensors,omitempty"`
+}
+
+func (x *StatsResponse_Thermal) Reset() {
+	*x = StatsResponse_Thermal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_Thermal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_Thermal) ProtoMessage() {}
+
+func (x *StatsResponse_Thermal) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_Thermal.ProtoReflect.Descriptor instead.
+func (*StatsResponse_Thermal) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 12}
+}
+
+func (x *StatsResponse_Thermal) GetCpus() []*StatsResponse_Thermal_CPUFreq {
+	if x != nil {
+		return x.Cpus
+	}
+	return nil
+}
+
+func (x *StatsResponse_Thermal) GetSensors() []*StatsResponse_Thermal_Sensor {
+	if x != nil {
+		return x.Sensors
+	}
+	return nil
+}
+
+// Represents the software RAID arrays status
+type StatsResponse_MDStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// md arrays
+	Arrays []*StatsResponse_MDStat_Array `protobuf:"bytes,1,rep,name=arrays,proto3" json:"arrays,omitempty"`
+}
+
+func (x *StatsResponse_MDStat) Reset() {
+	*x = StatsResponse_MDStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_MDStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_MDStat) ProtoMessage() {}
+
+func (x *StatsResponse_MDStat) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_MDStat.ProtoReflect.Descriptor instead.
+func (*StatsResponse_MDStat) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 13}
+}
+
+func (x *StatsResponse_MDStat) GetArrays() []*StatsResponse_MDStat_Array {
+	if x != nil {
+		return x.Arrays
+	}
+	return nil
+}
+
+// Represents the ZFS adaptive replacement cache statistics
+type StatsResponse_ZFSArc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Current size of the ARC in bytes
+	SizeBytes uint64 `protobuf:"varint,1,opt,name=sizeBytes,proto3" json:"sizeBytes,omitempty"`
+	// Size the ARC is adapting to in bytes
+	TargetBytes uint64 `protobuf:"varint,2,opt,name=targetBytes,proto3" json:"targetBytes,omitempty"`
+	// Minimum size of the ARC in bytes
+	MinBytes uint64 `protobuf:"varint,3,opt,name=minBytes,proto3" json:"minBytes,omitempty"`
+	// Maximum size of the ARC in bytes
+	MaxBytes uint64 `protobuf:"varint,4,opt,name=maxBytes,proto3" json:"maxBytes,omitempty"`
+	// Number of the ARC hits per second
+	Hits float64 `protobuf:"fixed64,5,opt,name=hits,proto3" json:"hits,omitempty"`
+	// Number of the ARC misses per second
+	Misses float64 `protobuf:"fixed64,6,opt,name=misses,proto3" json:"misses,omitempty"`
+	// Percentage of the hits among all the ARC lookups
+	HitPercent float64 `protobuf:"fixed64,7,opt,name=hitPercent,proto3" json:"hitPercent,omitempty"`
+}
+
+func (x *StatsResponse_ZFSArc) Reset() {
+	*x = StatsResponse_ZFSArc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_ZFSArc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_ZFSArc) ProtoMessage() {}
+
+func (x *StatsResponse_ZFSArc) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_ZFSArc.ProtoReflect.Descriptor instead.
+func (*StatsResponse_ZFSArc) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 14}
+}
+
+func (x *StatsResponse_ZFSArc) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *StatsResponse_ZFSArc) GetTargetBytes() uint64 {
+	if x != nil {
+		return x.TargetBytes
+	}
+	return 0
+}
+
+func (x *StatsResponse_ZFSArc) GetMinBytes() uint64 {
+	if x != nil {
+		return x.MinBytes
+	}
+	return 0
+}
+
+func (x *StatsResponse_ZFSArc) GetMaxBytes() uint64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *StatsResponse_ZFSArc) GetHits() float64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *StatsResponse_ZFSArc) GetMisses() float64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *StatsResponse_ZFSArc) GetHitPercent() float64 {
+	if x != nil {
+		return x.HitPercent
+	}
+	return 0
+}
+
+// Represents the system clock synchronisation status
+type StatsResponse_TimeSync struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the clock is synchronised by NTP or PTP daemon
+	Synchronised bool `protobuf:"varint,1,opt,name=synchronised,proto3" json:"synchronised,omitempty"`
+	// Clock state like ok or insert leap second
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// Offset of the clock from the reference time in milliseconds
+	OffsetMs float64 `protobuf:"fixed64,3,opt,name=offsetMs,proto3" json:"offsetMs,omitempty"`
+	// Maximum error of the clock in milliseconds
+	MaxErrorMs float64 `protobuf:"fixed64,4,opt,name=maxErrorMs,proto3" json:"maxErrorMs,omitempty"`
+	// Estimated error of the clock in milliseconds
+	EstErrorMs float64 `protobuf:"fixed64,5,opt,name=estErrorMs,proto3" json:"estErrorMs,omitempty"`
+	// Frequency adjustment of the clock in parts per million
+	FreqPpm float64 `protobuf:"fixed64,6,opt,name=freqPpm,proto3" json:"freqPpm,omitempty"`
+	// Offset of the TAI from UTC in seconds, 0 if not set by the daemon
+	TaiOffsetSec int32 `protobuf:"varint,7,opt,name=taiOffsetSec,proto3" json:"taiOffsetSec,omitempty"`
+}
+
+func (x *StatsResponse_TimeSync) Reset() {
+	*x = StatsResponse_TimeSync{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_TimeSync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_TimeSync) ProtoMessage() {}
+
+func (x *StatsResponse_TimeSync) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_TimeSync.ProtoReflect.Descriptor instead.
+func (*StatsResponse_TimeSync) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 15}
+}
+
+func (x *StatsResponse_TimeSync) GetSynchronised() bool {
+	if x != nil {
+		return x.Synchronised
+	}
+	return false
+}
+
+func (x *StatsResponse_TimeSync) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *StatsResponse_TimeSync) GetOffsetMs() float64 {
+	if x != nil {
+		return x.OffsetMs
+	}
+	return 0
+}
+
+func (x *StatsResponse_TimeSync) GetMaxErrorMs() float64 {
+	if x != nil {
+		return x.MaxErrorMs
+	}
+	return 0
+}
+
+func (x *StatsResponse_TimeSync) GetEstErrorMs() float64 {
+	if x != nil {
+		return x.EstErrorMs
+	}
+	return 0
+}
+
+func (x *StatsResponse_TimeSync) GetFreqPpm() float64 {
+	if x != nil {
+		return x.FreqPpm
+	}
+	return 0
+}
+
+func (x *StatsResponse_TimeSync) GetTaiOffsetSec() int32 {
+	if x != nil {
+		return x.TaiOffsetSec
+	}
+	return 0
+}
+
+// Represents the active login sessions
+type StatsResponse_Sessions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Active login sessions
+	Sessions []*StatsResponse_Sessions_Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	// Number of the sessions by the user names
+	PerUser map[string]int32 `protobuf:"bytes,2,rep,name=perUser,proto3" json:"perUser,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *StatsResponse_Sessions) Reset() {
+	*x = StatsResponse_Sessions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_Sessions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_Sessions) ProtoMessage() {}
+
+func (x *StatsResponse_Sessions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_Sessions.ProtoReflect.Descriptor instead.
+func (*StatsResponse_Sessions) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 16}
+}
+
+func (x *StatsResponse_Sessions) GetSessions() []*StatsResponse_Sessions_Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *StatsResponse_Sessions) GetPerUser() map[string]int32 {
+	if x != nil {
+		return x.PerUser
+	}
+	return nil
+}
+
+// Represents the processes and threads states summary
+type StatsResponse_ProcState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of the processes by their states
+	Processes *StatsResponse_ProcState_StateCounts `protobuf:"bytes,1,opt,name=processes,proto3" json:"processes,omitempty"`
+	// Number of the threads by their states
+	Threads *StatsResponse_ProcState_StateCounts `protobuf:"bytes,2,opt,name=threads,proto3" json:"threads,omitempty"`
+	// Total number of the processes
+	TotalProcesses int32 `protobuf:"varint,3,opt,name=totalProcesses,proto3" json:"totalProcesses,omitempty"`
+	// Total number of the threads of all the processes
+	TotalThreads int32 `protobuf:"varint,4,opt,name=totalThreads,proto3" json:"totalThreads,omitempty"`
+	// Processes not reaping their zombie children
+	ZombieParents []*StatsResponse_ProcState_ZombieParent `protobuf:"bytes,5,rep,name=zombieParents,proto3" json:"zombieParents,omitempty"`
+	// Threads staying in the uninterruptible sleep for long
+	Blocked []*StatsResponse_ProcState_BlockedTask `protobuf:"bytes,6,rep,name=blocked,proto3" json:"blocked,omitempty"`
+}
+
+func (x *StatsResponse_ProcState) Reset() {
+	*x = StatsResponse_ProcState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_ProcState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_ProcState) ProtoMessage() {}
+
+func (x *StatsResponse_ProcState) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_ProcState.ProtoReflect.Descriptor instead.
+func (*StatsResponse_ProcState) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 17}
+}
+
+func (x *StatsResponse_ProcState) GetProcesses() *StatsResponse_ProcState_StateCounts {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
+}
+
+func (x *StatsResponse_ProcState) GetThreads() *StatsResponse_ProcState_StateCounts {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+func (x *StatsResponse_ProcState) GetTotalProcesses() int32 {
+	if x != nil {
+		return x.TotalProcesses
+	}
+	return 0
+}
+
+func (x *StatsResponse_ProcState) GetTotalThreads() int32 {
+	if x != nil {
+		return x.TotalThreads
+	}
+	return 0
+}
+
+func (x *StatsResponse_ProcState) GetZombieParents() []*StatsResponse_ProcState_ZombieParent {
+	if x != nil {
+		return x.ZombieParents
+	}
+	return nil
+}
+
+func (x *StatsResponse_ProcState) GetBlocked() []*StatsResponse_ProcState_BlockedTask {
+	if x != nil {
+		return x.Blocked
+	}
+	return nil
+}
+
+// Represents the sockets usage and network memory statistics
+type StatsResponse_SockStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of the sockets of all the protocols in use
+	SocketsUsed uint64 `protobuf:"varint,1,opt,name=socketsUsed,proto3" json:"socketsUsed,omitempty"`
+	// TCP sockets usage
+	Tcp *StatsResponse_SockStat_TCP `protobuf:"bytes,2,opt,name=tcp,proto3" json:"tcp,omitempty"`
+	// UDP sockets usage
+	Udp *StatsResponse_SockStat_UDP `protobuf:"bytes,3,opt,name=udp,proto3" json:"udp,omitempty"`
+	// Number of the IPv4 and IPv6 raw sockets in use
+	RawInUse uint64 `protobuf:"varint,4,opt,name=rawInUse,proto3" json:"rawInUse,omitempty"`
+	// UDP sockets with the most datagrams dropped
+	UdpDrops []*StatsResponse_SockStat_UDPDrops `protobuf:"bytes,5,rep,name=udpDrops,proto3" json:"udpDrops,omitempty"`
+}
+
+func (x *StatsResponse_SockStat) Reset() {
+	*x = StatsResponse_SockStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_SockStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_SockStat) ProtoMessage() {}
+
+func (x *StatsResponse_SockStat) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_SockStat.ProtoReflect.Descriptor instead.
+func (*StatsResponse_SockStat) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 18}
+}
+
+func (x *StatsResponse_SockStat) GetSocketsUsed() uint64 {
+	if x != nil {
+		return x.SocketsUsed
+	}
+	return 0
+}
+
+func (x *StatsResponse_SockStat) GetTcp() *StatsResponse_SockStat_TCP {
+	if x != nil {
+		return x.Tcp
+	}
+	return nil
+}
+
+func (x *StatsResponse_SockStat) GetUdp() *StatsResponse_SockStat_UDP {
+	if x != nil {
+		return x.Udp
+	}
+	return nil
+}
+
+func (x *StatsResponse_SockStat) GetRawInUse() uint64 {
+	if x != nil {
+		return x.RawInUse
+	}
+	return 0
+}
+
+func (x *StatsResponse_SockStat) GetUdpDrops() []*StatsResponse_SockStat_UDPDrops {
+	if x != nil {
+		return x.UdpDrops
+	}
+	return nil
+}
+
+// Represents the traffic of the network interfaces
+type StatsResponse_NetDev struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Traffic of every network interface
+	Interfaces []*StatsResponse_NetDev_Interface `protobuf:"bytes,1,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+}
+
+func (x *StatsResponse_NetDev) Reset() {
+	*x = StatsResponse_NetDev{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_NetDev) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_NetDev) ProtoMessage() {}
+
+func (x *StatsResponse_NetDev) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_NetDev.ProtoReflect.Descriptor instead.
+func (*StatsResponse_NetDev) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 19}
+}
+
+func (x *StatsResponse_NetDev) GetInterfaces() []*StatsResponse_NetDev_Interface {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+// Represents the paging and swapping activity of the virtual memory
+type StatsResponse_VMStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of page faults per second
+	PageFaults float64 `protobuf:"fixed64,1,opt,name=pageFaults,proto3" json:"pageFaults,omitempty"`
+	// Number of major page faults requiring the disk IO per second
+	MajorFaults float64 `protobuf:"fixed64,2,opt,name=majorFaults,proto3" json:"majorFaults,omitempty"`
+	// Number of pages swapped in per second
+	SwapIns float64 `protobuf:"fixed64,3,opt,name=swapIns,proto3" json:"swapIns,omitempty"`
+	// Number of pages swapped out per second
+	SwapOuts float64 `protobuf:"fixed64,4,opt,name=swapOuts,proto3" json:"swapOuts,omitempty"`
+	// Number of kilobytes paged in from the disk per second
+	PagedInKb float64 `protobuf:"fixed64,5,opt,name=pagedInKb,proto3" json:"pagedInKb,omitempty"`
+	// Number of kilobytes paged out to the disk per second
+	PagedOutKb float64 `protobuf:"fixed64,6,opt,name=pagedOutKb,proto3" json:"pagedOutKb,omitempty"`
+}
+
+func (x *StatsResponse_VMStat) Reset() {
+	*x = StatsResponse_VMStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_VMStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_VMStat) ProtoMessage() {}
+
+func (x *StatsResponse_VMStat) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_VMStat.ProtoReflect.Descriptor instead.
+func (*StatsResponse_VMStat) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 20}
+}
+
+func (x *StatsResponse_VMStat) GetPageFaults() float64 {
+	if x != nil {
+		return x.PageFaults
+	}
+	return 0
+}
+
+func (x *StatsResponse_VMStat) GetMajorFaults() float64 {
+	if x != nil {
+		return x.MajorFaults
+	}
+	return 0
+}
+
+func (x *StatsResponse_VMStat) GetSwapIns() float64 {
+	if x != nil {
+		return x.SwapIns
+	}
+	return 0
+}
+
+func (x *StatsResponse_VMStat) GetSwapOuts() float64 {
+	if x != nil {
+		return x.SwapOuts
+	}
+	return 0
+}
+
+func (x *StatsResponse_VMStat) GetPagedInKb() float64 {
+	if x != nil {
+		return x.PagedInKb
+	}
+	return 0
+}
+
+func (x *StatsResponse_VMStat) GetPagedOutKb() float64 {
+	if x != nil {
+		return x.PagedOutKb
+	}
+	return 0
+}
+
+// Represents the metrics of the custom collector parsed from the output of its command
+type StatsResponse_Custom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the custom collector
+	Collector string `protobuf:"bytes,1,opt,name=collector,proto3" json:"collector,omitempty"`
+	// Metrics parsed from the output of the command
+	Metrics []*StatsResponse_Custom_Metric `protobuf:"bytes,2,rep,name=metrics,proto3" json:"metrics,omitempty"`
+}
+
+func (x *StatsResponse_Custom) Reset() {
+	*x = StatsResponse_Custom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_Custom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_Custom) ProtoMessage() {}
+
+func (x *StatsResponse_Custom) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_Custom.ProtoReflect.Descriptor instead.
+func (*StatsResponse_Custom) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 21}
+}
+
+func (x *StatsResponse_Custom) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *StatsResponse_Custom) GetMetrics() []*StatsResponse_Custom_Metric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+// Represents the memory of a NUMA node
+type StatsResponse_Memory_NUMANode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of the node
+	Node int32 `protobuf:"varint,1,opt,name=node,proto3" json:"node,omitempty"`
+	// Total memory of the node in Mb
+	TotalMb uint64 `protobuf:"varint,2,opt,name=totalMb,proto3" json:"totalMb,omitempty"`
+	// Free memory of the node in Mb
+	FreeMb uint64 `protobuf:"varint,3,opt,name=freeMb,proto3" json:"freeMb,omitempty"`
+	// Used memory of the node in Mb
+	UsedMb uint64 `protobuf:"varint,4,opt,name=usedMb,proto3" json:"usedMb,omitempty"`
+	// Page cache of the node in Mb
+	FilePagesMb uint64 `protobuf:"varint,5,opt,name=filePagesMb,proto3" json:"filePagesMb,omitempty"`
+	// Anonymous memory of the processes of the node in Mb
+	AnonPagesMb uint64 `protobuf:"varint,6,opt,name=anonPagesMb,proto3" json:"anonPagesMb,omitempty"`
+	// Slab memory of the node in Mb
+	SlabMb uint64 `protobuf:"varint,7,opt,name=slabMb,proto3" json:"slabMb,omitempty"`
+	// Number of the default size hugepages of the node
+	HugePages uint64 `protobuf:"varint,8,opt,name=hugePages,proto3" json:"hugePages,omitempty"`
+	// Number of the free default size hugepages of the node
+	HugePagesFree uint64 `protobuf:"varint,9,opt,name=hugePagesFree,proto3" json:"hugePagesFree,omitempty"`
+}
+
+func (x *StatsResponse_Memory_NUMANode) Reset() {
+	*x = StatsResponse_Memory_NUMANode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_Memory_NUMANode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_Memory_NUMANode) ProtoMessage() {}
+
+func (x *StatsResponse_Memory_NUMANode) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_Memory_NUMANode.ProtoReflect.Descriptor instead.
+func (*StatsResponse_Memory_NUMANode) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 5, 0}
+}
+
+func (x *StatsResponse_Memory_NUMANode) GetNode() int32 {
+	if x != nil {
+		return x.Node
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory_NUMANode) GetTotalMb() uint64 {
+	if x != nil {
+		return x.TotalMb
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory_NUMANode) GetFreeMb() uint64 {
+	if x != nil {
+		return x.FreeMb
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory_NUMANode) GetUsedMb() uint64 {
+	if x != nil {
+		return x.UsedMb
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory_NUMANode) GetFilePagesMb() uint64 {
+	if x != nil {
+		return x.FilePagesMb
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory_NUMANode) GetAnonPagesMb() uint64 {
+	if x != nil {
+		return x.AnonPagesMb
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory_NUMANode) GetSlabMb() uint64 {
+	if x != nil {
+		return x.SlabMb
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory_NUMANode) GetHugePages() uint64 {
+	if x != nil {
+		return x.HugePages
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory_NUMANode) GetHugePagesFree() uint64 {
+	if x != nil {
+		return x.HugePagesFree
+	}
+	return 0
+}
+
+// Represents the hugepage pool of a page size
+type StatsResponse_Memory_HugePagePool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Size of the hugepage in Kb
+	SizeKb uint64 `protobuf:"varint,1,opt,name=sizeKb,proto3" json:"sizeKb,omitempty"`
+	// Number of the hugepages in the pool
+	Total uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Number of the hugepages not allocated yet
+	Free uint64 `protobuf:"varint,3,opt,name=free,proto3" json:"free,omitempty"`
+	// Number of the hugepages committed to be allocated but not allocated yet
+	Reserved uint64 `protobuf:"varint,4,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	// Number of the hugepages allocated above the pool size by overcommit
+	Surplus uint64 `protobuf:"varint,5,opt,name=surplus,proto3" json:"surplus,omitempty"`
+}
+
+func (x *StatsResponse_Memory_HugePagePool) Reset() {
+	*x = StatsResponse_Memory_HugePagePool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_Memory_HugePagePool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_Memory_HugePagePool) ProtoMessage() {}
+
+func (x *StatsResponse_Memory_HugePagePool) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_Memory_HugePagePool.ProtoReflect.Descriptor instead.
+func (*StatsResponse_Memory_HugePagePool) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 5, 1}
+}
+
+func (x *StatsResponse_Memory_HugePagePool) GetSizeKb() uint64 {
+	if x != nil {
+		return x.SizeKb
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory_HugePagePool) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory_HugePagePool) GetFree() uint64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory_HugePagePool) GetReserved() uint64 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory_HugePagePool) GetSurplus() uint64 {
+	if x != nil {
+		return x.Surplus
+	}
+	return 0
+}
+
+// Represents the kernel slab cache
+type StatsResponse_Memory_SlabCache struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the cache like dentry
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of the objects in use
+	ActiveObjects uint64 `protobuf:"varint,2,opt,name=activeObjects,proto3" json:"activeObjects,omitempty"`
+	// Number of the objects allocated
+	Objects uint64 `protobuf:"varint,3,opt,name=objects,proto3" json:"objects,omitempty"`
+	// Memory of the cache slabs in Kb
+	SizeKb uint64 `protobuf:"varint,4,opt,name=sizeKb,proto3" json:"sizeKb,omitempty"`
+}
+
+func (x *StatsResponse_Memory_SlabCache) Reset() {
+	*x = StatsResponse_Memory_SlabCache{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_Memory_SlabCache) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_Memory_SlabCache) ProtoMessage() {}
+
+func (x *StatsResponse_Memory_SlabCache) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_Memory_SlabCache.ProtoReflect.Descriptor instead.
+func (*StatsResponse_Memory_SlabCache) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 5, 2}
+}
+
+func (x *StatsResponse_Memory_SlabCache) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatsResponse_Memory_SlabCache) GetActiveObjects() uint64 {
+	if x != nil {
+		return x.ActiveObjects
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory_SlabCache) GetObjects() uint64 {
+	if x != nil {
+		return x.Objects
+	}
+	return 0
+}
+
+func (x *StatsResponse_Memory_SlabCache) GetSizeKb() uint64 {
+	if x != nil {
+		return x.SizeKb
+	}
+	return 0
+}
+
+type StatsResponse_NetProto_IP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Datagrams received
+	InReceives float64 `protobuf:"fixed64,1,opt,name=inReceives,proto3" json:"inReceives,omitempty"`
+	// Datagrams sent
+	OutRequests float64 `protobuf:"fixed64,2,opt,name=outRequests,proto3" json:"outRequests,omitempty"`
+	// Received datagrams discarded, e.g. for lack of buffer space
+	InDiscards float64 `protobuf:"fixed64,3,opt,name=inDiscards,proto3" json:"inDiscards,omitempty"`
+	// Datagrams to send discarded
+	OutDiscards float64 `protobuf:"fixed64,4,opt,name=outDiscards,proto3" json:"outDiscards,omitempty"`
+	// Received datagrams discarded due to errors in their headers
+	InHdrErrors float64 `protobuf:"fixed64,5,opt,name=inHdrErrors,proto3" json:"inHdrErrors,omitempty"`
+}
+
+func (x *StatsResponse_NetProto_IP) Reset() {
+	*x = StatsResponse_NetProto_IP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_NetProto_IP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_NetProto_IP) ProtoMessage() {}
+
+func (x *StatsResponse_NetProto_IP) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_NetProto_IP.ProtoReflect.Descriptor instead.
+func (*StatsResponse_NetProto_IP) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 9, 0}
+}
+
+func (x *StatsResponse_NetProto_IP) GetInReceives() float64 {
+	if x != nil {
+		return x.InReceives
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_IP) GetOutRequests() float64 {
+	if x != nil {
+		return x.OutRequests
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_IP) GetInDiscards() float64 {
+	if x != nil {
+		return x.InDiscards
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_IP) GetOutDiscards() float64 {
+	if x != nil {
+		return x.OutDiscards
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_IP) GetInHdrErrors() float64 {
+	if x != nil {
+		return x.InHdrErrors
+	}
+	return 0
+}
+
+type StatsResponse_NetProto_TCP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of connections in the ESTABLISHED or CLOSE-WAIT state
+	CurrEstab uint64 `protobuf:"varint,1,opt,name=currEstab,proto3" json:"currEstab,omitempty"`
+	// Connections opened by the host
+	ActiveOpens float64 `protobuf:"fixed64,2,opt,name=activeOpens,proto3" json:"activeOpens,omitempty"`
+	// Connections accepted by the host
+	PassiveOpens float64 `protobuf:"fixed64,3,opt,name=passiveOpens,proto3" json:"passiveOpens,omitempty"`
+	// Failed connection attempts
+	AttemptFails float64 `protobuf:"fixed64,4,opt,name=attemptFails,proto3" json:"attemptFails,omitempty"`
+	// Segments sent
+	OutSegs float64 `protobuf:"fixed64,5,opt,name=outSegs,proto3" json:"outSegs,omitempty"`
+	// Segments retransmitted
+	RetransSegs float64 `protobuf:"fixed64,6,opt,name=retransSegs,proto3" json:"retransSegs,omitempty"`
+	// Percentage of the retransmitted segments among the sent ones
+	RetransPercent float64 `protobuf:"fixed64,7,opt,name=retransPercent,proto3" json:"retransPercent,omitempty"`
+	// Segments with the RST flag sent
+	ResetsSent float64 `protobuf:"fixed64,8,opt,name=resetsSent,proto3" json:"resetsSent,omitempty"`
+	// Established connections reset from either side
+	EstabResets float64 `protobuf:"fixed64,9,opt,name=estabResets,proto3" json:"estabResets,omitempty"`
+	// Segments received in error
+	InErrs float64 `protobuf:"fixed64,10,opt,name=inErrs,proto3" json:"inErrs,omitempty"`
+	// Times the accept queue of a listening socket overflowed
+	ListenOverflows float64 `protobuf:"fixed64,11,opt,name=listenOverflows,proto3" json:"listenOverflows,omitempty"`
+	// SYNs to listening sockets dropped
+	ListenDrops float64 `protobuf:"fixed64,12,opt,name=listenDrops,proto3" json:"listenDrops,omitempty"`
+}
+
+func (x *StatsResponse_NetProto_TCP) Reset() {
+	*x = StatsResponse_NetProto_TCP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_NetProto_TCP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_NetProto_TCP) ProtoMessage() {}
+
+func (x *StatsResponse_NetProto_TCP) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_NetProto_TCP.ProtoReflect.Descriptor instead.
+func (*StatsResponse_NetProto_TCP) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 9, 1}
+}
+
+func (x *StatsResponse_NetProto_TCP) GetCurrEstab() uint64 {
+	if x != nil {
+		return x.CurrEstab
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_TCP) GetActiveOpens() float64 {
+	if x != nil {
+		return x.ActiveOpens
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_TCP) GetPassiveOpens() float64 {
+	if x != nil {
+		return x.PassiveOpens
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_TCP) GetAttemptFails() float64 {
+	if x != nil {
+		return x.AttemptFails
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_TCP) GetOutSegs() float64 {
+	if x != nil {
+		return x.OutSegs
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_TCP) GetRetransSegs() float64 {
+	if x != nil {
+		return x.RetransSegs
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_TCP) GetRetransPercent() float64 {
+	if x != nil {
+		return x.RetransPercent
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_TCP) GetResetsSent() float64 {
+	if x != nil {
+		return x.ResetsSent
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_TCP) GetEstabResets() float64 {
+	if x != nil {
+		return x.EstabResets
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_TCP) GetInErrs() float64 {
+	if x != nil {
+		return x.InErrs
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_TCP) GetListenOverflows() float64 {
+	if x != nil {
+		return x.ListenOverflows
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_TCP) GetListenDrops() float64 {
+	if x != nil {
+		return x.ListenDrops
+	}
+	return 0
+}
+
+type StatsResponse_NetProto_UDP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Datagrams delivered to the sockets
+	InDatagrams float64 `protobuf:"fixed64,1,opt,name=inDatagrams,proto3" json:"inDatagrams,omitempty"`
+	// Datagrams sent
+	OutDatagrams float64 `protobuf:"fixed64,2,opt,name=outDatagrams,proto3" json:"outDatagrams,omitempty"`
+	// Datagrams received for the ports with no listener
+	NoPorts float64 `protobuf:"fixed64,3,opt,name=noPorts,proto3" json:"noPorts,omitempty"`
+	// Datagrams that could not be delivered
+	InErrors float64 `protobuf:"fixed64,4,opt,name=inErrors,proto3" json:"inErrors,omitempty"`
+	// Datagrams dropped due to the full receive buffer
+	RcvbufErrors float64 `protobuf:"fixed64,5,opt,name=rcvbufErrors,proto3" json:"rcvbufErrors,omitempty"`
+	// Datagrams dropped due to the full send buffer
+	SndbufErrors float64 `protobuf:"fixed64,6,opt,name=sndbufErrors,proto3" json:"sndbufErrors,omitempty"`
+}
+
+func (x *StatsResponse_NetProto_UDP) Reset() {
+	*x = StatsResponse_NetProto_UDP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_NetProto_UDP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_NetProto_UDP) ProtoMessage() {}
+
+func (x *StatsResponse_NetProto_UDP) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_NetProto_UDP.ProtoReflect.Descriptor instead.
+func (*StatsResponse_NetProto_UDP) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 9, 2}
+}
+
+func (x *StatsResponse_NetProto_UDP) GetInDatagrams() float64 {
+	if x != nil {
+		return x.InDatagrams
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_UDP) GetOutDatagrams() float64 {
+	if x != nil {
+		return x.OutDatagrams
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_UDP) GetNoPorts() float64 {
+	if x != nil {
+		return x.NoPorts
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_UDP) GetInErrors() float64 {
+	if x != nil {
+		return x.InErrors
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_UDP) GetRcvbufErrors() float64 {
+	if x != nil {
+		return x.RcvbufErrors
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_UDP) GetSndbufErrors() float64 {
+	if x != nil {
+		return x.SndbufErrors
+	}
+	return 0
+}
+
+type StatsResponse_NetProto_ICMP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Messages received
+	InMsgs float64 `protobuf:"fixed64,1,opt,name=inMsgs,proto3" json:"inMsgs,omitempty"`
+	// Messages sent
+	OutMsgs float64 `protobuf:"fixed64,2,opt,name=outMsgs,proto3" json:"outMsgs,omitempty"`
+	// Received messages with errors
+	InErrors float64 `protobuf:"fixed64,3,opt,name=inErrors,proto3" json:"inErrors,omitempty"`
+	// Messages not sent due to errors
+	OutErrors float64 `protobuf:"fixed64,4,opt,name=outErrors,proto3" json:"outErrors,omitempty"`
+	// "Destination unreachable" messages received
+	InDestUnreachs float64 `protobuf:"fixed64,5,opt,name=inDestUnreachs,proto3" json:"inDestUnreachs,omitempty"`
+}
+
+func (x *StatsResponse_NetProto_ICMP) Reset() {
+	*x = StatsResponse_NetProto_ICMP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_NetProto_ICMP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_NetProto_ICMP) ProtoMessage() {}
+
+func (x *StatsResponse_NetProto_ICMP) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_NetProto_ICMP.ProtoReflect.Descriptor instead.
+func (*StatsResponse_NetProto_ICMP) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 9, 3}
+}
+
+func (x *StatsResponse_NetProto_ICMP) GetInMsgs() float64 {
+	if x != nil {
+		return x.InMsgs
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_ICMP) GetOutMsgs() float64 {
+	if x != nil {
+		return x.OutMsgs
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_ICMP) GetInErrors() float64 {
+	if x != nil {
+		return x.InErrors
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_ICMP) GetOutErrors() float64 {
+	if x != nil {
+		return x.OutErrors
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetProto_ICMP) GetInDestUnreachs() float64 {
+	if x != nil {
+		return x.InDestUnreachs
+	}
+	return 0
+}
+
+// Represents the frequency and throttling of the single CPU
+type StatsResponse_Thermal_CPUFreq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Index of the CPU
+	Cpu int32 `protobuf:"varint,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	// Current frequency in MHz
+	CurMhz float64 `protobuf:"fixed64,2,opt,name=curMhz,proto3" json:"curMhz,omitempty"`
+	// Minimum frequency supported by the hardware in MHz
+	MinMhz float64 `protobuf:"fixed64,3,opt,name=minMhz,proto3" json:"minMhz,omitempty"`
+	// Maximum frequency supported by the hardware in MHz
+	MaxMhz float64 `protobuf:"fixed64,4,opt,name=maxMhz,proto3" json:"maxMhz,omitempty"`
+	// Number of times the core was throttled due to the high temperature
+	CoreThrottles uint64 `protobuf:"varint,5,opt,name=coreThrottles,proto3" json:"coreThrottles,omitempty"`
+	// Number of times the package of the core was throttled due to the high temperature
+	PackageThrottles uint64 `protobuf:"varint,6,opt,name=packageThrottles,proto3" json:"packageThrottles,omitempty"`
+}
+
+func (x *StatsResponse_Thermal_CPUFreq) Reset() {
+	*x = StatsResponse_Thermal_CPUFreq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_Thermal_CPUFreq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_Thermal_CPUFreq) ProtoMessage() {}
+
+func (x *StatsResponse_Thermal_CPUFreq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_Thermal_CPUFreq.ProtoReflect.Descriptor instead.
+func (*StatsResponse_Thermal_CPUFreq) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 12, 0}
+}
+
+func (x *StatsResponse_Thermal_CPUFreq) GetCpu() int32 {
+	if x != nil {
+		return x.Cpu
+	}
+	return 0
+}
+
+func (x *StatsResponse_Thermal_CPUFreq) GetCurMhz() float64 {
+	if x != nil {
+		return x.CurMhz
+	}
+	return 0
+}
+
+func (x *StatsResponse_Thermal_CPUFreq) GetMinMhz() float64 {
+	if x != nil {
+		return x.MinMhz
+	}
+	return 0
+}
+
+func (x *StatsResponse_Thermal_CPUFreq) GetMaxMhz() float64 {
+	if x != nil {
+		return x.MaxMhz
+	}
+	return 0
+}
+
+func (x *StatsResponse_Thermal_CPUFreq) GetCoreThrottles() uint64 {
+	if x != nil {
+		return x.CoreThrottles
+	}
+	return 0
+}
+
+func (x *StatsResponse_Thermal_CPUFreq) GetPackageThrottles() uint64 {
+	if x != nil {
+		return x.PackageThrottles
+	}
+	return 0
+}
+
+// Represents the temperature sensor
+type StatsResponse_Thermal_Sensor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Where the sensor is read from: hwmon or thermal
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// Name of the hwmon chip or the type of the thermal zone
+	Chip string `protobuf:"bytes,2,opt,name=chip,proto3" json:"chip,omitempty"`
+	// Label of the sensor
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	// Temperature in degrees Celsius
+	TempC float64 `protobuf:"fixed64,4,opt,name=tempC,proto3" json:"tempC,omitempty"`
+	// Critical temperature in degrees Celsius, 0 if unknown
+	CritC float64 `protobuf:"fixed64,5,opt,name=critC,proto3" json:"critC,omitempty"`
+}
+
+func (x *StatsResponse_Thermal_Sensor) Reset() {
+	*x = StatsResponse_Thermal_Sensor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_Thermal_Sensor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_Thermal_Sensor) ProtoMessage() {}
+
+func (x *StatsResponse_Thermal_Sensor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_Thermal_Sensor.ProtoReflect.Descriptor instead.
+func (*StatsResponse_Thermal_Sensor) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 12, 1}
+}
+
+func (x *StatsResponse_Thermal_Sensor) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *StatsResponse_Thermal_Sensor) GetChip() string {
+	if x != nil {
+		return x.Chip
+	}
+	return ""
+}
+
+func (x *StatsResponse_Thermal_Sensor) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *StatsResponse_Thermal_Sensor) GetTempC() float64 {
+	if x != nil {
+		return x.TempC
+	}
+	return 0
+}
+
+func (x *StatsResponse_Thermal_Sensor) GetCritC() float64 {
+	if x != nil {
+		return x.CritC
+	}
+	return 0
+}
+
+// Represents the status of the md array
+type StatsResponse_MDStat_Array struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the array like md0
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// State of the array like active, inactive or active (auto-read-only)
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// RAID level of the array like raid1
+	Level string `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	// Number of the devices the array consists of
+	Devices int32 `protobuf:"varint,4,opt,name=devices,proto3" json:"devices,omitempty"`
+	// Number of the devices in sync
+	ActiveDevices int32 `protobuf:"varint,5,opt,name=activeDevices,proto3" json:"activeDevices,omitempty"`
+	// Whether some of the devices are missing or failed
+	Degraded bool `protobuf:"varint,6,opt,name=degraded,proto3" json:"degraded,omitempty"`
+	// Member devices of the array
+	Members []*StatsResponse_MDStat_Member `protobuf:"bytes,7,rep,name=members,proto3" json:"members,omitempty"`
+	// Resync, recovery, reshape or check in progress
+	Sync *StatsResponse_MDStat_Sync `protobuf:"bytes,8,opt,name=sync,proto3" json:"sync,omitempty"`
+}
+
+func (x *StatsResponse_MDStat_Array) Reset() {
+	*x = StatsResponse_MDStat_Array{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_MDStat_Array) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_MDStat_Array) ProtoMessage() {}
+
+func (x *StatsResponse_MDStat_Array) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_MDStat_Array.ProtoReflect.Descriptor instead.
+func (*StatsResponse_MDStat_Array) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 13, 0}
+}
+
+func (x *StatsResponse_MDStat_Array) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatsResponse_MDStat_Array) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *StatsResponse_MDStat_Array) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *StatsResponse_MDStat_Array) GetDevices() int32 {
+	if x != nil {
+		return x.Devices
+	}
+	return 0
+}
+
+func (x *StatsResponse_MDStat_Array) GetActiveDevices() int32 {
+	if x != nil {
+		return x.ActiveDevices
+	}
+	return 0
+}
+
+func (x *StatsResponse_MDStat_Array) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+func (x *StatsResponse_MDStat_Array) GetMembers() []*StatsResponse_MDStat_Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *StatsResponse_MDStat_Array) GetSync() *StatsResponse_MDStat_Sync {
+	if x != nil {
+		return x.Sync
+	}
+	return nil
+}
+
+// Represents the member device of the md array
+type StatsResponse_MDStat_Member struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Kernel name of the device like sda1
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Role number of the device in the array
+	Slot int32 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	// State of the device: active, faulty, spare, write-mostly or replacement
+	State string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *StatsResponse_MDStat_Member) Reset() {
+	*x = StatsResponse_MDStat_Member{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_MDStat_Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_MDStat_Member) ProtoMessage() {}
+
+func (x *StatsResponse_MDStat_Member) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_MDStat_Member.ProtoReflect.Descriptor instead.
+func (*StatsResponse_MDStat_Member) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 13, 1}
+}
+
+func (x *StatsResponse_MDStat_Member) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatsResponse_MDStat_Member) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *StatsResponse_MDStat_Member) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// Represents the sync action of the md array
+type StatsResponse_MDStat_Sync struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sync action like resync, recovery, reshape or check, empty if the array is idle
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// Whether the action is delayed or pending
+	Pending bool `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	// Progress of the action in percentage
+	Percent float64 `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"`
+	// Estimated time to finish the action in minutes
+	FinishMin float64 `protobuf:"fixed64,4,opt,name=finishMin,proto3" json:"finishMin,omitempty"`
+	// Speed of the action in Kb per second
+	SpeedKbs uint64 `protobuf:"varint,5,opt,name=speedKbs,proto3" json:"speedKbs,omitempty"`
+}
+
+func (x *StatsResponse_MDStat_Sync) Reset() {
+	*x = StatsResponse_MDStat_Sync{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_MDStat_Sync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_MDStat_Sync) ProtoMessage() {}
+
+func (x *StatsResponse_MDStat_Sync) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_MDStat_Sync.ProtoReflect.Descriptor instead.
+func (*StatsResponse_MDStat_Sync) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 13, 2}
+}
+
+func (x *StatsResponse_MDStat_Sync) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *StatsResponse_MDStat_Sync) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+func (x *StatsResponse_MDStat_Sync) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *StatsResponse_MDStat_Sync) GetFinishMin() float64 {
+	if x != nil {
+		return x.FinishMin
+	}
+	return 0
+}
+
+func (x *StatsResponse_MDStat_Sync) GetSpeedKbs() uint64 {
+	if x != nil {
+		return x.SpeedKbs
+	}
+	return 0
+}
+
+// Represents the login session of the user
+type StatsResponse_Sessions_Session struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the logged-in user
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Terminal of the session like pts/0
+	Tty string `protobuf:"bytes,2,opt,name=tty,proto3" json:"tty,omitempty"`
+	// Remote host the user logged in from, empty for the local sessions
+	Host string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	// PID of the login process
+	Pid int32 `protobuf:"varint,4,opt,name=pid,proto3" json:"pid,omitempty"`
+	// Time the user logged in at as Unix time in seconds
+	LoginTime int64 `protobuf:"varint,5,opt,name=loginTime,proto3" json:"loginTime,omitempty"`
+}
+
+func (x *StatsResponse_Sessions_Session) Reset() {
+	*x = StatsResponse_Sessions_Session{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_Sessions_Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_Sessions_Session) ProtoMessage() {}
+
+func (x *StatsResponse_Sessions_Session) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_Sessions_Session.ProtoReflect.Descriptor instead.
+func (*StatsResponse_Sessions_Session) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 16, 1}
+}
+
+func (x *StatsResponse_Sessions_Session) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *StatsResponse_Sessions_Session) GetTty() string {
+	if x != nil {
+		return x.Tty
+	}
+	return ""
+}
+
+func (x *StatsResponse_Sessions_Session) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *StatsResponse_Sessions_Session) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *StatsResponse_Sessions_Session) GetLoginTime() int64 {
+	if x != nil {
+		return x.LoginTime
+	}
+	return 0
+}
+
+// Represents the number of the tasks by their states
+type StatsResponse_ProcState_StateCounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of the tasks running or runnable (R)
+	Running int32 `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
+	// Number of the tasks in the interruptible sleep (S)
+	Sleeping int32 `protobuf:"varint,2,opt,name=sleeping,proto3" json:"sleeping,omitempty"`
+	// Number of the tasks in the uninterruptible sleep, usually waiting for I/O (D)
+	DiskSleep int32 `protobuf:"varint,3,opt,name=diskSleep,proto3" json:"diskSleep,omitempty"`
+	// Number of the terminated tasks not reaped by their parents (Z)
+	Zombie int32 `protobuf:"varint,4,opt,name=zombie,proto3" json:"zombie,omitempty"`
+	// Number of the tasks stopped by a signal or a debugger (T, t)
+	Stopped int32 `protobuf:"varint,5,opt,name=stopped,proto3" json:"stopped,omitempty"`
+	// Number of the idle kernel threads (I)
+	Idle int32 `protobuf:"varint,6,opt,name=idle,proto3" json:"idle,omitempty"`
+}
+
+func (x *StatsResponse_ProcState_StateCounts) Reset() {
+	*x = StatsResponse_ProcState_StateCounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_ProcState_StateCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_ProcState_StateCounts) ProtoMessage() {}
+
+func (x *StatsResponse_ProcState_StateCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_ProcState_StateCounts.ProtoReflect.Descriptor instead.
+func (*StatsResponse_ProcState_StateCounts) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 17, 0}
+}
+
+func (x *StatsResponse_ProcState_StateCounts) GetRunning() int32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *StatsResponse_ProcState_StateCounts) GetSleeping() int32 {
+	if x != nil {
+		return x.Sleeping
+	}
+	return 0
+}
+
+func (x *StatsResponse_ProcState_StateCounts) GetDiskSleep() int32 {
+	if x != nil {
+		return x.DiskSleep
+	}
+	return 0
+}
+
+func (x *StatsResponse_ProcState_StateCounts) GetZombie() int32 {
+	if x != nil {
+		return x.Zombie
+	}
+	return 0
+}
+
+func (x *StatsResponse_ProcState_StateCounts) GetStopped() int32 {
+	if x != nil {
+		return x.Stopped
+	}
+	return 0
+}
+
+func (x *StatsResponse_ProcState_StateCounts) GetIdle() int32 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+// Represents the process with the zombie children
+type StatsResponse_ProcState_ZombieParent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PID of the parent process
+	Pid int32 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	// Name of the parent process
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of the zombie children
+	Zombies int32 `protobuf:"varint,3,opt,name=zombies,proto3" json:"zombies,omitempty"`
+}
+
+func (x *StatsResponse_ProcState_ZombieParent) Reset() {
+	*x = StatsResponse_ProcState_ZombieParent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_ProcState_ZombieParent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_ProcState_ZombieParent) ProtoMessage() {}
+
+func (x *StatsResponse_ProcState_ZombieParent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_ProcState_ZombieParent.ProtoReflect.Descriptor instead.
+func (*StatsResponse_ProcState_ZombieParent) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 17, 1}
+}
+
+func (x *StatsResponse_ProcState_ZombieParent) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *StatsResponse_ProcState_ZombieParent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatsResponse_ProcState_ZombieParent) GetZombies() int32 {
+	if x != nil {
+		return x.Zombies
+	}
+	return 0
+}
+
+// Represents the thread staying in the uninterruptible sleep
+type StatsResponse_ProcState_BlockedTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the thread
+	Tid int32 `protobuf:"varint,1,opt,name=tid,proto3" json:"tid,omitempty"`
+	// Name of the thread
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Time the thread is seen in the uninterruptible sleep for in seconds
+	BlockedSec float64 `protobuf:"fixed64,3,opt,name=blockedSec,proto3" json:"blockedSec,omitempty"`
+}
+
+func (x *StatsResponse_ProcState_BlockedTask) Reset() {
+	*x = StatsResponse_ProcState_BlockedTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_ProcState_BlockedTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_ProcState_BlockedTask) ProtoMessage() {}
+
+func (x *StatsResponse_ProcState_BlockedTask) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_ProcState_BlockedTask.ProtoReflect.Descriptor instead.
+func (*StatsResponse_ProcState_BlockedTask) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 17, 2}
+}
+
+func (x *StatsResponse_ProcState_BlockedTask) GetTid() int32 {
+	if x != nil {
+		return x.Tid
+	}
+	return 0
+}
+
+func (x *StatsResponse_ProcState_BlockedTask) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatsResponse_ProcState_BlockedTask) GetBlockedSec() float64 {
+	if x != nil {
+		return x.BlockedSec
+	}
+	return 0
+}
+
+// Represents the TCP sockets usage
+type StatsResponse_SockStat_TCP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of the IPv4 TCP sockets in use
+	InUse uint64 `protobuf:"varint,1,opt,name=inUse,proto3" json:"inUse,omitempty"`
+	// Number of the IPv6 TCP sockets in use
+	InUse6 uint64 `protobuf:"varint,2,opt,name=inUse6,proto3" json:"inUse6,omitempty"`
+	// Number of the TCP sockets not attached to any file descriptor
+	Orphan uint64 `protobuf:"varint,3,opt,name=orphan,proto3" json:"orphan,omitempty"`
+	// Number of the TCP sockets in the TIME_WAIT state
+	TimeWait uint64 `protobuf:"varint,4,opt,name=timeWait,proto3" json:"timeWait,omitempty"`
+	// Number of the allocated TCP sockets
+	Alloc uint64 `protobuf:"varint,5,opt,name=alloc,proto3" json:"alloc,omitempty"`
+	// Memory used by the TCP sockets against tcp_mem
+	Memory *StatsResponse_SockStat_Memory `protobuf:"bytes,6,opt,name=memory,proto3" json:"memory,omitempty"`
+}
+
+func (x *StatsResponse_SockStat_TCP) Reset() {
+	*x = StatsResponse_SockStat_TCP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_SockStat_TCP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_SockStat_TCP) ProtoMessage() {}
+
+func (x *StatsResponse_SockStat_TCP) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_SockStat_TCP.ProtoReflect.Descriptor instead.
+func (*StatsResponse_SockStat_TCP) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 18, 0}
+}
+
+func (x *StatsResponse_SockStat_TCP) GetInUse() uint64 {
+	if x != nil {
+		return x.InUse
+	}
+	return 0
+}
+
+func (x *StatsResponse_SockStat_TCP) GetInUse6() uint64 {
+	if x != nil {
+		return x.InUse6
+	}
+	return 0
+}
+
+func (x *StatsResponse_SockStat_TCP) GetOrphan() uint64 {
+	if x != nil {
+		return x.Orphan
+	}
+	return 0
+}
+
+func (x *StatsResponse_SockStat_TCP) GetTimeWait() uint64 {
+	if x != nil {
+		return x.TimeWait
+	}
+	return 0
+}
+
+func (x *StatsResponse_SockStat_TCP) GetAlloc() uint64 {
+	if x != nil {
+		return x.Alloc
+	}
+	return 0
+}
+
+func (x *StatsResponse_SockStat_TCP) GetMemory() *StatsResponse_SockStat_Memory {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+// Represents the UDP sockets usage
+type StatsResponse_SockStat_UDP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of the IPv4 UDP sockets in use
+	InUse uint64 `protobuf:"varint,1,opt,name=inUse,proto3" json:"inUse,omitempty"`
+	// Number of the IPv6 UDP sockets in use
+	InUse6 uint64 `protobuf:"varint,2,opt,name=inUse6,proto3" json:"inUse6,omitempty"`
+	// Memory used by the UDP sockets against udp_mem
+	Memory *StatsResponse_SockStat_Memory `protobuf:"bytes,3,opt,name=memory,proto3" json:"memory,omitempty"`
+}
+
+func (x *StatsResponse_SockStat_UDP) Reset() {
+	*x = StatsResponse_SockStat_UDP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_SockStat_UDP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_SockStat_UDP) ProtoMessage() {}
+
+func (x *StatsResponse_SockStat_UDP) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_SockStat_UDP.ProtoReflect.Descriptor instead.
+func (*StatsResponse_SockStat_UDP) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 18, 1}
+}
+
+func (x *StatsResponse_SockStat_UDP) GetInUse() uint64 {
+	if x != nil {
+		return x.InUse
+	}
+	return 0
+}
+
+func (x *StatsResponse_SockStat_UDP) GetInUse6() uint64 {
+	if x != nil {
+		return x.InUse6
+	}
+	return 0
+}
+
+func (x *StatsResponse_SockStat_UDP) GetMemory() *StatsResponse_SockStat_Memory {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+// Represents the memory used by the protocol sockets against its limits
+type StatsResponse_SockStat_Memory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Memory used in pages
+	UsedPages uint64 `protobuf:"varint,1,opt,name=usedPages,proto3" json:"usedPages,omitempty"`
+	// Memory used in bytes
+	UsedBytes uint64 `protobuf:"varint,2,opt,name=usedBytes,proto3" json:"usedBytes,omitempty"`
+	// Threshold the kernel starts moderating the memory allocation above in pages
+	PressurePages uint64 `protobuf:"varint,3,opt,name=pressurePages,proto3" json:"pressurePages,omitempty"`
+	// Maximum memory in pages
+	MaxPages uint64 `protobuf:"varint,4,opt,name=maxPages,proto3" json:"maxPages,omitempty"`
+	// Used memory against the maximum in percentage
+	UsedPercent float64 `protobuf:"fixed64,5,opt,name=usedPercent,proto3" json:"usedPercent,omitempty"`
+	// Whether the memory used is above the pressure threshold
+	UnderPressure bool `protobuf:"varint,6,opt,name=underPressure,proto3" json:"underPressure,omitempty"`
+}
+
+func (x *StatsResponse_SockStat_Memory) Reset() {
+	*x = StatsResponse_SockStat_Memory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_SockStat_Memory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_SockStat_Memory) ProtoMessage() {}
+
+func (x *StatsResponse_SockStat_Memory) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_SockStat_Memory.ProtoReflect.Descriptor instead.
+func (*StatsResponse_SockStat_Memory) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 18, 2}
+}
+
+func (x *StatsResponse_SockStat_Memory) GetUsedPages() uint64 {
+	if x != nil {
+		return x.UsedPages
+	}
+	return 0
+}
+
+func (x *StatsResponse_SockStat_Memory) GetUsedBytes() uint64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *StatsResponse_SockStat_Memory) GetPressurePages() uint64 {
+	if x != nil {
+		return x.PressurePages
+	}
+	return 0
+}
+
+func (x *StatsResponse_SockStat_Memory) GetMaxPages() uint64 {
+	if x != nil {
+		return x.MaxPages
+	}
+	return 0
+}
+
+func (x *StatsResponse_SockStat_Memory) GetUsedPercent() float64 {
+	if x != nil {
+		return x.UsedPercent
+	}
+	return 0
+}
+
+func (x *StatsResponse_SockStat_Memory) GetUnderPressure() bool {
+	if x != nil {
+		return x.UnderPressure
+	}
+	return false
+}
+
+// Represents the UDP socket with the datagrams dropped
+type StatsResponse_SockStat_UDPDrops struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Local address of the socket like 127.0.0.1:53
+	LocalAddress string `protobuf:"bytes,1,opt,name=localAddress,proto3" json:"localAddress,omitempty"`
+	// Inode of the socket to find the process owning it
+	Inode uint64 `protobuf:"varint,2,opt,name=inode,proto3" json:"inode,omitempty"`
+	// Number of the datagrams dropped since the socket was created
+	Drops uint64 `protobuf:"varint,3,opt,name=drops,proto3" json:"drops,omitempty"`
+	// Size of the receive queue in bytes
+	RxQueueBytes uint64 `protobuf:"varint,4,opt,name=rxQueueBytes,proto3" json:"rxQueueBytes,omitempty"`
+}
+
+func (x *StatsResponse_SockStat_UDPDrops) Reset() {
+	*x = StatsResponse_SockStat_UDPDrops{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_SockStat_UDPDrops) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_SockStat_UDPDrops) ProtoMessage() {}
+
+func (x *StatsResponse_SockStat_UDPDrops) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_SockStat_UDPDrops.ProtoReflect.Descriptor instead.
+func (*StatsResponse_SockStat_UDPDrops) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 18, 3}
+}
+
+func (x *StatsResponse_SockStat_UDPDrops) GetLocalAddress() string {
+	if x != nil {
+		return x.LocalAddress
+	}
+	return ""
+}
+
+func (x *StatsResponse_SockStat_UDPDrops) GetInode() uint64 {
+	if x != nil {
+		return x.Inode
+	}
+	return 0
+}
+
+func (x *StatsResponse_SockStat_UDPDrops) GetDrops() uint64 {
+	if x != nil {
+		return x.Drops
+	}
+	return 0
+}
+
+func (x *StatsResponse_SockStat_UDPDrops) GetRxQueueBytes() uint64 {
+	if x != nil {
+		return x.RxQueueBytes
+	}
+	return 0
+}
+
+// Represents the traffic of the network interface
+type StatsResponse_NetDev_Interface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the interface like eth0
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of bytes received per second
+	RxBytes float64 `protobuf:"fixed64,2,opt,name=rxBytes,proto3" json:"rxBytes,omitempty"`
+	// Number of bytes transmitted per second
+	TxBytes float64 `protobuf:"fixed64,3,opt,name=txBytes,proto3" json:"txBytes,omitempty"`
+	// Number of packets received per second
+	RxPackets float64 `protobuf:"fixed64,4,opt,name=rxPackets,proto3" json:"rxPackets,omitempty"`
+	// Number of packets transmitted per second
+	TxPackets float64 `protobuf:"fixed64,5,opt,name=txPackets,proto3" json:"txPackets,omitempty"`
+	// Number of receive errors per second
+	RxErrors float64 `protobuf:"fixed64,6,opt,name=rxErrors,proto3" json:"rxErrors,omitempty"`
+	// Number of transmit errors per second
+	TxErrors float64 `protobuf:"fixed64,7,opt,name=txErrors,proto3" json:"txErrors,omitempty"`
+	// Number of received packets dropped per second
+	RxDropped float64 `protobuf:"fixed64,8,opt,name=rxDropped,proto3" json:"rxDropped,omitempty"`
+	// Number of packets dropped on transmit per second
+	TxDropped float64 `protobuf:"fixed64,9,opt,name=txDropped,proto3" json:"txDropped,omitempty"`
+}
+
+func (x *StatsResponse_NetDev_Interface) Reset() {
+	*x = StatsResponse_NetDev_Interface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_NetDev_Interface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_NetDev_Interface) ProtoMessage() {}
+
+func (x *StatsResponse_NetDev_Interface) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_NetDev_Interface.ProtoReflect.Descriptor instead.
+func (*StatsResponse_NetDev_Interface) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 19, 0}
+}
+
+func (x *StatsResponse_NetDev_Interface) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatsResponse_NetDev_Interface) GetRxBytes() float64 {
+	if x != nil {
+		return x.RxBytes
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetDev_Interface) GetTxBytes() float64 {
+	if x != nil {
+		return x.TxBytes
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetDev_Interface) GetRxPackets() float64 {
+	if x != nil {
+		return x.RxPackets
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetDev_Interface) GetTxPackets() float64 {
+	if x != nil {
+		return x.TxPackets
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetDev_Interface) GetRxErrors() float64 {
+	if x != nil {
+		return x.RxErrors
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetDev_Interface) GetTxErrors() float64 {
+	if x != nil {
+		return x.TxErrors
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetDev_Interface) GetRxDropped() float64 {
+	if x != nil {
+		return x.RxDropped
+	}
+	return 0
+}
+
+func (x *StatsResponse_NetDev_Interface) GetTxDropped() float64 {
+	if x != nil {
+		return x.TxDropped
+	}
+	return 0
+}
+
+// Represents the metric parsed from the output of the command
+type StatsResponse_Custom_Metric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the metric like queue_depth
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Unit of the value like seconds
+	Unit string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	// Type of the value: gauge, counter or rate
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// Value of the metric
+	Value float64 `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StatsResponse_Custom_Metric) Reset() {
+	*x = StatsResponse_Custom_Metric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse_Custom_Metric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse_Custom_Metric) ProtoMessage() {}
+
+func (x *StatsResponse_Custom_Metric) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse_Custom_Metric.ProtoReflect.Descriptor instead.
+func (*StatsResponse_Custom_Metric) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{1, 21, 0}
+}
+
+func (x *StatsResponse_Custom_Metric) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatsResponse_Custom_Metric) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *StatsResponse_Custom_Metric) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StatsResponse_Custom_Metric) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// Represents the usage of the single process against its soft limits
+type ProcessLimitsResponse_Process struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Process ID
+	Pid int32 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	// Process name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the process is watched by the configuration
+	Watched bool `protobuf:"varint,3,opt,name=watched,proto3" json:"watched,omitempty"`
+	// Open file descriptors against the open files limit
+	OpenFiles *StatsResponse_LimitUsage `protobuf:"bytes,4,opt,name=openFiles,proto3" json:"openFiles,omitempty"`
+	// Threads of the process user against the processes limit
+	Processes *StatsResponse_LimitUsage `protobuf:"bytes,5,opt,name=processes,proto3" json:"processes,omitempty"`
+	// Locked memory in Kb against the locked memory limit
+	LockedMemoryKb *StatsResponse_LimitUsage `protobuf:"bytes,6,opt,name=lockedMemoryKb,proto3" json:"lockedMemoryKb,omitempty"`
+	// Utilisation of the limit the process is closest to
+	MaxUsedPercent float64 `protobuf:"fixed64,7,opt,name=maxUsedPercent,proto3" json:"maxUsedPercent,omitempty"`
+}
+
+func (x *ProcessLimitsResponse_Process) Reset() {
+	*x = ProcessLimitsResponse_Process{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessLimitsResponse_Process) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessLimitsResponse_Process) ProtoMessage() {}
+
+func (x *ProcessLimitsResponse_Process) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessLimitsResponse_Process.ProtoReflect.Descriptor instead.
+func (*ProcessLimitsResponse_Process) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *ProcessLimitsResponse_Process) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ProcessLimitsResponse_Process) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProcessLimitsResponse_Process) GetWatched() bool {
+	if x != nil {
+		return x.Watched
+	}
+	return false
+}
+
+func (x *ProcessLimitsResponse_Process) GetOpenFiles() *StatsResponse_LimitUsage {
+	if x != nil {
+		return x.OpenFiles
+	}
+	return nil
+}
+
+func (x *ProcessLimitsResponse_Process) GetProcesses() *StatsResponse_LimitUsage {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
+}
+
+func (x *ProcessLimitsResponse_Process) GetLockedMemoryKb() *StatsResponse_LimitUsage {
+	if x != nil {
+		return x.LockedMemoryKb
+	}
+	return nil
+}
+
+func (x *ProcessLimitsResponse_Process) GetMaxUsedPercent() float64 {
+	if x != nil {
+		return x.MaxUsedPercent
+	}
+	return 0
+}
+
+// Represents the block device with its partitions and mount points
+type BlockDevicesResponse_BlockDevice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Kernel name of the device like sda or nvme0n1
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Model of the device
+	Model string `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	// Serial number of the device
+	Serial string `protobuf:"bytes,3,opt,name=serial,proto3" json:"serial,omitempty"`
+	// Size of the device in bytes
+	SizeBytes uint64 `protobuf:"varint,4,opt,name=sizeBytes,proto3" json:"sizeBytes,omitempty"`
+	// Whether the device is the rotational disk, otherwise it is SSD or virtual
+	Rotational bool `protobuf:"varint,5,opt,name=rotational,proto3" json:"rotational,omitempty"`
+	// Whether the device is removable
+	Removable bool `protobuf:"varint,6,opt,name=removable,proto3" json:"removable,omitempty"`
+	// Selected I/O scheduler of the device
+	Scheduler string `protobuf:"bytes,7,opt,name=scheduler,proto3" json:"scheduler,omitempty"`
+	// Device mapper name of the device like vg0-root
+	DmName string `protobuf:"bytes,8,opt,name=dmName,proto3" json:"dmName,omitempty"`
+	// Partitions of the device
+	Partitions []*BlockDevicesResponse_Partition `protobuf:"bytes,9,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	// Mount points of the whole device
+	MountPoints []*BlockDevicesResponse_MountPoint `protobuf:"bytes,10,rep,name=mountPoints,proto3" json:"mountPoints,omitempty"`
+}
+
+func (x *BlockDevicesResponse_BlockDevice) Reset() {
+	*x = BlockDevicesResponse_BlockDevice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockDevicesResponse_BlockDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockDevicesResponse_BlockDevice) ProtoMessage() {}
+
+func (x *BlockDevicesResponse_BlockDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockDevicesResponse_BlockDevice.ProtoReflect.Descriptor instead.
+func (*BlockDevicesResponse_BlockDevice) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *BlockDevicesResponse_BlockDevice) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BlockDevicesResponse_BlockDevice) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *BlockDevicesResponse_BlockDevice) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+func (x *BlockDevicesResponse_BlockDevice) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *BlockDevicesResponse_BlockDevice) GetRotational() bool {
+	if x != nil {
+		return x.Rotational
+	}
+	return false
+}
+
+func (x *BlockDevicesResponse_BlockDevice) GetRemovable() bool {
+	if x != nil {
+		return x.Removable
+	}
+	return false
+}
+
+func (x *BlockDevicesResponse_BlockDevice) GetScheduler() string {
+	if x != nil {
+		return x.Scheduler
+	}
+	return ""
+}
+
+func (x *BlockDevicesResponse_BlockDevice) GetDmName() string {
+	if x != nil {
+		return x.DmName
+	}
+	return ""
+}
+
+func (x *BlockDevicesResponse_BlockDevice) GetPartitions() []*BlockDevicesResponse_Partition {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+func (x *BlockDevicesResponse_BlockDevice) GetMountPoints() []*BlockDevicesResponse_MountPoint {
+	if x != nil {
+		return x.MountPoints
+	}
+	return nil
+}
+
+// Represents the partition of the block device
+type BlockDevicesResponse_Partition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Kernel name of the partition like sda1
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Size of the partition in bytes
+	SizeBytes uint64 `protobuf:"varint,2,opt,name=sizeBytes,proto3" json:"sizeBytes,omitempty"`
+	// Offset of the partition from the start of the device in bytes
+	StartBytes uint64 `protobuf:"varint,3,opt,name=startBytes,proto3" json:"startBytes,omitempty"`
+	// Mount points of the partition
+	MountPoints []*BlockDevicesResponse_MountPoint `protobuf:"bytes,4,rep,name=mountPoints,proto3" json:"mountPoints,omitempty"`
+}
+
+func (x *BlockDevicesResponse_Partition) Reset() {
+	*x = BlockDevicesResponse_Partition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockDevicesResponse_Partition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockDevicesResponse_Partition) ProtoMessage() {}
+
+func (x *BlockDevicesResponse_Partition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockDevicesResponse_Partition.ProtoReflect.Descriptor instead.
+func (*BlockDevicesResponse_Partition) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *BlockDevicesResponse_Partition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BlockDevicesResponse_Partition) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *BlockDevicesResponse_Partition) GetStartBytes() uint64 {
+	if x != nil {
+		return x.StartBytes
+	}
+	return 0
+}
+
+func (x *BlockDevicesResponse_Partition) GetMountPoints() []*BlockDevicesResponse_MountPoint {
+	if x != nil {
+		return x.MountPoints
+	}
+	return nil
+}
+
+// Represents the place the filesystem is mounted at
+type BlockDevicesResponse_MountPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path the filesystem is mounted at
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Type of the filesystem
+	FsType string `protobuf:"bytes,2,opt,name=fsType,proto3" json:"fsType,omitempty"`
+}
+
+func (x *BlockDevicesResponse_MountPoint) Reset() {
+	*x = BlockDevicesResponse_MountPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockDevicesResponse_MountPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockDevicesResponse_MountPoint) ProtoMessage() {}
+
+func (x *BlockDevicesResponse_MountPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockDevicesResponse_MountPoint.ProtoReflect.Descriptor instead.
+func (*BlockDevicesResponse_MountPoint) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{7, 2}
+}
+
+func (x *BlockDevicesResponse_MountPoint) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *BlockDevicesResponse_MountPoint) GetFsType() string {
+	if x != nil {
+		return x.FsType
+	}
+	return ""
+}
+
+// Represents the process the event is about
+type Event_Process struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PID of the process
+	Pid int32 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	// Name of the process
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// PID of the parent process, 0 if unknown
+	Ppid int32 `protobuf:"varint,3,opt,name=ppid,proto3" json:"ppid,omitempty"`
+	// Command line of the process, empty if unknown
+	Command string `protobuf:"bytes,4,opt,name=command,proto3" json:"command,omitempty"`
+	// Name of the user owning the process, empty if unknown
+	User string `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	// Time the process lived for in seconds for the exited process
+	LifetimeSec float64 `protobuf:"fixed64,6,opt,name=lifetimeSec,proto3" json:"lifetimeSec,omitempty"`
+}
+
+func (x *Event_Process) Reset() {
+	*x = Event_Process{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_Process) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Process) ProtoMessage() {}
+
+func (x *Event_Process) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Process.ProtoReflect.Descriptor instead.
+func (*Event_Process) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *Event_Process) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *Event_Process) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Event_Process) GetPpid() int32 {
+	if x != nil {
+		return x.Ppid
+	}
+	return 0
+}
+
+func (x *Event_Process) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *Event_Process) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *Event_Process) GetLifetimeSec() float64 {
+	if x != nil {
+		return x.LifetimeSec
+	}
+	return 0
+}
+
+// Represents the metric collector
+type ListCollectorsResponse_Collector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the metric used in the configuration like cpu
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Title of the metric like CPU Usage
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Operating systems the metric is supported on, empty if every one
+	Platforms []string `protobuf:"bytes,3,rep,name=platforms,proto3" json:"platforms,omitempty"`
+	// Whether the metric is collected only if included in the configuration
+	OptIn bool `protobuf:"varint,4,opt,name=optIn,proto3" json:"optIn,omitempty"`
+	// Whether the metric is supported on the system the app is running on
+	Supported bool `protobuf:"varint,5,opt,name=supported,proto3" json:"supported,omitempty"`
+	// Whether the metric is collected, the configuration and SetCollectorEnabled enable and disable it
+	Enabled bool `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *ListCollectorsResponse_Collector) Reset() {
+	*x = ListCollectorsResponse_Collector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCollectorsResponse_Collector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectorsResponse_Collector) ProtoMessage() {}
+
+func (x *ListCollectorsResponse_Collector) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectorsResponse_Collector.ProtoReflect.Descriptor instead.
+func (*ListCollectorsResponse_Collector) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *ListCollectorsResponse_Collector) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListCollectorsResponse_Collector) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ListCollectorsResponse_Collector) GetPlatforms() []string {
+	if x != nil {
+		return x.Platforms
+	}
+	return nil
+}
+
+func (x *ListCollectorsResponse_Collector) GetOptIn() bool {
+	if x != nil {
+		return x.OptIn
+	}
+	return false
+}
+
+func (x *ListCollectorsResponse_Collector) GetSupported() bool {
+	if x != nil {
+		return x.Supported
+	}
+	return false
+}
+
+func (x *ListCollectorsResponse_Collector) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// Represents the health of the metrics collector
+type CollectorStatusResponse_Collector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the metric used in the configuration like cpu
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of the collections run
+	Collections uint64 `protobuf:"varint,2,opt,name=collections,proto3" json:"collections,omitempty"`
+	// Number of the collections failed
+	Failures uint64 `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	// Number of the collections failed since the last successful one
+	ConsecutiveFailures uint64 `protobuf:"varint,4,opt,name=consecutiveFailures,proto3" json:"consecutiveFailures,omitempty"`
+	// Time of the last successful collection as Unix time in milliseconds, 0 if there was none
+	LastSuccessMs int64 `protobuf:"varint,5,opt,name=lastSuccessMs,proto3" json:"lastSuccessMs,omitempty"`
+	// Error of the last failed collection
+	LastError string `protobuf:"bytes,6,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	// Time of the last failed collection as Unix time in milliseconds, 0 if there was none
+	LastErrorMs int64 `protobuf:"varint,7,opt,name=lastErrorMs,proto3" json:"lastErrorMs,omitempty"`
+	// Duration of the last collection in milliseconds
+	LastDurationMs float64 `protobuf:"fixed64,8,opt,name=lastDurationMs,proto3" json:"lastDurationMs,omitempty"`
+	// Histogram of the collections durations
+	Duration *CollectorStatusResponse_Histogram `protobuf:"bytes,9,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Number of the samples produced by the last successful collection
+	LastSamples uint64 `protobuf:"varint,10,opt,name=lastSamples,proto3" json:"lastSamples,omitempty"`
+	// Number of the samples produced by all the collections
+	Samples uint64 `protobuf:"varint,11,opt,name=samples,proto3" json:"samples,omitempty"`
+	// Whether the last collection failed, so the metric is the one of the last successful collection
+	Stale bool `protobuf:"varint,12,opt,name=stale,proto3" json:"stale,omitempty"`
+}
+
+func (x *CollectorStatusResponse_Collector) Reset() {
+	*x = CollectorStatusResponse_Collector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectorStatusResponse_Collector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectorStatusResponse_Collector) ProtoMessage() {}
+
+func (x *CollectorStatusResponse_Collector) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectorStatusResponse_Collector.ProtoReflect.Descriptor instead.
+func (*CollectorStatusResponse_Collector) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *CollectorStatusResponse_Collector) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectorStatusResponse_Collector) GetCollections() uint64 {
+	if x != nil {
+		return x.Collections
+	}
+	return 0
+}
+
+func (x *CollectorStatusResponse_Collector) GetFailures() uint64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *CollectorStatusResponse_Collector) GetConsecutiveFailures() uint64 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *CollectorStatusResponse_Collector) GetLastSuccessMs() int64 {
+	if x != nil {
+		return x.LastSuccessMs
+	}
+	return 0
+}
+
+func (x *CollectorStatusResponse_Collector) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *CollectorStatusResponse_Collector) GetLastErrorMs() int64 {
+	if x != nil {
+		return x.LastErrorMs
+	}
+	return 0
+}
+
+func (x *CollectorStatusResponse_Collector) GetLastDurationMs() float64 {
+	if x != nil {
+		return x.LastDurationMs
+	}
+	return 0
+}
+
+func (x *CollectorStatusResponse_Collector) GetDuration() *CollectorStatusResponse_Histogram {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *CollectorStatusResponse_Collector) GetLastSamples() uint64 {
+	if x != nil {
+		return x.LastSamples
+	}
+	return 0
+}
+
+func (x *CollectorStatusResponse_Collector) GetSamples() uint64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *CollectorStatusResponse_Collector) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+// Represents the distribution of the durations
+type CollectorStatusResponse_Histogram struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Upper bounds of the buckets in milliseconds, the last bucket has no bound
+	BoundsMs []float64 `protobuf:"fixed64,1,rep,packed,name=boundsMs,proto3" json:"boundsMs,omitempty"`
+	// Number of the durations within every bucket
+	Counts []uint64 `protobuf:"varint,2,rep,packed,name=counts,proto3" json:"counts,omitempty"`
+	// Sum of the durations in milliseconds
+	SumMs float64 `protobuf:"fixed64,3,opt,name=sumMs,proto3" json:"sumMs,omitempty"`
+	// Number of the durations
+	Count uint64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CollectorStatusResponse_Histogram) Reset() {
+	*x = CollectorStatusResponse_Histogram{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sysmon_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectorStatusResponse_Histogram) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectorStatusResponse_Histogram) ProtoMessage() {}
+
+func (x *CollectorStatusResponse_Histogram) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sysmon_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectorStatusResponse_Histogram.ProtoReflect.Descriptor instead.
+func (*CollectorStatusResponse_Histogram) Descriptor() ([]byte, []int) {
+	return file_api_sysmon_proto_rawDescGZIP(), []int{16, 1}
+}
+
+func (x *CollectorStatusResponse_Histogram) GetBoundsMs() []float64 {
+	if x != nil {
+		return x.BoundsMs
+	}
+	return nil
+}
+
+func (x *CollectorStatusResponse_Histogram) GetCounts() []uint64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *CollectorStatusResponse_Histogram) GetSumMs() float64 {
+	if x != nil {
+		return x.SumMs
+	}
+	return 0
+}
+
+func (x *CollectorStatusResponse_Histogram) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_api_sysmon_proto protoreflect.FileDescriptor
+
+var file_api_sysmon_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x79, 0x73, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x22, 0x0e, 0x0a, 0x0c, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x86, 0x49, 0x0a, 0x0d,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
+	0x03, 0x63, 0x70, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x43, 0x50, 0x55, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x2f, 0x0a, 0x04, 0x64,
+	0x69, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x12, 0x35, 0x0a, 0x06,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x12, 0x44, 0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x6c, 0x6f,
+	0x61, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x73,
+	0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x08,
+	0x6e, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52,
+	0x08, 0x6e, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x35, 0x0a, 0x06, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x12, 0x38, 0x0a, 0x07, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x68, 0x65, 0x72, 0x6d, 0x61,
+	0x6c, 0x52, 0x07, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x06, 0x6d, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x4d, 0x44, 0x53, 0x74, 0x61, 0x74, 0x52, 0x06, 0x6d, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x12, 0x35, 0x0a, 0x06, 0x7a, 0x66, 0x73, 0x41, 0x72, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x5a, 0x46, 0x53, 0x41, 0x72, 0x63,
+	0x52, 0x06, 0x7a, 0x66, 0x73, 0x41, 0x72, 0x63, 0x12, 0x3b, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x53, 0x79, 0x6e, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x6f, 0x63,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x52, 0x08, 0x73, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x12,
+	0x2f, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b,
+	0x12, 0x3e, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x12, 0x35, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x44, 0x65, 0x76, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x44, 0x65, 0x76, 0x52,
+	0x06, 0x6e, 0x65, 0x74, 0x44, 0x65, 0x76, 0x12, 0x35, 0x0a, 0x06, 0x76, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x56, 0x4d, 0x53, 0x74, 0x61, 0x74, 0x52, 0x06, 0x76, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x12, 0x35,
+	0x0a, 0x06, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x06, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x14,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x4f, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4d, 0x73, 0x18, 0x15, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4d, 0x73, 0x1a, 0x40, 0x0a, 0x12,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4d, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6a,
+	0x0a, 0x04, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x6d, 0x65,
+	0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x63, 0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63, 0x65, 0x64, 0x1a, 0x91, 0x02, 0x0a, 0x09, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x72, 0x75, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x61, 0x6c, 0x65, 0x73, 0x63, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63,
+	0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72,
+	0x72, 0x75, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72,
+	0x72, 0x75, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4a, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x4d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74,
+	0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x61, 0x6e,
+	0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c,
+	0x6d, 0x65, 0x61, 0x6e, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x6d, 0x61, 0x78, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x73, 0x1a, 0x45,
+	0x0a, 0x03, 0x43, 0x50, 0x55, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x69, 0x64, 0x6c, 0x65, 0x1a, 0xf8, 0x01, 0x0a, 0x04, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x72, 0x65, 0x61, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4b, 0x42, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4b, 0x42, 0x12, 0x18,
+	0x0a, 0x07, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x64,
+	0x4d, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x64, 0x4d, 0x62,
+	0x12, 0x20, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x49, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x64, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x73,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x75,
+	0x73, 0x65, 0x64, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x1a, 0x82, 0x08, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x4d, 0x62, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x4d, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x65, 0x65, 0x4d,
+	0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x72, 0x65, 0x65, 0x4d, 0x62, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x62, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x62, 0x12, 0x18, 0x0a, 0x07, 0x57,
+	0x69, 0x72, 0x65, 0x64, 0x4d, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x57, 0x69,
+	0x72, 0x65, 0x64, 0x4d, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6c, 0x61, 0x62, 0x4d, 0x62, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x6c, 0x61, 0x62, 0x4d, 0x62, 0x12, 0x2c, 0x0a,
+	0x11, 0x73, 0x6c, 0x61, 0x62, 0x52, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65,
+	0x4d, 0x62, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x73, 0x6c, 0x61, 0x62, 0x52, 0x65,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x62, 0x12, 0x1c, 0x0a, 0x09, 0x68,
+	0x75, 0x67, 0x65, 0x74, 0x6c, 0x62, 0x4d, 0x62, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x68, 0x75, 0x67, 0x65, 0x74, 0x6c, 0x62, 0x4d, 0x62, 0x12, 0x44, 0x0a, 0x09, 0x6e, 0x75, 0x6d,
+	0x61, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x4e, 0x55, 0x4d, 0x41,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12,
+	0x48, 0x0a, 0x09, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x2e, 0x48, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x09,
+	0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x0d, 0x74, 0x6f, 0x70,
+	0x53, 0x6c, 0x61, 0x62, 0x43, 0x61, 0x63, 0x68, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x2e,
+	0x53, 0x6c, 0x61, 0x62, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x0d, 0x74, 0x6f, 0x70, 0x53, 0x6c,
+	0x61, 0x62, 0x43, 0x61, 0x63, 0x68, 0x65, 0x73, 0x1a, 0x88, 0x02, 0x0a, 0x08, 0x4e, 0x55, 0x4d,
+	0x41, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x4d, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x4d, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x65, 0x65, 0x4d, 0x62, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x72, 0x65, 0x65, 0x4d, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x64, 0x4d, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x64, 0x4d, 0x62, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73,
+	0x4d, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61,
+	0x67, 0x65, 0x73, 0x4d, 0x62, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6e, 0x6f, 0x6e, 0x50, 0x61, 0x67,
+	0x65, 0x73, 0x4d, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x6e, 0x6f, 0x6e,
+	0x50, 0x61, 0x67, 0x65, 0x73, 0x4d, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6c, 0x61, 0x62, 0x4d,
+	0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x6c, 0x61, 0x62, 0x4d, 0x62, 0x12,
+	0x1c, 0x0a, 0x09, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x46, 0x72, 0x65, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x46,
+	0x72, 0x65, 0x65, 0x1a, 0x86, 0x01, 0x0a, 0x0c, 0x48, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65,
+	0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x7a, 0x65, 0x4b, 0x62, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x69, 0x7a, 0x65, 0x4b, 0x62, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x72, 0x70, 0x6c, 0x75, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x75, 0x72, 0x70, 0x6c, 0x75, 0x73, 0x1a, 0x77, 0x0a, 0x09,
+	0x53, 0x6c, 0x61, 0x62, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a,
+	0x0d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x69, 0x7a, 0x65, 0x4b, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73,
+	0x69, 0x7a, 0x65, 0x4b, 0x62, 0x1a, 0x5f, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x65, 0x4d, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6f, 0x6e, 0x65, 0x4d, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x66, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x66,
+	0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x66, 0x74, 0x65, 0x65,
+	0x6e, 0x4d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x69, 0x66, 0x74,
+	0x65, 0x65, 0x6e, 0x4d, 0x69, 0x6e, 0x1a, 0x9e, 0x02, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x72, 0x75, 0x70, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x70, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x70, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x74, 0x6f, 0x70,
+	0x49, 0x72, 0x71, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x49, 0x52, 0x51, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x49, 0x72, 0x71, 0x73, 0x12,
+	0x36, 0x0a, 0x08, 0x73, 0x6f, 0x66, 0x74, 0x49, 0x72, 0x71, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x52, 0x51, 0x52, 0x08, 0x73,
+	0x6f, 0x66, 0x74, 0x49, 0x72, 0x71, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x43, 0x70,
+	0x75, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x70, 0x65, 0x72, 0x43, 0x70, 0x75, 0x12,
+	0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x73, 0x69, 0x65, 0x73, 0x74, 0x43, 0x70, 0x75, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x75, 0x73, 0x69, 0x65, 0x73, 0x74, 0x43, 0x70, 0x75, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x6d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x69, 0x6d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x38, 0x0a,
+	0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x72, 0x71, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x52, 0x51, 0x52, 0x09, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x49, 0x72, 0x71, 0x73, 0x1a, 0x9b, 0x01, 0x0a, 0x03, 0x49, 0x52, 0x51, 0x12,
+	0x10, 0x0a, 0x03, 0x69, 0x72, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x72,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72,
+	0x43, 0x70, 0x75, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x70, 0x65, 0x72, 0x43, 0x70,
+	0x75, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x73, 0x69, 0x65,
+	0x73, 0x74, 0x43, 0x70, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x75, 0x73,
+	0x69, 0x65, 0x73, 0x74, 0x43, 0x70, 0x75, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6d, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x69, 0x6d, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x96, 0x09, 0x0a, 0x08, 0x4e, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x32, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x49, 0x50, 0x52, 0x02, 0x69, 0x70, 0x12, 0x35, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x43, 0x50, 0x52, 0x03, 0x74, 0x63, 0x70, 0x12, 0x35, 0x0a,
+	0x03, 0x75, 0x64, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x44, 0x50, 0x52,
+	0x03, 0x75, 0x64, 0x70, 0x12, 0x38, 0x0a, 0x04, 0x69, 0x63, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x43, 0x4d, 0x50, 0x52, 0x04, 0x69, 0x63, 0x6d, 0x70, 0x1a, 0xaa,
+	0x01, 0x0a, 0x02, 0x49, 0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x69, 0x6e, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x44, 0x69, 0x73,
+	0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x69, 0x6e, 0x44,
+	0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x44, 0x69,
+	0x73, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6f, 0x75,
+	0x74, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x48,
+	0x64, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x69, 0x6e, 0x48, 0x64, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x97, 0x03, 0x0a, 0x03,
+	0x54, 0x43, 0x50, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x75, 0x72, 0x72, 0x45, 0x73, 0x74, 0x61, 0x62,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x75, 0x72, 0x72, 0x45, 0x73, 0x74, 0x61,
+	0x62, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x70,
+	0x65, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x4f, 0x70,
+	0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x69,
+	0x76, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x75, 0x74, 0x53, 0x65, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6f, 0x75,
+	0x74, 0x53, 0x65, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x53, 0x65, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x53, 0x65, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0e, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x65, 0x73, 0x74, 0x61, 0x62, 0x52, 0x65, 0x73, 0x65, 0x74, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x65, 0x73, 0x74, 0x61, 0x62, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x45, 0x72, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x69, 0x6e, 0x45, 0x72, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c,
+	0x6f, 0x77, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x44, 0x72, 0x6f,
+	0x70, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x44, 0x72, 0x6f, 0x70, 0x73, 0x1a, 0xc9, 0x01, 0x0a, 0x03, 0x55, 0x44, 0x50, 0x12, 0x20, 0x0a,
+	0x0b, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x44, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x44, 0x61, 0x74, 0x61, 0x67, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x6f, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6e, 0x6f, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x69, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x63, 0x76,
+	0x62, 0x75, 0x66, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x72, 0x63, 0x76, 0x62, 0x75, 0x66, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x73, 0x6e, 0x64, 0x62, 0x75, 0x66, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x6e, 0x64, 0x62, 0x75, 0x66, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x1a, 0x9a, 0x01, 0x0a, 0x04, 0x49, 0x43, 0x4d, 0x50, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e,
+	0x4d, 0x73, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x69, 0x6e, 0x4d, 0x73,
+	0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x69, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6f, 0x75, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x74,
+	0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x69, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x73, 0x1a, 0xdf,
+	0x02, 0x0a, 0x06, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x0b, 0x66, 0x69, 0x6c,
+	0x65, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x35,
+	0x0a, 0x04, 0x70, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x04, 0x70, 0x69, 0x64, 0x73, 0x12, 0x3f, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x49, 0x0a, 0x0e, 0x69, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x0e, 0x69, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x12, 0x4d, 0x0a, 0x10, 0x69, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x10,
+	0x69, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x1a, 0x72, 0x0a, 0x0a, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x03, 0x6d, 0x61, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x64, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x1a, 0xb6, 0x03, 0x0a, 0x07, 0x54, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x6c,
+	0x12, 0x3a, 0x0a, 0x04, 0x63, 0x70, 0x75, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x6c, 0x2e, 0x43,
+	0x50, 0x55, 0x46, 0x72, 0x65, 0x71, 0x52, 0x04, 0x63, 0x70, 0x75, 0x73, 0x12, 0x3f, 0x0a, 0x07,
+	0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x6c, 0x2e, 0x53, 0x65,
+	0x6e, 0x73, 0x6f, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x1a, 0xb5, 0x01,
+	0x0a, 0x07, 0x43, 0x50, 0x55, 0x46, 0x72, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x70, 0x75,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x4d, 0x68, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x4d, 0x68, 0x7a, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x4d, 0x68, 0x7a, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x4d, 0x68, 0x7a, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x61, 0x78, 0x4d, 0x68, 0x7a, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78,
+	0x4d, 0x68, 0x7a, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x68, 0x72, 0x6f, 0x74,
+	0x74, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x65,
+	0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x68, 0x72, 0x6f,
+	0x74, 0x74, 0x6c, 0x65, 0x73, 0x1a, 0x76, 0x0a, 0x06, 0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x69, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x6d, 0x70, 0x43, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x74, 0x65, 0x6d, 0x70, 0x43, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x72, 0x69, 0x74, 0x43,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x63, 0x72, 0x69, 0x74, 0x43, 0x1a, 0xba, 0x04,
+	0x0a, 0x06, 0x4d, 0x44, 0x53, 0x74, 0x61, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x61, 0x72, 0x72, 0x61,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x4d, 0x44, 0x53, 0x74, 0x61, 0x74, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x06, 0x61,
+	0x72, 0x72, 0x61, 0x79, 0x73, 0x1a, 0x9b, 0x02, 0x0a, 0x05, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x07, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x44, 0x53, 0x74, 0x61, 0x74, 0x2e, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x04, 0x73,
+	0x79, 0x6e, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x4d, 0x44, 0x53, 0x74, 0x61, 0x74, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x04, 0x73,
+	0x79, 0x6e, 0x63, 0x1a, 0x46, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x8c, 0x01, 0x0a, 0x04,
+	0x53, 0x79, 0x6e, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x4d, 0x69, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x4d, 0x69, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x70, 0x65, 0x65, 0x64, 0x4b, 0x62, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x70, 0x65, 0x65, 0x64, 0x4b, 0x62, 0x73, 0x1a, 0xcc, 0x01, 0x0a, 0x06, 0x5a,
+	0x46, 0x53, 0x41, 0x72, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x68, 0x69, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x69, 0x74,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x68,
+	0x69, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x1a, 0xde, 0x01, 0x0a, 0x08, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72,
+	0x6f, 0x6e, 0x69, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x79,
+	0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4d, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6d, 0x61, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x6d, 0x61, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x65, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x65, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x66, 0x72, 0x65, 0x71, 0x50, 0x70, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x66,
+	0x72, 0x65, 0x71, 0x50, 0x70, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x61, 0x69, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x53, 0x65, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x61,
+	0x69, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x53, 0x65, 0x63, 0x1a, 0xc8, 0x02, 0x0a, 0x08, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x43, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x46, 0x0a, 0x07,
+	0x70, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x50,
+	0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x65, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x1a, 0x3a, 0x0a, 0x0c, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x73, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x74,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0xd7, 0x05, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x63, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50,
+	0x72, 0x6f, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x46, 0x0a, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x07,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x12, 0x53, 0x0a, 0x0d, 0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x50, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x5a, 0x6f, 0x6d,
+	0x62, 0x69, 0x65, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x7a, 0x6f, 0x6d, 0x62, 0x69,
+	0x65, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x1a, 0xa7, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6c,
+	0x65, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x6c,
+	0x65, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x53, 0x6c,
+	0x65, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x53,
+	0x6c, 0x65, 0x65, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73,
+	0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x1a, 0x4e, 0x0a, 0x0c, 0x5a, 0x6f,
+	0x6d, 0x62, 0x69, 0x65, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x73, 0x1a, 0x53, 0x0a, 0x0b, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x53, 0x65, 0x63, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x53, 0x65, 0x63, 0x1a,
+	0x82, 0x07, 0x0a, 0x08, 0x53, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x55, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x35,
+	0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x2e, 0x54, 0x43, 0x50,
+	0x52, 0x03, 0x74, 0x63, 0x70, 0x12, 0x35, 0x0a, 0x03, 0x75, 0x64, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x2e, 0x55, 0x44, 0x50, 0x52, 0x03, 0x75, 0x64, 0x70, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x61, 0x77, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x72, 0x61, 0x77, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x75, 0x64, 0x70, 0x44,
+	0x72, 0x6f, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x2e, 0x55, 0x44, 0x50, 0x44,
+	0x72, 0x6f, 0x70, 0x73, 0x52, 0x08, 0x75, 0x64, 0x70, 0x44, 0x72, 0x6f, 0x70, 0x73, 0x1a, 0xbd,
+	0x01, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x69, 0x6e, 0x55, 0x73, 0x65, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x69, 0x6e,
+	0x55, 0x73, 0x65, 0x36, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x57, 0x61, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x57, 0x61, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x12, 0x3e,
+	0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x2e,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x1a, 0x73,
+	0x0a, 0x03, 0x55, 0x44, 0x50, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69,
+	0x6e, 0x55, 0x73, 0x65, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x69, 0x6e, 0x55,
+	0x73, 0x65, 0x36, 0x12, 0x3e, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x6f, 0x63, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x1a, 0xce, 0x01, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x75, 0x73, 0x65, 0x64, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x75, 0x73, 0x65, 0x64, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x75, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x24,
+	0x0a, 0x0d, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73,
+	0x73, 0x75, 0x72, 0x65, 0x1a, 0x7e, 0x0a, 0x08, 0x55, 0x44, 0x50, 0x44, 0x72, 0x6f, 0x70, 0x73,
+	0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72,
+	0x6f, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x72, 0x6f, 0x70, 0x73,
+	0x12, 0x22, 0x0a, 0x0c, 0x72, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x1a, 0xd7, 0x02, 0x0a, 0x06, 0x4e, 0x65, 0x74, 0x44, 0x65, 0x76, 0x12,
+	0x47, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x44,
+	0x65, 0x76, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x83, 0x02, 0x0a, 0x09, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x78,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x78, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x72, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x74, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x78,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x78,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x78, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x78, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x78, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x72, 0x78, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x78, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x78, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x1a, 0xbe,
+	0x01, 0x0a, 0x06, 0x56, 0x4d, 0x53, 0x74, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x6a,
+	0x6f, 0x72, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x77, 0x61, 0x70, 0x49, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x73, 0x77,
+	0x61, 0x70, 0x49, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x73, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x64, 0x49, 0x6e, 0x4b, 0x62, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x64, 0x49, 0x6e, 0x4b, 0x62, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x4b, 0x62, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x4b, 0x62, 0x1a,
+	0xc2, 0x01, 0x0a, 0x06, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3e, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52,
+	0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x1a, 0x5a, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9e, 0x03, 0x0a,
+	0x15, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x1a, 0xbe, 0x02, 0x0a,
+	0x07, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x09,
+	0x6f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x09, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x0e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4b, 0x62, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4b, 0x62, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x64,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6d,
+	0x61, 0x78, 0x55, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x13, 0x0a,
+	0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0xba, 0x03, 0x0a, 0x12, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x63, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x63, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x6b, 0x65, 0x72,
+	0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x69, 0x73, 0x74, 0x72, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x69, 0x73, 0x74, 0x72, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x70, 0x75, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x70, 0x75, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x70, 0x75, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x70, 0x75, 0x53, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x70, 0x75, 0x43, 0x6f, 0x72, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x70, 0x75, 0x43, 0x6f, 0x72, 0x65, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x70, 0x75, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x70, 0x75, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x62,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4d, 0x62, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x22,
+	0x15, 0x0a, 0x13, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xba, 0x05, 0x0a, 0x14, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x43, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x1a, 0xf6, 0x02, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x4a, 0x0a, 0x0b, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x0b, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0xa9, 0x01,
+	0x0a, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x4a, 0x0a,
+	0x0b, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x4d, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x73, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x73, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x2f, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x22, 0xc9, 0x02, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x74, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x30, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x93, 0x01, 0x0a, 0x07, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x70, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x70, 0x69, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x20,
+	0x0a, 0x0b, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63,
+	0x22, 0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x93, 0x02, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x1a,
+	0xad, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x70, 0x74, 0x49, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x6f, 0x70, 0x74, 0x49, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22,
+	0x2c, 0x0a, 0x0e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x22, 0x62, 0x0a,
+	0x0b, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x69,
+	0x6d, 0x65, 0x4d, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e,
+	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65,
+	0x71, 0x22, 0xe2, 0x01, 0x0a, 0x06, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x33, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2e, 0x0a, 0x16, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x8c, 0x05, 0x0a, 0x17, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x1a, 0xb7,
+	0x03, 0x0a, 0x09, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x30,
+	0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4d,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x4d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x6c, 0x61, 0x73, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x46,
+	0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x61, 0x73,
+	0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x1a, 0x6b, 0x0a, 0x09, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x4d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x08, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x4d,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x75, 0x6d,
+	0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x75, 0x6d, 0x4d, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x22, 0x4b, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x32, 0xee,
+	0x04, 0x0a, 0x0b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3b,
+	0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12,
+	0x1d, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1a, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12,
+	0x1c, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40,
+	0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x53, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x1e, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32,
+	0x71, 0x0a, 0x0b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x62,
+	0x0a, 0x13, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e,
+	0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x73, 0x69, 0x74, 0x6e, 0x69, 0x6b, 0x6f, 0x76, 0x69, 0x6b, 0x2f, 0x73, 0x79, 0x73, 0x6d,
+	0x6f, 0x6e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x79, 0x73, 0x6d, 0x6f, 0x6e,
+	0x5f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_sysmon_proto_rawDescOnce sync.Once
+	file_api_sysmon_proto_rawDescData = file_api_sysmon_proto_rawDesc
+)
+
+func file_api_sysmon_proto_rawDescGZIP() []byte {
+	file_api_sysmon_proto_rawDescOnce.Do(func() {
+		file_api_sysmon_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_sysmon_proto_rawDescData)
+	})
+	return file_api_sysmon_proto_rawDescData
+}
+
+var file_api_sysmon_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
+var file_api_sysmon_proto_goTypes = []interface{}{
+	(*StatsRequest)(nil),                         // 0: monitor.StatsRequest
+	(*StatsResponse)(nil),                        // 1: monitor.StatsResponse
+	(*ProcessLimitsRequest)(nil),                 // 2: monitor.ProcessLimitsRequest
+	(*ProcessLimitsResponse)(nil),                // 3: monitor.ProcessLimitsResponse
+	(*SystemInfoRequest)(nil),                    // 4: monitor.SystemInfoRequest
+	(*SystemInfoResponse)(nil),                   // 5: monitor.SystemInfoResponse
+	(*BlockDevicesRequest)(nil),                  // 6: monitor.BlockDevicesRequest
+	(*BlockDevicesResponse)(nil),                 // 7: monitor.BlockDevicesResponse
+	(*StreamEventsRequest)(nil),                  // 8: monitor.StreamEventsRequest
+	(*Event)(nil),                                // 9: monitor.Event
+	(*ListCollectorsRequest)(nil),                // 10: monitor.ListCollectorsRequest
+	(*ListCollectorsResponse)(nil),               // 11: monitor.ListCollectorsResponse
+	(*SamplesRequest)(nil),                       // 12: monitor.SamplesRequest
+	(*SampleBatch)(nil),                          // 13: monitor.SampleBatch
+	(*Sample)(nil),                               // 14: monitor.Sample
+	(*CollectorStatusRequest)(nil),               // 15: monitor.CollectorStatusRequest
+	(*CollectorStatusResponse)(nil),              // 16: monitor.CollectorStatusResponse
+	(*SetCollectorEnabledRequest)(nil),           // 17: monitor.SetCollectorEnabledRequest
+	(*SetCollectorEnabledResponse)(nil),          // 18: monitor.SetCollectorEnabledResponse
+	nil,                                          // 19: monitor.StatsResponse.CollectedAtMsEntry
+	(*StatsResponse_Tick)(nil),                   // 20: monitor.StatsResponse.Tick
+	(*StatsResponse_Scheduler)(nil),              // 21: monitor.StatsResponse.Scheduler
+	(*StatsResponse_CPU)(nil),                    // 22: monitor.StatsResponse.CPU
+	(*StatsResponse_Disk)(nil),                   // 23: monitor.StatsResponse.Disk
+	(*StatsResponse_Memory)(nil),                 // 24: monitor.StatsResponse.Memory
+	(*StatsResponse_LoadAverage)(nil),            // 25: monitor.StatsResponse.LoadAverage
+	(*StatsResponse_Interrupts)(nil),             // 26: monitor.StatsResponse.Interrupts
+	(*StatsResponse_IRQ)(nil),                    // 27: monitor.StatsResponse.IRQ
+	(*StatsResponse_NetProto)(nil),               // 28: monitor.StatsResponse.NetProto
+	(*StatsResponse_Limits)(nil),                 // 29: monitor.StatsResponse.Limits
+	(*StatsResponse_LimitUsage)(nil),             // 30: monitor.StatsResponse.LimitUsage
+	(*StatsResponse_Thermal)(nil),                // 31: monitor.StatsResponse.Thermal
+	(*StatsResponse_MDStat)(nil),                 // 32: monitor.StatsResponse.MDStat
+	(*StatsResponse_ZFSArc)(nil),                 // 33: monitor.StatsResponse.ZFSArc
+	(*StatsResponse_TimeSync)(nil),               // 34: monitor.StatsResponse.TimeSync
+	(*StatsResponse_Sessions)(nil),               // 35: monitor.StatsResponse.Sessions
+	(*StatsResponse_ProcState)(nil),              // 36: monitor.StatsResponse.ProcState
+	(*StatsResponse_SockStat)(nil),               // 37: monitor.StatsResponse.SockStat
+	(*StatsResponse_NetDev)(nil),                 // 38: monitor.StatsResponse.NetDev
+	(*StatsResponse_VMStat)(nil),                 // 39: monitor.StatsResponse.VMStat
+	(*StatsResponse_Custom)(nil),                 // 40: monitor.StatsResponse.Custom
+	(*StatsResponse_Memory_NUMANode)(nil),        // 41: monitor.StatsResponse.Memory.NUMANode
+	(*StatsResponse_Memory_HugePagePool)(nil),    // 42: monitor.StatsResponse.Memory.HugePagePool
+	(*StatsResponse_Memory_SlabCache)(nil),       // 43: monitor.StatsResponse.Memory.SlabCache
+	(*StatsResponse_NetProto_IP)(nil),            // 44: monitor.StatsResponse.NetProto.IP
+	(*StatsResponse_NetProto_TCP)(nil),           // 45: monitor.StatsResponse.NetProto.TCP
+	(*StatsResponse_NetProto_UDP)(nil),           // 46: monitor.StatsResponse.NetProto.UDP
+	(*StatsResponse_NetProto_ICMP)(nil),          // 47: monitor.StatsResponse.NetProto.ICMP
+	(*StatsResponse_Thermal_CPUFreq)(nil),        // 48: monitor.StatsResponse.Thermal.CPUFreq
+	(*StatsResponse_Thermal_Sensor)(nil),         // 49: monitor.StatsResponse.Thermal.Sensor
+	(*StatsResponse_MDStat_Array)(nil),           // 50: monitor.StatsResponse.MDStat.Array
+	(*StatsResponse_MDStat_Member)(nil),          // 51: monitor.StatsResponse.MDStat.Member
+	(*StatsResponse_MDStat_Sync)(nil),            // 52: monitor.StatsResponse.MDStat.Sync
+	nil,                                          // 53: monitor.StatsResponse.Sessions.PerUserEntry
+	(*StatsResponse_Sessions_Session)(nil),       // 54: monitor.StatsResponse.Sessions.Session
+	(*StatsResponse_ProcState_StateCounts)(nil),  // 55: monitor.StatsResponse.ProcState.StateCounts
+	(*StatsResponse_ProcState_ZombieParent)(nil), // 56: monitor.StatsResponse.ProcState.ZombieParent
+	(*StatsResponse_ProcState_BlockedTask)(nil),  // 57: monitor.StatsResponse.ProcState.BlockedTask
+	(*StatsResponse_SockStat_TCP)(nil),           // 58: monitor.StatsResponse.SockStat.TCP
+	(*StatsResponse_SockStat_UDP)(nil),           // 59: monitor.StatsResponse.SockStat.UDP
+	(*StatsResponse_SockStat_Memory)(nil),        // 60: monitor.StatsResponse.SockStat.Memory
+	(*StatsResponse_SockStat_UDPDrops)(nil),      // 61: monitor.StatsResponse.SockStat.UDPDrops
+	(*StatsResponse_NetDev_Interface)(nil),       // 62: monitor.StatsResponse.NetDev.Interface
+	(*StatsResponse_Custom_Metric)(nil),          // 63: monitor.StatsResponse.Custom.Metric
+	(*ProcessLimitsResponse_Process)(nil),        // 64: monitor.ProcessLimitsResponse.Process
+	(*BlockDevicesResponse_BlockDevice)(nil),     // 65: monitor.BlockDevicesResponse.BlockDevice
+	(*BlockDevicesResponse_Partition)(nil),       // 66: monitor.BlockDevicesResponse.Partition
+	(*BlockDevicesResponse_MountPoint)(nil),      // 67: monitor.BlockDevicesResponse.MountPoint
+	(*Event_Process)(nil),                        // 68: monitor.Event.Process
+	(*ListCollectorsResponse_Collector)(nil),     // 69: monitor.ListCollectorsResponse.Collector
+	nil,                                          // 70: monitor.Sample.LabelsEntry
+	(*CollectorStatusResponse_Collector)(nil),    // 71: monitor.CollectorStatusResponse.Collector
+	(*CollectorStatusResponse_Histogram)(nil),    // 72: monitor.CollectorStatusResponse.Histogram
+}
+var file_api_sysmon_proto_depIdxs = []int32{
+	22, // 0: monitor.StatsResponse.cpu:type_name -> monitor.StatsResponse.CPU
+	23, // 1: monitor.StatsResponse.disk:type_name -> monitor.StatsResponse.Disk
+	24, // 2: monitor.StatsResponse.memory:type_name -> monitor.StatsResponse.Memory
+	25, // 3: monitor.StatsResponse.loadAverage:type_name -> monitor.StatsResponse.LoadAverage
+	26, // 4: monitor.StatsResponse.interrupts:type_name -> monitor.StatsResponse.Interrupts
+	28, // 5: monitor.StatsResponse.netProto:type_name -> monitor.StatsResponse.NetProto
+	29, // 6: monitor.StatsResponse.limits:type_name -> monitor.StatsResponse.Limits
+	31, // 7: monitor.StatsResponse.thermal:type_name -> monitor.StatsResponse.Thermal
+	32, // 8: monitor.StatsResponse.mdStat:type_name -> monitor.StatsResponse.MDStat
+	33, // 9: monitor.StatsResponse.zfsArc:type_name -> monitor.StatsResponse.ZFSArc
+	34, // 10: monitor.StatsResponse.timeSync:type_name -> monitor.StatsResponse.TimeSync
+	35, // 11: monitor.StatsResponse.sessions:type_name -> monitor.StatsResponse.Sessions
+	36, // 12: monitor.StatsResponse.procState:type_name -> monitor.StatsResponse.ProcState
+	37, // 13: monitor.StatsResponse.sockStat:type_name -> monitor.StatsResponse.SockStat
+	20, // 14: monitor.StatsResponse.tick:type_name -> monitor.StatsResponse.Tick
+	21, // 15: monitor.StatsResponse.scheduler:type_name -> monitor.StatsResponse.Scheduler
+	38, // 16: monitor.StatsResponse.netDev:type_name -> monitor.StatsResponse.NetDev
+	39, // 17: monitor.StatsResponse.vmStat:type_name -> monitor.StatsResponse.VMStat
+	40, // 18: monitor.StatsResponse.custom:type_name -> monitor.StatsResponse.Custom
+	19, // 19: monitor.StatsResponse.collectedAtMs:type_name -> monitor.StatsResponse.CollectedAtMsEntry
+	64, // 20: monitor.ProcessLimitsResponse.processes:type_name -> monitor.ProcessLimitsResponse.Process
+	65, // 21: monitor.BlockDevicesResponse.devices:type_name -> monitor.BlockDevicesResponse.BlockDevice
+	68, // 22: monitor.Event.process:type_name -> monitor.Event.Process
+	69, // 23: monitor.ListCollectorsResponse.collectors:type_name -> monitor.ListCollectorsResponse.Collector
+	14, // 24: monitor.SampleBatch.samples:type_name -> monitor.Sample
+	70, // 25: monitor.Sample.labels:type_name -> monitor.Sample.LabelsEntry
+	71, // 26: monitor.CollectorStatusResponse.collectors:type_name -> monitor.CollectorStatusResponse.Collector
+	41, // 27: monitor.StatsResponse.Memory.numaNodes:type_name -> monitor.StatsResponse.Memory.NUMANode
+	42, // 28: monitor.StatsResponse.Memory.hugePages:type_name -> monitor.StatsResponse.Memory.HugePagePool
+	43, // 29: monitor.StatsResponse.Memory.topSlabCaches:type_name -> monitor.StatsResponse.Memory.SlabCache
+	27, // 30: monitor.StatsResponse.Interrupts.topIrqs:type_name -> monitor.StatsResponse.IRQ
+	27, // 31: monitor.StatsResponse.Interrupts.softIrqs:type_name -> monitor.StatsResponse.IRQ
+	27, // 32: monitor.StatsResponse.Interrupts.localIrqs:type_name -> monitor.StatsResponse.IRQ
+	44, // 33: monitor.StatsResponse.NetProto.ip:type_name -> monitor.StatsResponse.NetProto.IP
+	45, // 34: monitor.StatsResponse.NetProto.tcp:type_name -> monitor.StatsResponse.NetProto.TCP
+	46, // 35: monitor.StatsResponse.NetProto.udp:type_name -> monitor.StatsResponse.NetProto.UDP
+	47, // 36: monitor.StatsResponse.NetProto.icmp:type_name -> monitor.StatsResponse.NetProto.ICMP
+	30, // 37: monitor.StatsResponse.Limits.fileHandles:type_name -> monitor.StatsResponse.LimitUsage
+	30, // 38: monitor.StatsResponse.Limits.pids:type_name -> monitor.StatsResponse.LimitUsage
+	30, // 39: monitor.StatsResponse.Limits.conntrack:type_name -> monitor.StatsResponse.LimitUsage
+	30, // 40: monitor.StatsResponse.Limits.inotifyWatches:type_name -> monitor.StatsResponse.LimitUsage
+	30, // 41: monitor.StatsResponse.Limits.inotifyInstances:type_name -> monitor.StatsResponse.LimitUsage
+	48, // 42: monitor.StatsResponse.Thermal.cpus:type_name -> monitor.StatsResponse.Thermal.CPUFreq
+	49, // 43: monitor.StatsResponse.Thermal.sensors:type_name -> monitor.StatsResponse.Thermal.Sensor
+	50, // 44: monitor.StatsResponse.MDStat.arrays:type_name -> monitor.StatsResponse.MDStat.Array
+	54, // 45: monitor.StatsResponse.Sessions.sessions:type_name -> monitor.StatsResponse.Sessions.Session
+	53, // 46: monitor.StatsResponse.Sessions.perUser:type_name -> monitor.StatsResponse.Sessions.PerUserEntry
+	55, // 47: monitor.StatsResponse.ProcState.processes:type_name -> monitor.StatsResponse.ProcState.StateCounts
+	55, // 48: monitor.StatsResponse.ProcState.threads:type_name -> monitor.StatsResponse.ProcState.StateCounts
+	56, // 49: monitor.StatsResponse.ProcState.zombieParents:type_name -> monitor.StatsResponse.ProcState.ZombieParent
+	57, // 50: monitor.StatsResponse.ProcState.blocked:type_name -> monitor.StatsResponse.ProcState.BlockedTask
+	58, // 51: monitor.StatsResponse.SockStat.tcp:type_name -> monitor.StatsResponse.SockStat.TCP
+	59, // 52: monitor.StatsResponse.SockStat.udp:type_name -> monitor.StatsResponse.SockStat.UDP
+	61, // 53: monitor.StatsResponse.SockStat.udpDrops:type_name -> monitor.StatsResponse.SockStat.UDPDrops
+	62, // 54: monitor.StatsResponse.NetDev.interfaces:type_name -> monitor.StatsResponse.NetDev.Interface
+	63, // 55: monitor.StatsResponse.Custom.metrics:type_name -> monitor.StatsResponse.Custom.Metric
+	51, // 56: monitor.StatsResponse.MDStat.Array.members:type_name -> monitor.StatsResponse.MDStat.Member
+	52, // 57: monitor.StatsResponse.MDStat.Array.sync:type_name -> monitor.StatsResponse.MDStat.Sync
+	60, // 58: monitor.StatsResponse.SockStat.TCP.memory:type_name -> monitor.StatsResponse.SockStat.Memory
+	60, // 59: monitor.StatsResponse.SockStat.UDP.memory:type_name -> monitor.StatsResponse.SockStat.Memory
+	30, // 60: monitor.ProcessLimitsResponse.Process.openFiles:type_name -> monitor.StatsResponse.LimitUsage
+	30, // 61: monitor.ProcessLimitsResponse.Process.processes:type_name -> monitor.StatsResponse.LimitUsage
+	30, // 62: monitor.ProcessLimitsResponse.Process.lockedMemoryKb:type_name -> monitor.StatsResponse.LimitUsage
+	66, // 63: monitor.BlockDevicesResponse.BlockDevice.partitions:type_name -> monitor.BlockDevicesResponse.Partition
+	67, // 64: monitor.BlockDevicesResponse.BlockDevice.mountPoints:type_name -> monitor.BlockDevicesResponse.MountPoint
+	67, // 65: monitor.BlockDevicesResponse.Partition.mountPoints:type_name -> monitor.BlockDevicesResponse.MountPoint
+	72, // 66: monitor.CollectorStatusResponse.Collector.duration:type_name -> monitor.CollectorStatusResponse.Histogram
+	0,  // 67: monitor.SystemStats.GetStats:input_type -> monitor.StatsRequest
+	2,  // 68: monitor.SystemStats.GetProcessLimits:input_type -> monitor.ProcessLimitsRequest
+	4,  // 69: monitor.SystemStats.GetSystemInfo:input_type -> monitor.SystemInfoRequest
+	6,  // 70: monitor.SystemStats.GetBlockDevices:input_type -> monitor.BlockDevicesRequest
+	8,  // 71: monitor.SystemStats.StreamEvents:input_type -> monitor.StreamEventsRequest
+	10, // 72: monitor.SystemStats.ListCollectors:input_type -> monitor.ListCollectorsRequest
+	12, // 73: monitor.SystemStats.GetSamples:input_type -> monitor.SamplesRequest
+	15, // 74: monitor.SystemStats.GetCollectorStatus:input_type -> monitor.CollectorStatusRequest
+	17, // 75: monitor.SystemAdmin.SetCollectorEnabled:input_type -> monitor.SetCollectorEnabledRequest
+	1,  // 76: monitor.SystemStats.GetStats:output_type -> monitor.StatsResponse
+	3,  // 77: monitor.SystemStats.GetProcessLimits:output_type -> monitor.ProcessLimitsResponse
+	5,  // 78: monitor.SystemStats.GetSystemInfo:output_type -> monitor.SystemInfoResponse
+	7,  // 79: monitor.SystemStats.GetBlockDevices:output_type -> monitor.BlockDevicesResponse
+	9,  // 80: monitor.SystemStats.StreamEvents:output_type -> monitor.Event
+	11, // 81: monitor.SystemStats.ListCollectors:output_type -> monitor.ListCollectorsResponse
+	13, // 82: monitor.SystemStats.GetSamples:output_type -> monitor.SampleBatch
+	16, // 83: monitor.SystemStats.GetCollectorStatus:output_type -> monitor.CollectorStatusResponse
+	18, // 84: monitor.SystemAdmin.SetCollectorEnabled:output_type -> monitor.SetCollectorEnabledResponse
+	76, // [76:85] is the sub-list for method output_type
+	67, // [67:76] is the sub-list for method input_type
+	67, // [67:67] is the sub-list for extension type_name
+	67, // [67:67] is the sub-list for extension extendee
+	0,  // [0:67] is the sub-list for field type_name
+}
+
+func init() { file_api_sysmon_proto_init() }
+func file_api_sysmon_proto_init() {
+	if File_api_sysmon_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_sysmon_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessLimitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessLimitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockDevicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockDevicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCollectorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCollectorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SamplesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SampleBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectorStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectorStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCollectorEnabledRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCollectorEnabledResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_Tick); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_Scheduler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_CPU); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_Disk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_Memory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_LoadAverage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_Interrupts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_IRQ); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_NetProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_Limits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_LimitUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_Thermal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_MDStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_ZFSArc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_TimeSync); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_Sessions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_ProcState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_SockStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_NetDev); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_VMStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_Custom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_Memory_NUMANode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -600,8 +7393,8 @@ func file_api_sysmon_proto_init() {
 				return nil
 			}
 		}
-		file_api_sysmon_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsResponse); i {
+		file_api_sysmon_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_Memory_HugePagePool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -612,8 +7405,8 @@ func file_api_sysmon_proto_init() {
 				return nil
 			}
 		}
-		file_api_sysmon_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsResponse_CPU); i {
+		file_api_sysmon_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_Memory_SlabCache); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -624,8 +7417,8 @@ func file_api_sysmon_proto_init() {
 				return nil
 			}
 		}
-		file_api_sysmon_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsResponse_Disk); i {
+		file_api_sysmon_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_NetProto_IP); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -636,8 +7429,8 @@ func file_api_sysmon_proto_init() {
 				return nil
 			}
 		}
-		file_api_sysmon_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsResponse_Memory); i {
+		file_api_sysmon_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_NetProto_TCP); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -648,8 +7441,296 @@ func file_api_sysmon_proto_init() {
 				return nil
 			}
 		}
-		file_api_sysmon_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsResponse_LoadAverage); i {
+		file_api_sysmon_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_NetProto_UDP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_NetProto_ICMP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_Thermal_CPUFreq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_Thermal_Sensor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_MDStat_Array); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_MDStat_Member); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_MDStat_Sync); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_Sessions_Session); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_ProcState_StateCounts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_ProcState_ZombieParent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_ProcState_BlockedTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_SockStat_TCP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_SockStat_UDP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_SockStat_Memory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_SockStat_UDPDrops); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_NetDev_Interface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse_Custom_Metric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessLimitsResponse_Process); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockDevicesResponse_BlockDevice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockDevicesResponse_Partition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockDevicesResponse_MountPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_Process); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCollectorsResponse_Collector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectorStatusResponse_Collector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sysmon_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectorStatusResponse_Histogram); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -667,9 +7748,9 @@ func file_api_sysmon_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_sysmon_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   73,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_api_sysmon_proto_goTypes,
 		DependencyIndexes: file_api_sysmon_proto_depIdxs,
@@ -23,6 +23,13 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SystemStatsClient interface {
 	GetStats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
+	GetProcessLimits(ctx context.Context, in *ProcessLimitsRequest, opts ...grpc.CallOption) (*ProcessLimitsResponse, error)
+	GetSystemInfo(ctx context.Context, in *SystemInfoRequest, opts ...grpc.CallOption) (*SystemInfoResponse, error)
+	GetBlockDevices(ctx context.Context, in *BlockDevicesRequest, opts ...grpc.CallOption) (*BlockDevicesResponse, error)
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (SystemStats_StreamEventsClient, error)
+	ListCollectors(ctx context.Context, in *ListCollectorsRequest, opts ...grpc.CallOption) (*ListCollectorsResponse, error)
+	GetSamples(ctx context.Context, in *SamplesRequest, opts ...grpc.CallOption) (*SampleBatch, error)
+	GetCollectorStatus(ctx context.Context, in *CollectorStatusRequest, opts ...grpc.CallOption) (*CollectorStatusResponse, error)
 }
 
 type systemStatsClient struct {
@@ -42,11 +49,104 @@ func (c *systemStatsClient) GetStats(ctx context.Context, in *StatsRequest, opts
 	return out, nil
 }
 
+func (c *systemStatsClient) GetProcessLimits(ctx context.Context, in *ProcessLimitsRequest, opts ...grpc.CallOption) (*ProcessLimitsResponse, error) {
+	out := new(ProcessLimitsResponse)
+	err := c.cc.Invoke(ctx, "/monitor.SystemStats/GetProcessLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemStatsClient) GetSystemInfo(ctx context.Context, in *SystemInfoRequest, opts ...grpc.CallOption) (*SystemInfoResponse, error) {
+	out := new(SystemInfoResponse)
+	err := c.cc.Invoke(ctx, "/monitor.SystemStats/GetSystemInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemStatsClient) GetBlockDevices(ctx context.Context, in *BlockDevicesRequest, opts ...grpc.CallOption) (*BlockDevicesResponse, error) {
+	out := new(BlockDevicesResponse)
+	err := c.cc.Invoke(ctx, "/monitor.SystemStats/GetBlockDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemStatsClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (SystemStats_StreamEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SystemStats_ServiceDesc.Streams[0], "/monitor.SystemStats/StreamEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &systemStatsStreamEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SystemStats_StreamEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type systemStatsStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *systemStatsStreamEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *systemStatsClient) ListCollectors(ctx context.Context, in *ListCollectorsRequest, opts ...grpc.CallOption) (*ListCollectorsResponse, error) {
+	out := new(ListCollectorsResponse)
+	err := c.cc.Invoke(ctx, "/monitor.SystemStats/ListCollectors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemStatsClient) GetSamples(ctx context.Context, in *SamplesRequest, opts ...grpc.CallOption) (*SampleBatch, error) {
+	out := new(SampleBatch)
+	err := c.cc.Invoke(ctx, "/monitor.SystemStats/GetSamples", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemStatsClient) GetCollectorStatus(ctx context.Context, in *CollectorStatusRequest, opts ...grpc.CallOption) (*CollectorStatusResponse, error) {
+	out := new(CollectorStatusResponse)
+	err := c.cc.Invoke(ctx, "/monitor.SystemStats/GetCollectorStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SystemStatsServer is the server API for SystemStats service.
 // All implementations must embed UnimplementedSystemStatsServer
 // for forward compatibility
 type SystemStatsServer interface {
 	GetStats(context.Context, *StatsRequest) (*StatsResponse, error)
+	GetProcessLimits(context.Context, *ProcessLimitsRequest) (*ProcessLimitsResponse, error)
+	GetSystemInfo(context.Context, *SystemInfoRequest) (*SystemInfoResponse, error)
+	GetBlockDevices(context.Context, *BlockDevicesRequest) (*BlockDevicesResponse, error)
+	StreamEvents(*StreamEventsRequest, SystemStats_StreamEventsServer) error
+	ListCollectors(context.Context, *ListCollectorsRequest) (*ListCollectorsResponse, error)
+	GetSamples(context.Context, *SamplesRequest) (*SampleBatch, error)
+	GetCollectorStatus(context.Context, *CollectorStatusRequest) (*CollectorStatusResponse, error)
 	mustEmbedUnimplementedSystemStatsServer()
 }
 
@@ -57,6 +157,27 @@ type UnimplementedSystemStatsServer struct {
 func (UnimplementedSystemStatsServer) GetStats(context.Context, *StatsRequest) (*StatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
 }
+func (UnimplementedSystemStatsServer) GetProcessLimits(context.Context, *ProcessLimitsRequest) (*ProcessLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProcessLimits not implemented")
+}
+func (UnimplementedSystemStatsServer) GetSystemInfo(context.Context, *SystemInfoRequest) (*SystemInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemInfo not implemented")
+}
+func (UnimplementedSystemStatsServer) GetBlockDevices(context.Context, *BlockDevicesRequest) (*BlockDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockDevices not implemented")
+}
+func (UnimplementedSystemStatsServer) StreamEvents(*StreamEventsRequest, SystemStats_StreamEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEvents not implemented")
+}
+func (UnimplementedSystemStatsServer) ListCollectors(context.Context, *ListCollectorsRequest) (*ListCollectorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectors not implemented")
+}
+func (UnimplementedSystemStatsServer) GetSamples(context.Context, *SamplesRequest) (*SampleBatch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSamples not implemented")
+}
+func (UnimplementedSystemStatsServer) GetCollectorStatus(context.Context, *CollectorStatusRequest) (*CollectorStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectorStatus not implemented")
+}
 func (UnimplementedSystemStatsServer) mustEmbedUnimplementedSystemStatsServer() {}
 
 // UnsafeSystemStatsServer may be embedded to opt out of forward compatibility for this service.
@@ -88,6 +209,135 @@ func _SystemStats_GetStats_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SystemStats_GetProcessLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemStatsServer).GetProcessLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/monitor.SystemStats/GetProcessLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemStatsServer).GetProcessLimits(ctx, req.(*ProcessLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemStats_GetSystemInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemStatsServer).GetSystemInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/monitor.SystemStats/GetSystemInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemStatsServer).GetSystemInfo(ctx, req.(*SystemInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemStats_GetBlockDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemStatsServer).GetBlockDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/monitor.SystemStats/GetBlockDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemStatsServer).GetBlockDevices(ctx, req.(*BlockDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemStats_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SystemStatsServer).StreamEvents(m, &systemStatsStreamEventsServer{stream})
+}
+
+type SystemStats_StreamEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type systemStatsStreamEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *systemStatsStreamEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SystemStats_ListCollectors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemStatsServer).ListCollectors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/monitor.SystemStats/ListCollectors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemStatsServer).ListCollectors(ctx, req.(*ListCollectorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemStats_GetSamples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SamplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemStatsServer).GetSamples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/monitor.SystemStats/GetSamples",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemStatsServer).GetSamples(ctx, req.(*SamplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemStats_GetCollectorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectorStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemStatsServer).GetCollectorStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/monitor.SystemStats/GetCollectorStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemStatsServer).GetCollectorStatus(ctx, req.(*CollectorStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SystemStats_ServiceDesc is the grpc.ServiceDesc for SystemStats service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -99,6 +349,122 @@ var SystemStats_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStats",
 			Handler:    _SystemStats_GetStats_Handler,
 		},
+		{
+			MethodName: "GetProcessLimits",
+			Handler:    _SystemStats_GetProcessLimits_Handler,
+		},
+		{
+			MethodName: "GetSystemInfo",
+			Handler:    _SystemStats_GetSystemInfo_Handler,
+		},
+		{
+			MethodName: "GetBlockDevices",
+			Handler:    _SystemStats_GetBlockDevices_Handler,
+		},
+		{
+			MethodName: "ListCollectors",
+			Handler:    _SystemStats_ListCollectors_Handler,
+		},
+		{
+			MethodName: "GetSamples",
+			Handler:    _SystemStats_GetSamples_Handler,
+		},
+		{
+			MethodName: "GetCollectorStatus",
+			Handler:    _SystemStats_GetCollectorStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _SystemStats_StreamEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/sysmon.proto",
+}
+
+// SystemAdminClient is the client API for SystemAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SystemAdminClient interface {
+	SetCollectorEnabled(ctx context.Context, in *SetCollectorEnabledRequest, opts ...grpc.CallOption) (*SetCollectorEnabledResponse, error)
+}
+
+type systemAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSystemAdminClient(cc grpc.ClientConnInterface) SystemAdminClient {
+	return &systemAdminClient{cc}
+}
+
+func (c *systemAdminClient) SetCollectorEnabled(ctx context.Context, in *SetCollectorEnabledRequest, opts ...grpc.CallOption) (*SetCollectorEnabledResponse, error) {
+	out := new(SetCollectorEnabledResponse)
+	err := c.cc.Invoke(ctx, "/monitor.SystemAdmin/SetCollectorEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SystemAdminServer is the server API for SystemAdmin service.
+// All implementations must embed UnimplementedSystemAdminServer
+// for forward compatibility
+type SystemAdminServer interface {
+	SetCollectorEnabled(context.Context, *SetCollectorEnabledRequest) (*SetCollectorEnabledResponse, error)
+	mustEmbedUnimplementedSystemAdminServer()
+}
+
+// UnimplementedSystemAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedSystemAdminServer struct {
+}
+
+func (UnimplementedSystemAdminServer) SetCollectorEnabled(context.Context, *SetCollectorEnabledRequest) (*SetCollectorEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectorEnabled not implemented")
+}
+func (UnimplementedSystemAdminServer) mustEmbedUnimplementedSystemAdminServer() {}
+
+// UnsafeSystemAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SystemAdminServer will
+// result in compilation errors.
+type UnsafeSystemAdminServer interface {
+	mustEmbedUnimplementedSystemAdminServer()
+}
+
+func RegisterSystemAdminServer(s grpc.ServiceRegistrar, srv SystemAdminServer) {
+	s.RegisterService(&SystemAdmin_ServiceDesc, srv)
+}
+
+func _SystemAdmin_SetCollectorEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectorEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemAdminServer).SetCollectorEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/monitor.SystemAdmin/SetCollectorEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemAdminServer).SetCollectorEnabled(ctx, req.(*SetCollectorEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SystemAdmin_ServiceDesc is the grpc.ServiceDesc for SystemAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SystemAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "monitor.SystemAdmin",
+	HandlerType: (*SystemAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetCollectorEnabled",
+			Handler:    _SystemAdmin_SetCollectorEnabled_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/sysmon.proto",